@@ -59,7 +59,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	tfacmpca "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -128,7 +127,7 @@ var (
 //
 // PreCheck(t) must be called before using this provider instance.
 var (
-	Provider *schema.Provider = errs.Must(provider.New(context.Background()))
+	Provider *schema.Provider = errs.Must(newProvider(context.Background()))
 )
 
 type ProviderFunc func() *schema.Provider
@@ -146,7 +145,7 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, _, err := protoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
@@ -179,7 +178,7 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
 	for _, name := range providerNames {
-		providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+		providerServerFactory, p, err := protoV5ProviderServerFactory(ctx)
 
 		if err != nil {
 			t.Fatal(err)
@@ -201,7 +200,7 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
 	for _, name := range providerNames {
-		providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+		providerServerFactory, p, err := protoV5ProviderServerFactory(ctx)
 
 		if err != nil {
 			t.Fatal(err)
@@ -297,6 +296,8 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		configureEmulatorEnvironment()
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -357,6 +358,10 @@ func CheckSleep(t *testing.T, d time.Duration) resource.TestCheckFunc {
 // CheckResourceAttrAccountID ensures the Terraform state exactly matches the account ID
 func CheckResourceAttrAccountID(ctx context.Context, resourceName, attributeName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
+		if Emulator() {
+			return MatchResourceAttrAccountID(resourceName, attributeName)(s)
+		}
+
 		return resource.TestCheckResourceAttr(resourceName, attributeName, AccountID(ctx))(s)
 	}
 }
@@ -370,8 +375,8 @@ func CheckResourceAttrRegionalARN(ctx context.Context, resourceName, attributeNa
 			Region:    Region(),
 			Resource:  arnResource,
 			Service:   arnService,
-		}.String()
-		return resource.TestCheckResourceAttr(resourceName, attributeName, attributeValue)(s)
+		}
+		return checkResourceAttrARN(resourceName, attributeName, attributeValue)(s)
 	}
 }
 
@@ -461,7 +466,7 @@ func MatchResourceAttrAccountID(resourceName, attributeName string) resource.Tes
 func MatchResourceAttrRegionalARN(ctx context.Context, resourceName, attributeName, arnService string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arnRegexp := arn.ARN{
-			AccountID: AccountID(ctx),
+			AccountID: arnAccountIDRegexp(ctx),
 			Partition: Partition(),
 			Region:    Region(),
 			Resource:  arnResourceRegexp.String(),
@@ -482,7 +487,7 @@ func MatchResourceAttrRegionalARN(ctx context.Context, resourceName, attributeNa
 func MatchResourceAttrRegionalARNRegion(ctx context.Context, resourceName, attributeName, arnService, region string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arnRegexp := arn.ARN{
-			AccountID: AccountID(ctx),
+			AccountID: arnAccountIDRegexp(ctx),
 			Partition: Partition(),
 			Region:    region,
 			Resource:  arnResourceRegexp.String(),
@@ -570,19 +575,19 @@ func MatchResourceAttrGlobalHostname(resourceName, attributeName, serviceName st
 	}
 }
 
-func globalARNValue(ctx context.Context, arnService, arnResource string) string {
+func globalARNValue(ctx context.Context, arnService, arnResource string) arn.ARN {
 	return arn.ARN{
 		AccountID: AccountID(ctx),
 		Partition: Partition(),
 		Resource:  arnResource,
 		Service:   arnService,
-	}.String()
+	}
 }
 
 // CheckResourceAttrGlobalARN ensures the Terraform state exactly matches a formatted ARN without region
 func CheckResourceAttrGlobalARN(ctx context.Context, resourceName, attributeName, arnService, arnResource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return resource.TestCheckResourceAttr(resourceName, attributeName, globalARNValue(ctx, arnService, arnResource))(s)
+		return checkResourceAttrARN(resourceName, attributeName, globalARNValue(ctx, arnService, arnResource))(s)
 	}
 }
 
@@ -615,7 +620,7 @@ func CheckResourceAttrGlobalARNAccountID(resourceName, attributeName, accountID,
 func MatchResourceAttrGlobalARN(ctx context.Context, resourceName, attributeName, arnService string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arnRegexp := arn.ARN{
-			AccountID: AccountID(ctx),
+			AccountID: arnAccountIDRegexp(ctx),
 			Partition: Partition(),
 			Resource:  arnResourceRegexp.String(),
 			Service:   arnService,
@@ -893,7 +898,14 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
 func AccountID(ctx context.Context) string {
-	return ProviderAccountID(ctx, Provider)
+	accountID := ProviderAccountID(ctx, Provider)
+
+	// An emulator without STS support cannot report the account ID.
+	if accountID == "" && Emulator() {
+		return emulatorAccountID()
+	}
+
+	return accountID
 }
 
 func Region() string {
@@ -1587,6 +1599,8 @@ func RegisterServiceErrorCheckFunc(serviceID string, f ServiceErrorCheckFunc) {
 func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 	t.Helper()
 
+	PreCheckEmulatorSupportsService(t, serviceIDs...)
+
 	return func(err error) error {
 		if err == nil {
 			return nil
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// Acceptance tests can be run against a local AWS API emulator by setting TF_ACC_EMULATOR_ENDPOINT.
+// In this profile:
+//   - All service clients use the emulator endpoint (via AWS_ENDPOINT_URL) unless a service-specific endpoint is set
+//   - Dummy static credentials are used if none are set
+//   - The provider skips credentials, region and EC2 metadata validation and uses path-style S3 addressing
+//   - Tests for services not in TF_ACC_EMULATOR_SERVICES are skipped by ErrorCheck
+//   - Checks of account IDs in attribute values accept any account ID, as emulators fake them
+
+const (
+	emulatorAccountIDDefault = "000000000000"
+	emulatorCredentialValue  = "test"
+	accountIDPattern         = `\d{12}`
+)
+
+var accountIDRegexpAnchored = regexache.MustCompile(`^` + accountIDPattern + `$`)
+
+// Emulator returns whether acceptance tests are running against a local AWS API emulator.
+func Emulator() bool {
+	return os.Getenv(envvar.AccEmulatorEndpoint) != ""
+}
+
+// emulatorServices returns the set of AWS SDK service IDs supported by the emulator,
+// or nil if all services are supported.
+var emulatorServices = sync.OnceValues(func() (map[string]bool, error) {
+	return parseEmulatorServices(os.Getenv(envvar.AccEmulatorServices))
+})
+
+func parseEmulatorServices(v string) (map[string]bool, error) {
+	if strings.TrimSpace(v) == "" {
+		return nil, nil
+	}
+
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, err
+	}
+
+	services := make(map[string]bool)
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		var found bool
+		for _, sr := range serviceData {
+			if sdkID := sr.SDKID(); sdkID != "" && (s == sr.ProviderPackage() || strings.EqualFold(s, sdkID)) {
+				services[sdkID] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%s: unknown service %q", envvar.AccEmulatorServices, s)
+		}
+	}
+
+	return services, nil
+}
+
+// emulatorSupportsService returns whether the emulator supports the service with the specified AWS SDK service ID.
+func emulatorSupportsService(t *testing.T, serviceID string) bool {
+	t.Helper()
+
+	services, err := emulatorServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return services == nil || services[serviceID]
+}
+
+// PreCheckEmulatorSupportsService skips the test when running against an emulator that does not support
+// all of the services with the specified AWS SDK service IDs.
+// ErrorCheck calls this for its service IDs, so tests only need to call it for additional services they use.
+func PreCheckEmulatorSupportsService(t *testing.T, serviceIDs ...string) {
+	t.Helper()
+
+	if !Emulator() {
+		return
+	}
+
+	for _, serviceID := range serviceIDs {
+		if !emulatorSupportsService(t, serviceID) {
+			t.Skipf("skipping test; emulator does not support %s (%s=%q)", serviceID, envvar.AccEmulatorServices, os.Getenv(envvar.AccEmulatorServices))
+		}
+	}
+}
+
+// PreCheckNotEmulator skips tests which cannot run against an emulator, e.g. those relying on real infrastructure.
+func PreCheckNotEmulator(t *testing.T) {
+	t.Helper()
+
+	if Emulator() {
+		t.Skipf("skipping test; not supported when running against an emulator (%s is set)", envvar.AccEmulatorEndpoint)
+	}
+}
+
+// configureEmulatorEnvironment sets environment variables for the AWS SDK to use the emulator.
+// It must be called before the provider is configured.
+func configureEmulatorEnvironment() {
+	if !Emulator() {
+		return
+	}
+
+	if os.Getenv("AWS_ENDPOINT_URL") == "" {
+		os.Setenv("AWS_ENDPOINT_URL", os.Getenv(envvar.AccEmulatorEndpoint))
+	}
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+		os.Setenv(envvar.AccessKeyId, emulatorCredentialValue)
+		os.Setenv(envvar.SecretAccessKey, emulatorCredentialValue)
+	}
+}
+
+// applyEmulatorDefaults defaults provider arguments for use with an emulator.
+// Defaults do not change the provider's schema, so the muxed provider servers remain consistent.
+func applyEmulatorDefaults(p *schema.Provider) error {
+	if !Emulator() {
+		return nil
+	}
+
+	services, err := emulatorServices()
+	if err != nil {
+		return err
+	}
+
+	defaults := map[string]any{
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+	}
+
+	// Without STS the provider cannot determine the account ID; AccountID falls back to the emulator's.
+	if services != nil && !services[names.STSServiceID] {
+		defaults["skip_requesting_account_id"] = true
+	}
+
+	for k, v := range defaults {
+		s, ok := p.Schema[k]
+		if !ok {
+			return fmt.Errorf("defaulting provider argument for emulator: %q not found", k)
+		}
+		s.Default = v
+	}
+
+	return nil
+}
+
+// newProvider returns a new provider instance, with emulator defaults if applicable.
+func newProvider(ctx context.Context) (*schema.Provider, error) {
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyEmulatorDefaults(p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// protoV5ProviderServerFactory returns a new muxed provider server factory, with emulator defaults if applicable.
+func protoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
+	factory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := applyEmulatorDefaults(p); err != nil {
+		return nil, nil, err
+	}
+
+	return factory, p, nil
+}
+
+// emulatorAccountID returns the account ID used by an emulator without STS support.
+func emulatorAccountID() string {
+	return envvar.GetWithDefault(envvar.AccEmulatorAccountID, emulatorAccountIDDefault)
+}
+
+// arnAccountIDRegexp returns the account ID component of ARN regular expressions.
+// When running against an emulator any account ID is matched.
+func arnAccountIDRegexp(ctx context.Context) string {
+	if Emulator() {
+		return accountIDPattern
+	}
+
+	return AccountID(ctx)
+}
+
+// checkResourceAttrARN ensures the Terraform state exactly matches the ARN,
+// ignoring the ARN's account ID when running against an emulator.
+func checkResourceAttrARN(resourceName, attributeName string, v arn.ARN) resource.TestCheckFunc {
+	if !Emulator() || v.AccountID == "" {
+		return resource.TestCheckResourceAttr(resourceName, attributeName, v.String())
+	}
+
+	return resource.TestMatchResourceAttr(resourceName, attributeName, regexache.MustCompile(`^`+arnRegexpAnyAccount(v)+`$`))
+}
+
+func arnRegexpAnyAccount(v arn.ARN) string {
+	return arn.ARN{
+		Partition: regexp.QuoteMeta(v.Partition),
+		Service:   regexp.QuoteMeta(v.Service),
+		Region:    regexp.QuoteMeta(v.Region),
+		AccountID: accountIDPattern,
+		Resource:  regexp.QuoteMeta(v.Resource),
+	}.String()
+}
+
+var _ knownvalue.Check = anyAccountARNCheck(nil)
+
+// anyAccountARNCheck builds an ARN Check for the account ID in the value being checked.
+// It is used when running against an emulator.
+type anyAccountARNCheck func(accountID string) knownvalue.Check
+
+func (f anyAccountARNCheck) CheckValue(other any) error {
+	accountID := accountIDPattern
+	if v, ok := other.(string); ok {
+		if parsed, err := arn.Parse(v); err == nil && accountIDRegexpAnchored.MatchString(parsed.AccountID) {
+			accountID = parsed.AccountID
+		}
+	}
+
+	return f(accountID).CheckValue(other)
+}
+
+func (f anyAccountARNCheck) String() string {
+	return f(accountIDPattern).String()
+}
+
+// arnKnownValueCheck returns a Check built for the expected account ID,
+// or for any account ID when running against an emulator.
+func arnKnownValueCheck(ctx context.Context, f func(accountID string) knownvalue.Check) knownvalue.Check {
+	if Emulator() {
+		return anyAccountARNCheck(f)
+	}
+
+	return f(AccountID(ctx))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseEmulatorServices(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expected    map[string]bool
+		expectError bool
+	}{
+		"empty": {
+			value: "",
+		},
+		"blank": {
+			value: "  ",
+		},
+		"provider packages": {
+			value: "sqs, sns,sts",
+			expected: map[string]bool{
+				names.SNSServiceID: true,
+				names.SQSServiceID: true,
+				names.STSServiceID: true,
+			},
+		},
+		"SDK service IDs": {
+			value: "SQS,dynamodb,Elastic Load Balancing v2",
+			expected: map[string]bool{
+				names.DynamoDBServiceID: true,
+				names.ELBV2ServiceID:    true,
+				names.SQSServiceID:      true,
+			},
+		},
+		"unknown": {
+			value:       "sqs,nosuchservice",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := acctest.ParseEmulatorServices(testCase.value)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// FakeTest runs a test case against an in-memory fake AWS backend.
+// Like resource.UnitTest, it does not require TF_ACC to be set, and as no requests are
+// sent to AWS it does not require credentials.
+//
+// If the test case has no provider factories, the main provider is used.
+// Test checks should use ProviderMeta(ctx, t) to create AWS API clients, or create them
+// from backend.Config(region) to set up or modify resources out of band.
+func FakeTest(ctx context.Context, t *testing.T, backend *fakeaws.Backend, testCase resource.TestCase) {
+	t.Helper()
+
+	accessKeyID, secretAccessKey := fakeaws.Credentials()
+	dir := t.TempDir()
+
+	// Ensure that the provider only uses the fake backend's credentials.
+	t.Setenv(envvar.AccessKeyId, accessKeyID)
+	t.Setenv(envvar.SecretAccessKey, secretAccessKey)
+	t.Setenv(envvar.DefaultRegion, fakeaws.DefaultRegion)
+	t.Setenv(envvar.Profile, "")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	if testCase.ProtoV5ProviderFactories == nil {
+		testCase.ProtoV5ProviderFactories = fakeProtoV5ProviderFactories(ctx, t, backend, ProviderName)
+	}
+
+	t.Cleanup(func() {
+		providerMetas.Lock()
+		delete(providerMetas, t.Name())
+		providerMetas.Unlock()
+	})
+
+	resource.UnitTest(t, testCase)
+}
+
+func fakeProtoV5ProviderFactories(ctx context.Context, t *testing.T, backend *fakeaws.Backend, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+	t.Helper()
+
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
+
+	for _, name := range providerNames {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = fakeProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, backend, t.Name())
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// fakeProviderConfigureContextFunc returns a provider configuration function that sends all AWS API requests to a fake backend.
+func fakeProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, backend *fakeaws.Backend, testName string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		meta, ok := provider.Meta().(*conns.AWSClient)
+		if !ok {
+			meta = new(conns.AWSClient)
+		}
+
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		meta.SetHTTPClient(ctx, backend.HTTPClient())
+		provider.SetMeta(meta)
+
+		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
+			return nil, append(diags, ds...)
+		} else {
+			meta = v.(*conns.AWSClient)
+		}
+
+		providerMetas.Lock()
+		providerMetas[testName] = meta
+		providerMetas.Unlock()
+
+		return meta, diags
+	}
+}
@@ -59,3 +59,15 @@ func ImportCheckResourceAttrSet(key string, set bool) resource.ImportStateCheckF
 		return nil
 	}
 }
+
+// ImportStepWithResourceIdentity returns a test step that imports the specified resource via an import block
+// using the resource identity from the prior step's state. The import must plan no changes.
+// Import by resource identity requires Terraform 1.12.0 or later, so tests using this step should
+// skip older versions via `TerraformVersionChecks`.
+func ImportStepWithResourceIdentity(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:    resourceName,
+		ImportState:     true,
+		ImportStateKind: resource.ImportBlockWithResourceIdentity,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// The checks are tested against an emulator, as the expected account ID is then taken from the value being checked
+// and no configured provider is required.
+
+func TestRegionalARNRegexp(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.AccEmulatorEndpoint, "http://localhost:4566")
+	t.Setenv(envvar.DefaultRegion, "us-west-2") //lintignore:AWSAT003
+
+	testKnownValueCheck(t, acctest.RegionalARNRegexp("ec2", regexache.MustCompile(`vpc/vpc-.+`)), map[string]bool{
+		"arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0":     true,  //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0123456789abcdef0":     false, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2::123456789012:vpc/vpc-0123456789abcdef0":              false, //lintignore:AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:subnet/subnet-0123456789abcde": false, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam:us-west-2:123456789012:vpc/vpc-0123456789abcdef0":     false, //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcd": false, //lintignore:AWSAT003,AWSAT005
+	})
+}
+
+func TestGlobalARNRegexp(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.AccEmulatorEndpoint, "http://localhost:4566")
+	t.Setenv(envvar.DefaultRegion, "us-west-2") //lintignore:AWSAT003
+
+	testKnownValueCheck(t, acctest.GlobalARNRegexp("route53-recovery-readiness", regexache.MustCompile(`cell/.+`)), map[string]bool{
+		"arn:aws:route53-recovery-readiness::123456789012:cell/example":          true,  //lintignore:AWSAT005
+		"arn:aws:route53-recovery-readiness:us-west-2:123456789012:cell/example": false, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:route53-recovery-readiness::123456789012:recovery-group/x":      false, //lintignore:AWSAT005
+	})
+}
+
+func testKnownValueCheck(t *testing.T, check knownvalue.Check, values map[string]bool) {
+	t.Helper()
+
+	for value, want := range values {
+		if err := check.CheckValue(value); want && err != nil {
+			t.Errorf("CheckValue(%q): unexpected error: %s", value, err)
+		} else if !want && err == nil {
+			t.Errorf("CheckValue(%q): expected error", value)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ statecheck.StateCheck = expectIdentityCheck{}
+
+type expectIdentityCheck struct {
+	base           Base
+	servicePackage conns.ServicePackage
+}
+
+// CheckState verifies that the resource's identity matches its identity specification:
+// the account ID and Region (unless global) are those in effect and the identifying attributes match state.
+func (e expectIdentityCheck) CheckState(ctx context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	res, ok := e.base.ResourceFromState(req, resp)
+	if !ok {
+		return
+	}
+
+	identitySpec := findResourceIdentitySpec(ctx, e.servicePackage, res.Type)
+	if identitySpec == nil {
+		resp.Error = fmt.Errorf("no identity specification found for resource type %s", res.Type)
+		return
+	}
+
+	if res.IdentityValues == nil {
+		resp.Error = fmt.Errorf("%s - Identity not found in state", e.base.ResourceAddress())
+		return
+	}
+
+	expected := make(map[string]any)
+
+	if !identitySpec.IsARN {
+		expected[names.AttrAccountID] = acctest.AccountID(ctx)
+
+		if !identitySpec.IsGlobal {
+			region := acctest.Region()
+			if v, ok := res.AttributeValues[names.AttrRegion].(string); ok && v != "" {
+				region = v
+			}
+			expected[names.AttrRegion] = region
+		}
+	}
+
+	for _, attribute := range identitySpec.Attributes {
+		expected[attribute.Name] = res.AttributeValues[attribute.Name]
+	}
+
+	if got, want := len(res.IdentityValues), len(expected); got != want {
+		resp.Error = fmt.Errorf("%s - expected %d identity attributes, got %d: %v", e.base.ResourceAddress(), want, got, res.IdentityValues)
+		return
+	}
+
+	for k, want := range expected {
+		got, ok := res.IdentityValues[k]
+		if !ok {
+			resp.Error = fmt.Errorf("%s - identity attribute %q not found", e.base.ResourceAddress(), k)
+			return
+		}
+
+		if got != want {
+			resp.Error = fmt.Errorf("%s - identity attribute %q: expected %v, got %v", e.base.ResourceAddress(), k, want, got)
+			return
+		}
+	}
+}
+
+// ExpectResourceIdentity returns a state check that verifies the resource's identity against
+// the identity specification registered by the service package.
+func ExpectResourceIdentity(servicePackage conns.ServicePackage, resourceAddress string) statecheck.StateCheck {
+	return expectIdentityCheck{
+		base:           NewBase(resourceAddress),
+		servicePackage: servicePackage,
+	}
+}
+
+func findResourceIdentitySpec(ctx context.Context, sp conns.ServicePackage, typeName string) *types.ServicePackageResourceIdentity {
+	for _, r := range sp.FrameworkResources(ctx) {
+		factory, _ := r.Factory(ctx)
+		var metadata resource.MetadataResponse
+		factory.Metadata(ctx, resource.MetadataRequest{}, &metadata)
+		if metadata.TypeName == typeName {
+			return r.Identity
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+// ResourceAdoptionConfig contains the provider-level settings for adoption of existing resources on create.
+type ResourceAdoptionConfig struct {
+	Enabled bool   // Are existing resources adopted unless a resource's configuration opts out?
+	TagKey  string // Key of the tag used to identify existing resources for adoption.
+}
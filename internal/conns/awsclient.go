@@ -38,13 +38,15 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*serviceRateLimiter // Keyed by service package name.
 	region                    string
+	resourceAdoptionConfig    *ResourceAdoptionConfig
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3.Client // Keyed by Region.
+	s3UsePathStyle            bool                  // From provider configuration.
+	s3USEast1RegionalEndpoint string                // From provider configuration.
+	stsRegion                 string                // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -79,8 +81,16 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) ResourceAdoptionConfig(context.Context) *ResourceAdoptionConfig {
+	return c.resourceAdoptionConfig
+}
+
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// The configuration's Region is any per-resource Region override in effect.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -102,11 +112,28 @@ func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
 }
 
-// Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// Region returns the ID of the AWS Region in effect.
+// This is the per-resource Region override, if any, otherwise the provider's configured Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion; v != "" {
+			return v
+		}
+	}
+
+	return c.region
+}
+
+// DefaultRegion returns the ID of the provider's configured AWS Region, ignoring any per-resource Region override.
+func (c *AWSClient) DefaultRegion(context.Context) string {
 	return c.region
 }
 
+// IsRegionOverridden returns whether a per-resource Region override different from the provider's configured Region is in effect.
+func (c *AWSClient) IsRegionOverridden(ctx context.Context) bool {
+	return c.Region(ctx) != c.region
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -161,18 +188,25 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3.Client)
+	}
+
+	region := c.Region(ctx)
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -317,8 +351,14 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if c.IsRegionOverridden(ctx) {
+		// Per-resource Region override.
+		cfg := c.AwsConfig(ctx)
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -334,23 +374,37 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	case names.STS:
 		m["sts_region"] = c.stsRegion
 	}
+	if v, ok := c.rateLimiters[servicePackageName]; ok {
+		m["rate_limiter"] = v
+	}
 
 	return m
 }
 
+// clientCacheKey returns the key used to cache the default AWS SDK for Go v2 API client for the specified service.
+// Clients for any per-resource Region override are cached separately from those for the provider's configured Region.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if c.IsRegionOverridden(ctx) {
+		return servicePackageName + "|" + c.Region(ctx)
+	}
+
+	return servicePackageName
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -385,7 +439,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -29,7 +30,8 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     []awsbase.AssumeRole
+	AssumeRole                     []AssumeRole
+	AssumeRoleWithSAML             *AssumeRoleWithSAML
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -39,13 +41,17 @@ type Config struct {
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
+	HTTPRecordingMode              string
+	HTTPRecordingPath              string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string]ServiceRateLimits // Keyed by service package name.
 	Region                         string
+	ResourceAdoptionConfig         *ResourceAdoptionConfig
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -70,6 +76,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if c.HTTPRecordingMode != "" {
+		httpClient, err := newHTTPRecordingClient(ctx, c.HTTPRecordingMode, c.HTTPRecordingPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring HTTP recording: %s", err)
+		}
+		client.SetHTTPClient(ctx, httpClient)
+
+		if c.HTTPRecordingMode == vcr.ModeReplay {
+			// Replayed requests are not sent to AWS so no real credentials are required.
+			// Any credentials returned by recorded STS calls have been redacted.
+			c.AccessKey = replayAccessKey
+			c.SecretKey = replaySecretKey
+			c.Token = ""
+			c.Profile = ""
+			c.EC2MetadataServiceEnableState = imds.ClientDisabled
+		}
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -83,7 +107,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 				{Name: "terraform-provider-aws", Version: version.ProviderVersion, Comment: "+https://registry.terraform.io/providers/hashicorp/aws"},
 			},
 		},
-		AssumeRole:                     c.AssumeRole,
+		AssumeRole:                     expandBaseAssumeRoles(c.AssumeRole),
 		AssumeRoleWithWebIdentity:      c.AssumeRoleWithWebIdentity,
 		Backoff:                        &v1CompatibleBackoff{maxRetryDelay: maxBackoff},
 		CallerDocumentationURL:         "https://registry.terraform.io/providers/hashicorp/aws",
@@ -141,6 +165,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
 	awsbaseConfig.SkipCredsValidation = true
 
+	var credentials aws.CredentialsProvider
+	if c.resolvesCredentials() {
+		var d diag.Diagnostics
+		credentials, d = c.resolveCredentials(ctx, &awsbaseConfig)
+		diags = append(diags, d...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
@@ -156,6 +191,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Credentials obtained by the provider are refreshed as they expire.
+	if credentials != nil {
+		cfg.Credentials = credentials
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -210,7 +250,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.rateLimiters = make(map[string]*serviceRateLimiter, len(c.RateLimits))
+	for k, v := range c.RateLimits {
+		client.rateLimiters[k] = newServiceRateLimiter(v)
+	}
 	client.region = c.Region
+	client.resourceAdoptionConfig = c.ResourceAdoptionConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithFrameworkListResources is an interface that extends ServicePackage with list resources.
+// List resources enumerate existing resources, e.g. for `terraform query`.
+type ServicePackageWithFrameworkListResources interface {
+	ServicePackage
+	FrameworkListResources(context.Context) []*types.ServicePackageFrameworkListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -38,6 +45,7 @@ var (
 type InContext struct {
 	IsDataSource        bool   // Data source?
 	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Per-resource Region override, empty if the provider's configured Region is used
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
 }
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// AssumeRoleWithSAML requests are not signed.
+	anonymousAccessKey = "anonymous_access_key"
+	anonymousSecretKey = "anonymous_secret_key"
+
+	// Maximum time that an MFA token command can run for.
+	tokenCommandTimeout = 1 * time.Minute
+)
+
+// AssumeRole is the configuration for assuming an IAM Role.
+// In addition to the options supported by the AWS SDK base it supports MFA and sourcing credentials from an external process.
+type AssumeRole struct {
+	awsbase.AssumeRole
+	CredentialProcess string // Command whose output supplies the credentials used to assume the role. Only valid for the first role in a chain.
+	SerialNumber      string
+	TokenCode         string
+	TokenCommand      string // Command whose output is the MFA token code.
+}
+
+func (ar AssumeRole) resolvedByProvider() bool {
+	return ar.CredentialProcess != "" || ar.SerialNumber != ""
+}
+
+// AssumeRoleWithSAML is the configuration for assuming an IAM Role using a SAML assertion.
+type AssumeRoleWithSAML struct {
+	Duration          time.Duration
+	Policy            string
+	PolicyARNs        []string
+	PrincipalARN      string
+	RoleARN           string
+	SAMLAssertion     string
+	SAMLAssertionFile string
+}
+
+func (ar AssumeRoleWithSAML) assertion() (string, error) {
+	if ar.SAMLAssertionFile == "" {
+		return ar.SAMLAssertion, nil
+	}
+
+	b, err := os.ReadFile(ar.SAMLAssertionFile)
+	if err != nil {
+		return "", fmt.Errorf("reading SAML assertion file (%s): %w", ar.SAMLAssertionFile, err)
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+func expandBaseAssumeRoles(assumeRoles []AssumeRole) []awsbase.AssumeRole {
+	if len(assumeRoles) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awsbase.AssumeRole, len(assumeRoles))
+	for i, v := range assumeRoles {
+		apiObjects[i] = v.AssumeRole
+	}
+
+	return apiObjects
+}
+
+// resolvesCredentials returns whether the provider, rather than the AWS SDK base, must obtain the credentials used to make API calls.
+func (c *Config) resolvesCredentials() bool {
+	if c.AssumeRoleWithSAML != nil {
+		return true
+	}
+
+	for _, v := range c.AssumeRole {
+		if v.resolvedByProvider() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveCredentials returns a provider of the credentials used to make API calls.
+// The provider obtains the source credentials and assumes any configured IAM Roles, refreshing credentials as they expire.
+// The AWS SDK base configuration's credential sources are replaced with the provider's current static credentials;
+// the returned provider must replace the credentials in the resulting AWS SDK configuration.
+// Credentials obtained using a static MFA token code cannot be refreshed as a token code can only be used once,
+// so the credentials of a role assumed using a token code, and of any subsequent roles, last at most that role's duration.
+func (c *Config) resolveCredentials(ctx context.Context, awsbaseConfig *awsbase.Config) (aws.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c.AssumeRoleWithSAML != nil && c.AssumeRoleWithWebIdentity != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "only one of assume_role_with_saml and assume_role_with_web_identity can be configured")
+	}
+
+	for i, v := range c.AssumeRole {
+		if v.CredentialProcess == "" {
+			continue
+		}
+		if i > 0 {
+			return nil, sdkdiag.AppendErrorf(diags, "assume_role %d of %d: credential_process can only be configured for the first IAM Role", i+1, len(c.AssumeRole))
+		}
+		if c.AssumeRoleWithSAML != nil || c.AssumeRoleWithWebIdentity != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "assume_role credential_process cannot be combined with assume_role_with_saml or assume_role_with_web_identity")
+		}
+	}
+
+	baseConfig := *awsbaseConfig
+	baseConfig.AssumeRole = nil
+
+	// The source credentials are obtained by the provider rather than the AWS SDK base.
+	if c.AssumeRoleWithSAML != nil || (len(c.AssumeRole) > 0 && c.AssumeRole[0].CredentialProcess != "") {
+		baseConfig.AccessKey = anonymousAccessKey
+		baseConfig.SecretKey = anonymousSecretKey
+		baseConfig.Token = ""
+		baseConfig.Profile = ""
+	}
+
+	_, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &baseConfig)
+
+	for _, d := range awsDiags {
+		diags = append(diags, diag.Diagnostic{
+			Severity: baseSeverityToSDKSeverity(d.Severity()),
+			Summary:  d.Summary(),
+			Detail:   d.Detail(),
+		})
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if ar := c.AssumeRoleWithSAML; ar != nil {
+		tflog.Info(ctx, "Assuming IAM Role with SAML", map[string]any{
+			"tf_aws.assume_role_with_saml.role_arn":      ar.RoleARN,
+			"tf_aws.assume_role_with_saml.principal_arn": ar.PrincipalARN,
+		})
+
+		cfg.Credentials = aws.NewCredentialsCache(&samlCredentialsProvider{
+			assumeRole: ar,
+			client:     newSTSClient(cfg, &baseConfig, aws.AnonymousCredentials{}),
+		})
+
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "assuming IAM Role (%s) with SAML: %s", ar.RoleARN, err)
+		}
+	}
+
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].CredentialProcess != "" {
+		tflog.Info(ctx, "Retrieving credentials from credential process")
+
+		cfg.Credentials = aws.NewCredentialsCache(processcreds.NewProvider(c.AssumeRole[0].CredentialProcess))
+
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving credentials from credential process: %s", err)
+		}
+	}
+
+	total := len(c.AssumeRole)
+	for i, ar := range c.AssumeRole {
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.index":         i,
+			"tf_aws.assume_role.role_arn":      ar.RoleARN,
+			"tf_aws.assume_role.session_name":  ar.SessionName,
+			"tf_aws.assume_role.serial_number": ar.SerialNumber,
+		})
+
+		// Each role is assumed using the credentials of the previous role in the chain.
+		provider := stscreds.NewAssumeRoleProvider(newSTSClient(cfg, &baseConfig, nil), ar.RoleARN, ar.assumeRoleOptions)
+
+		if ar.TokenCode != "" {
+			// A token code can only be used once, so the role is assumed once.
+			v, err := provider.Retrieve(ctx)
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "assuming IAM Role (%s) (%d of %d): %s", ar.RoleARN, i+1, total, err)
+			}
+
+			cfg.Credentials = credentialsProvider(v)
+
+			continue
+		}
+
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "assuming IAM Role (%s) (%d of %d): %s", ar.RoleARN, i+1, total, err)
+		}
+	}
+
+	v, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "retrieving credentials: %s", err)
+	}
+
+	awsbaseConfig.AccessKey = v.AccessKeyID
+	awsbaseConfig.SecretKey = v.SecretAccessKey
+	awsbaseConfig.Token = v.SessionToken
+	awsbaseConfig.Profile = ""
+	awsbaseConfig.AssumeRole = nil
+	awsbaseConfig.AssumeRoleWithWebIdentity = nil
+
+	return cfg.Credentials, diags
+}
+
+func (ar AssumeRole) assumeRoleOptions(opts *stscreds.AssumeRoleOptions) {
+	opts.RoleSessionName = ar.SessionName
+	opts.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		opts.ExternalID = aws.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		opts.Policy = aws.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		opts.PolicyARNs = append(opts.PolicyARNs, awstypes.PolicyDescriptorType{Arn: aws.String(v)})
+	}
+
+	if ar.SourceIdentity != "" {
+		opts.SourceIdentity = aws.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		opts.Tags = append(opts.Tags, awstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+
+	opts.TransitiveTagKeys = ar.TransitiveTagKeys
+
+	if ar.SerialNumber != "" {
+		opts.SerialNumber = aws.String(ar.SerialNumber)
+
+		switch {
+		case ar.TokenCode != "":
+			opts.TokenProvider = func() (string, error) {
+				return ar.TokenCode, nil
+			}
+		case ar.TokenCommand != "":
+			command := ar.TokenCommand
+			opts.TokenProvider = func() (string, error) {
+				output, err := executeCommand(context.Background(), command, tokenCommandTimeout)
+				if err != nil {
+					return "", fmt.Errorf("executing MFA token command: %w", err)
+				}
+
+				return strings.TrimSpace(string(output)), nil
+			}
+		}
+	}
+}
+
+// samlCredentialsProvider assumes an IAM Role using a SAML assertion.
+// A SAML assertion file is read each time the role is assumed, so the credentials can be refreshed if the file is updated.
+type samlCredentialsProvider struct {
+	assumeRole *AssumeRoleWithSAML
+	client     *sts.Client
+}
+
+func (p *samlCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	return assumeRoleWithSAML(ctx, p.client, p.assumeRole)
+}
+
+func assumeRoleWithSAML(ctx context.Context, conn *sts.Client, ar *AssumeRoleWithSAML) (aws.Credentials, error) {
+	assertion, err := ar.assertion()
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	input := sts.AssumeRoleWithSAMLInput{
+		PrincipalArn:  aws.String(ar.PrincipalARN),
+		RoleArn:       aws.String(ar.RoleARN),
+		SAMLAssertion: aws.String(assertion),
+	}
+
+	if ar.Duration != 0 {
+		input.DurationSeconds = aws.Int32(int32(ar.Duration / time.Second))
+	}
+
+	if ar.Policy != "" {
+		input.Policy = aws.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{Arn: aws.String(v)})
+	}
+
+	output, err := conn.AssumeRoleWithSAML(ctx, &input)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     aws.ToString(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.ToString(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.ToString(output.Credentials.SessionToken),
+		Source:          "AssumeRoleWithSAMLProvider",
+		CanExpire:       true,
+		Expires:         aws.ToTime(output.Credentials.Expiration),
+	}, nil
+}
+
+func newSTSClient(cfg aws.Config, awsbaseConfig *awsbase.Config, credentials aws.CredentialsProvider) *sts.Client {
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+		if v := awsbaseConfig.StsRegion; v != "" {
+			o.Region = v
+		}
+		if v := awsbaseConfig.StsEndpoint; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+}
+
+func credentialsProvider(v aws.Credentials) aws.CredentialsProvider {
+	return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return v, nil
+	})
+}
+
+// executeCommand runs the specified command using the platform's shell and returns its standard output.
+// The command is stopped after the specified timeout. Its standard input is empty, as the provider's standard input
+// is not a terminal, so a command that prompts for input fails instead of waiting indefinitely.
+func executeCommand(ctx context.Context, command string, timeout time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.Stdin = nil
+	// Don't wait for any processes started by the command that keep its output open.
+	cmd.WaitDelay = time.Second
+
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("timed out after %s: %w", timeout, err)
+		}
+		if v := strings.TrimSpace(stderr.String()); v != "" {
+			return nil, fmt.Errorf("%w: %s", err, v)
+		}
+		return nil, err
+	}
+
+	return output, nil
+}
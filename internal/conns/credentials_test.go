@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecuteCommand(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("commands are POSIX shell commands")
+	}
+
+	testCases := map[string]struct {
+		command       string
+		timeout       time.Duration
+		expected      string
+		expectedError string
+	}{
+		"output": {
+			command:  "echo 123456",
+			timeout:  time.Minute,
+			expected: "123456\n",
+		},
+		"standard input is empty": {
+			command:  "read -r code; echo \"[$code]\"",
+			timeout:  time.Minute,
+			expected: "[]\n",
+		},
+		"standard error": {
+			command:       "echo 'no MFA device' >&2; exit 1",
+			timeout:       time.Minute,
+			expectedError: "exit status 1: no MFA device",
+		},
+		"timeout": {
+			command:       "echo 'touch your security key' >&2; sleep 60",
+			timeout:       100 * time.Millisecond,
+			expectedError: "timed out after 100ms",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := executeCommand(context.Background(), testCase.command, testCase.timeout)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got output %q", testCase.expectedError, output)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Errorf("expected error containing %q, got %q", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := string(output), testCase.expected; got != want {
+				t.Errorf("output = %q, want %q", got, want)
+			}
+		})
+	}
+}
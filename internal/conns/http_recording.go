@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+const (
+	replayAccessKey = "mock_access_key"
+	replaySecretKey = "mock_secret_key"
+)
+
+// newHTTPRecordingClient returns an HTTP client that records or replays AWS API interactions.
+// The client is not built by aws-sdk-go-base so proxies are configured only by environment variables.
+func newHTTPRecordingClient(ctx context.Context, mode, path string) (*http.Client, error) {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	v, err := vcr.NewSessionTransport(ctx, mode, path, transport)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = v
+
+	return httpClient, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+// RateLimit is a client-side request rate limit.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// ServiceRateLimits are the client-side request rate limits for a service package's AWS API.
+// A zero RequestsPerSecond means the API as a whole is not rate limited.
+type ServiceRateLimits struct {
+	RateLimit
+	Operations map[string]RateLimit
+}
+
+// serviceRateLimiter enforces a service package's rate limits.
+// It is shared by all of the service package's API clients, including those for overridden Regions.
+type serviceRateLimiter struct {
+	service    *ratelimit.Limiter
+	operations map[string]*ratelimit.Limiter
+}
+
+func newServiceRateLimiter(limits ServiceRateLimits) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		operations: make(map[string]*ratelimit.Limiter, len(limits.Operations)),
+	}
+
+	if v := limits.RequestsPerSecond; v > 0 {
+		l.service = ratelimit.NewLimiter(v, limits.Burst)
+	}
+
+	for k, v := range limits.Operations {
+		l.operations[k] = ratelimit.NewLimiter(v.RequestsPerSecond, v.Burst)
+	}
+
+	return l
+}
+
+// APIOptions returns the AWS SDK for Go v2 API client middleware for a service package's API client.
+// The middleware enforces any client-side rate limits and logs throttled requests.
+func APIOptions(_ context.Context, config map[string]any) []func(*middleware.Stack) error {
+	apiOptions := []func(*middleware.Stack) error{
+		addThrottleLoggingMiddleware,
+	}
+
+	if v, ok := config["rate_limiter"].(*serviceRateLimiter); ok && v != nil {
+		apiOptions = append(apiOptions, v.addMiddleware)
+	}
+
+	return apiOptions
+}
+
+func (l *serviceRateLimiter) addMiddleware(stack *middleware.Stack) error {
+	// Inserted after the retry middleware so that each attempt is rate limited.
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("TFRateLimit", l.handleFinalize), "Retry", middleware.After)
+}
+
+func (l *serviceRateLimiter) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	for _, limiter := range []*ratelimit.Limiter{l.operations[operation], l.service} {
+		if limiter == nil {
+			continue
+		}
+
+		delay, err := limiter.Wait(ctx)
+		if err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		if delay > 0 {
+			tflog.Debug(ctx, "Client-side rate limit delayed AWS API request", map[string]any{
+				"tf_aws.rate_limit.delay": delay.String(),
+				"tf_aws.service_id":       awsmiddleware.GetServiceID(ctx),
+				"tf_aws.operation":        operation,
+			})
+		}
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
+
+func addThrottleLoggingMiddleware(stack *middleware.Stack) error {
+	// Inserted after the retry middleware so that each throttled attempt is logged.
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("TFThrottleLogging", handleThrottleLogging), "Retry", middleware.After)
+}
+
+func handleThrottleLogging(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if apiErr, ok := errs.As[smithy.APIError](err); ok {
+		if _, ok := retry.DefaultThrottleErrorCodes[apiErr.ErrorCode()]; ok {
+			tflog.Warn(ctx, "AWS API request throttled", map[string]any{
+				"tf_aws.service_id": awsmiddleware.GetServiceID(ctx),
+				"tf_aws.operation":  awsmiddleware.GetOperationName(ctx),
+				"error_code":        apiErr.ErrorCode(),
+			})
+		}
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+type stubHTTPClient struct {
+	requests int
+}
+
+func (c *stubHTTPClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body: io.NopCloser(strings.NewReader(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult>
+</GetCallerIdentityResponse>`)),
+	}, nil
+}
+
+func TestAPIOptionsRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	httpClient := &stubHTTPClient{}
+	config := map[string]any{
+		"rate_limiter": newServiceRateLimiter(ServiceRateLimits{
+			Operations: map[string]RateLimit{
+				"GetCallerIdentity": {RequestsPerSecond: 0.001, Burst: 1},
+			},
+		}),
+	}
+	client := sts.New(sts.Options{
+		APIOptions:  APIOptions(ctx, config),
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  httpClient,
+		Region:      "us-west-2", //lintignore:AWSAT003
+	})
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("first request: %s", err)
+	}
+
+	// The operation's burst is exhausted.
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("second request: expected deadline exceeded, got %v", err)
+	}
+
+	if got, want := httpClient.requests, 1; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// importIDRegionSeparator separates a resource's import ID from a per-resource Region override,
+// e.g. `vpc-0123456789abcdef0@us-west-2`.
+const importIDRegionSeparator = "@"
+
+// WithOverrideRegion returns a copy of Context with the specified per-resource Region override.
+// An empty Region removes any override.
+func WithOverrideRegion(ctx context.Context, region string) context.Context {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	v := *inContext
+	v.OverrideRegion = region
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+// SplitImportIDRegion splits an import ID of the form `id@region` into the resource's ID and Region.
+// If the import ID has no recognizable Region suffix then the ID is returned unchanged and the Region is empty.
+func SplitImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+	if i < 0 {
+		return id, ""
+	}
+
+	region := id[i+len(importIDRegionSeparator):]
+	if _, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok {
+		return id, ""
+	}
+
+	return id[:i], region
+}
+
+// ValidateOverrideRegion returns an error if the specified per-resource Region override is not in the configured AWS partition.
+func (c *AWSClient) ValidateOverrideRegion(ctx context.Context, region string) error {
+	if region == "" || region == c.region {
+		return nil
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		// Allow Regions not yet known to the endpoints metadata.
+		return nil
+	}
+
+	if got, want := partition.ID(), c.Partition(ctx); want != "" && got != want {
+		return fmt.Errorf("Region (%s) is in partition (%s), provider is configured for partition (%s)", region, got, want)
+	}
+
+	return nil
+}
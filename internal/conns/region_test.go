@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		Name                 string
+		Context              func() context.Context
+		ExpectedRegion       string
+		ExpectedOverridden   bool
+		ExpectedRegionalHost string
+	}{
+		{
+			Name:                 "no resource context",
+			Context:              context.TODO,
+			ExpectedRegion:       "us-west-2",                    //lintignore:AWSAT003
+			ExpectedRegionalHost: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "no override",
+			Context: func() context.Context {
+				return NewResourceContext(context.TODO(), "Test", "Test")
+			},
+			ExpectedRegion:       "us-west-2",                    //lintignore:AWSAT003
+			ExpectedRegionalHost: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "same as default",
+			Context: func() context.Context {
+				return WithOverrideRegion(NewResourceContext(context.TODO(), "Test", "Test"), "us-west-2") //lintignore:AWSAT003
+			},
+			ExpectedRegion:       "us-west-2",                    //lintignore:AWSAT003
+			ExpectedRegionalHost: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			Context: func() context.Context {
+				return WithOverrideRegion(NewResourceContext(context.TODO(), "Test", "Test"), "eu-west-1") //lintignore:AWSAT003
+			},
+			ExpectedRegion:       "eu-west-1", //lintignore:AWSAT003
+			ExpectedOverridden:   true,
+			ExpectedRegionalHost: "test.eu-west-1.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "override removed",
+			Context: func() context.Context {
+				ctx := WithOverrideRegion(NewResourceContext(context.TODO(), "Test", "Test"), "eu-west-1") //lintignore:AWSAT003
+				return WithOverrideRegion(ctx, "")
+			},
+			ExpectedRegion:       "us-west-2",                    //lintignore:AWSAT003
+			ExpectedRegionalHost: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.Context()
+
+			if got, want := client.Region(ctx), testCase.ExpectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+			if got, want := client.DefaultRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+				t.Errorf("DefaultRegion: got %s, expected %s", got, want)
+			}
+			if got, want := client.IsRegionOverridden(ctx), testCase.ExpectedOverridden; got != want {
+				t.Errorf("IsRegionOverridden: got %t, expected %t", got, want)
+			}
+			if got, want := client.RegionalHostname(ctx, "test"), testCase.ExpectedRegionalHost; got != want {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Input          string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "empty",
+			Input:      "",
+			ExpectedID: "",
+		},
+		{
+			Name:       "no Region",
+			Input:      "vpc-0123456789abcdef0",
+			ExpectedID: "vpc-0123456789abcdef0",
+		},
+		{
+			Name:           "Region",
+			Input:          "vpc-0123456789abcdef0@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-0123456789abcdef0",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:           "GovCloud Region",
+			Input:          "vpc-0123456789abcdef0@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-0123456789abcdef0",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:       "email address",
+			Input:      "someone@example.com",
+			ExpectedID: "someone@example.com",
+		},
+		{
+			Name:           "multiple separators",
+			Input:          "someone@example.com@ap-southeast-2", //lintignore:AWSAT003
+			ExpectedID:     "someone@example.com",
+			ExpectedRegion: "ap-southeast-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.Input)
+
+			if got, want := gotID, testCase.ExpectedID; got != want {
+				t.Errorf("ID: got %s, expected %s", got, want)
+			}
+			if got, want := gotRegion, testCase.ExpectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateOverrideRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		Name        string
+		Region      string
+		ExpectError bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:   "default",
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:   "same partition",
+			Region: "eu-central-1", //lintignore:AWSAT003
+		},
+		{
+			Name:        "different partition",
+			Region:      "cn-north-1", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.ValidateOverrideRegion(ctx, testCase.Region)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error %t", err, want)
+			}
+		})
+	}
+}
@@ -16,3 +16,8 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetResourceAdoptionConfig is only intended for use in tests
+func SetResourceAdoptionConfig(client *AWSClient, c *ResourceAdoptionConfig) {
+	client.resourceAdoptionConfig = c
+}
@@ -66,6 +66,18 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against a local AWS API emulator, the emulator's endpoint URL, e.g. http://localhost:4566
+	AccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
+
+	// For tests run against a local AWS API emulator, a comma-separated list of the services the emulator supports.
+	// Services are identified by provider package name (e.g. elbv2) or AWS SDK service ID (e.g. Elastic Load Balancing v2).
+	// Tests for other services are skipped. If not set, all services are assumed to be supported.
+	AccEmulatorServices = "TF_ACC_EMULATOR_SERVICES"
+
+	// For tests run against a local AWS API emulator without STS support, the account ID the emulator uses in ARNs.
+	// Defaults to 000000000000.
+	AccEmulatorAccountID = "TF_ACC_EMULATOR_ACCOUNT_ID"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
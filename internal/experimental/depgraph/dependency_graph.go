@@ -263,3 +263,42 @@ func dependencyCycleError(path []string) error {
 func nonExistentNodeError(s string) error {
 	return fmt.Errorf("node does not exist: %s", s)
 }
+
+// Layers returns the nodes grouped into layers such that every node's dependencies are in earlier layers.
+// Nodes in the same layer do not depend on each other and can be processed concurrently.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Layers() ([][]string, error) {
+	// Look for cycles.
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[string]int, g.Len())
+	for _, node := range g.nodes {
+		remaining[node] = len(g.outgoingEdges[node])
+	}
+
+	layers := make([][]string, 0)
+
+	for len(remaining) > 0 {
+		layer := make([]string, 0)
+
+		for _, node := range g.nodes {
+			if n, ok := remaining[node]; ok && n == 0 {
+				layer = append(layer, node)
+			}
+		}
+
+		for _, node := range layer {
+			delete(remaining, node)
+
+			for _, dependent := range g.incomingEdges[node] {
+				remaining[dependent]--
+			}
+		}
+
+		layers = append(layers, layer)
+	}
+
+	return layers, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements stateful, in-memory fakes of a subset of AWS APIs.
+//
+// A Backend is an http.RoundTripper and is installed as the transport of the HTTP client
+// used by the provider, in the same way as the VCR recorder. Requests are routed to a
+// service fake using the service name in the request's Signature Version 4 credential scope,
+// so no endpoint configuration is needed. Requests are not authenticated.
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID used by a Backend unless overridden.
+	DefaultAccountID = "123456789012"
+	// DefaultRegion is the AWS Region used in Backend configurations.
+	DefaultRegion = "us-west-2" // lintignore:AWSAT003
+
+	accessKeyID     = "AKIAFAKEAWSBACKEND00"
+	secretAccessKey = "fakeaws-secret-access-key" // nosemgrep:ci.hardcoded-credentials
+)
+
+// service is implemented by each service fake.
+type service interface {
+	// signingName returns the service's Signature Version 4 signing name.
+	signingName() string
+	handle(w http.ResponseWriter, r *http.Request, rc *requestContext)
+}
+
+// requestContext describes the caller of an AWS API operation.
+type requestContext struct {
+	accountID string
+	partition string
+	region    string
+	requestID string
+}
+
+// Backend is an in-memory fake of a set of AWS APIs.
+// A Backend is safe for concurrent use.
+type Backend struct {
+	accountID string
+	// mu serializes AWS API operations, so service fakes don't need their own locking.
+	mu        sync.Mutex
+	requestID atomic.Int64
+	services  map[string]service
+}
+
+// Option configures a Backend.
+type Option func(*Backend)
+
+// WithAccountID sets the AWS account ID that owns the Backend's resources.
+func WithAccountID(accountID string) Option {
+	return func(b *Backend) {
+		b.accountID = accountID
+	}
+}
+
+// New returns a Backend with fakes of the IAM, KMS, S3, SQS, SSM and STS APIs.
+func New(optFns ...Option) *Backend {
+	b := &Backend{
+		accountID: DefaultAccountID,
+		services:  make(map[string]service),
+	}
+
+	for _, fn := range optFns {
+		fn(b)
+	}
+
+	for _, v := range []service{
+		newIAM(),
+		newKMS(),
+		newS3(),
+		newSQS(),
+		newSSM(),
+		newSTS(),
+	} {
+		b.services[v.signingName()] = v
+	}
+
+	return b
+}
+
+// AccountID returns the AWS account ID that owns the Backend's resources.
+func (b *Backend) AccountID() string {
+	return b.accountID
+}
+
+// HTTPClient returns an HTTP client that sends requests to the Backend.
+func (b *Backend) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: b,
+	}
+}
+
+// Config returns an AWS SDK configuration that sends requests to the Backend.
+// It can be used to create API clients that set up or modify resources out of band, for example to simulate drift.
+func (b *Backend) Config(region string) aws.Config {
+	return aws.Config{
+		Credentials:      credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+		HTTPClient:       b.HTTPClient(),
+		Region:           region,
+		RetryMaxAttempts: 1,
+	}
+}
+
+// Credentials returns the static credentials accepted by the Backend.
+func Credentials() (string, string) {
+	return accessKeyID, secretAccessKey
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]{8}/([a-z0-9-]+)/([a-z0-9-]+)/aws4_request`)
+
+// RoundTrip implements http.RoundTripper.
+func (b *Backend) RoundTrip(r *http.Request) (*http.Response, error) {
+	var region, signingName string
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		region, signingName = m[1], m[2]
+	} else if v, ok := s3UnsignedRequestRegion(r); ok {
+		// Some S3 operations, e.g. looking up a bucket's Region, use anonymous credentials.
+		region, signingName = v, "s3"
+	} else {
+		return nil, fmt.Errorf("fakeaws: request to %s is not signed with Signature Version 4", r.URL)
+	}
+
+	s, ok := b.services[signingName]
+	if !ok {
+		return nil, fmt.Errorf("fakeaws: service %q is not implemented", signingName)
+	}
+
+	rc := &requestContext{
+		accountID: b.accountID,
+		partition: names.PartitionForRegion(region).ID(),
+		region:    region,
+		requestID: fmt.Sprintf("00000000-0000-0000-0000-%012d", b.requestID.Add(1)),
+	}
+
+	w := httptest.NewRecorder()
+	w.Header().Set("X-Amzn-Requestid", rc.requestID)
+
+	func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		s.handle(w, r, rc)
+	}()
+
+	response := w.Result()
+	response.Request = r
+
+	return response, nil
+}
+
+func (rc *requestContext) arn(service, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", rc.partition, service, rc.region, rc.accountID, resource)
+}
+
+func (rc *requestContext) globalARN(service, resource string) string {
+	return fmt.Sprintf("arn:%s:%s::%s:%s", rc.partition, service, rc.accountID, resource)
+}
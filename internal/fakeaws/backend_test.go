@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+)
+
+func TestBackend_sts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New(fakeaws.WithAccountID("111122223333"))
+	conn := sts.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), "111122223333"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(output.Arn), "arn:aws:iam::111122223333:root"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+}
+
+func TestBackend_unknownOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New()
+	conn := kms.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+
+	_, err := conn.ListKeyRotations(ctx, &kms.ListKeyRotationsInput{KeyId: aws.String("1234abcd")})
+	if !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		t.Errorf("ListKeyRotations: got error %v, want UnknownOperationException", err)
+	}
+}
+
+func TestBackend_ssm(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New()
+	conn := ssm.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+	name := "/test/parameter"
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Type:  ssmtypes.ParameterTypeSecureString,
+		Value: aws.String("secret"),
+		Tags: []ssmtypes.Tag{
+			{Key: aws.String("Name"), Value: aws.String("test")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	_, err = conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Type:  ssmtypes.ParameterTypeSecureString,
+		Value: aws.String("secret"),
+	})
+	if !errs.IsA[*ssmtypes.ParameterAlreadyExists](err) {
+		t.Errorf("PutParameter: got error %v, want ParameterAlreadyExists", err)
+	}
+
+	output, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(true),
+		Value:     aws.String("updated"),
+	})
+	if err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+	if got, want := output.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	parameter, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+	if got, want := aws.ToString(parameter.Parameter.Value), "updated"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(parameter.Parameter.ARN), "arn:aws:ssm:us-west-2:123456789012:parameter/test/parameter"; got != want { // lintignore:AWSAT003,AWSAT005
+		t.Errorf("ARN = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+	})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+	if got, want := len(tags.TagList), 1; got != want {
+		t.Errorf("len(TagList) = %d, want %d", got, want)
+	}
+
+	// Resources are regional.
+	_, err = ssm.NewFromConfig(backend.Config("us-east-1")).GetParameter(ctx, &ssm.GetParameterInput{ // lintignore:AWSAT003
+		Name: aws.String(name),
+	})
+	if !errs.IsA[*ssmtypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter in other Region: got error %v, want ParameterNotFound", err)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String(name)}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name)})
+	if !errs.IsA[*ssmtypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter after delete: got error %v, want ParameterNotFound", err)
+	}
+}
+
+func TestBackend_sqs(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New()
+	conn := sqs.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+
+	output, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test"),
+		Attributes: map[string]string{
+			string(sqstypes.QueueAttributeNameVisibilityTimeout): "60",
+		},
+		Tags: map[string]string{
+			"Name": "test",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+	queueURL := aws.ToString(output.QueueUrl)
+
+	_, err = conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test"),
+		Attributes: map[string]string{
+			string(sqstypes.QueueAttributeNameVisibilityTimeout): "30",
+		},
+	})
+	if !errs.IsA[*sqstypes.QueueNameExists](err) {
+		t.Errorf("CreateQueue: got error %v, want QueueNameExists", err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes[string(sqstypes.QueueAttributeNameVisibilityTimeout)], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes[string(sqstypes.QueueAttributeNameQueueArn)], "arn:aws:sqs:us-west-2:123456789012:test"; got != want { // lintignore:AWSAT003,AWSAT005
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		t.Fatalf("ListQueueTags: %s", err)
+	}
+	if got, want := tags.Tags["Name"], "test"; got != want {
+		t.Errorf("Tags[Name] = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String("test")})
+	if !errs.IsA[*sqstypes.QueueDoesNotExist](err) {
+		t.Errorf("GetQueueUrl after delete: got error %v, want QueueDoesNotExist", err)
+	}
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueUrl after delete: got error %v, want AWS Query protocol error code", err)
+	}
+}
+
+func TestBackend_kms(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New()
+	conn := kms.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+
+	output, err := conn.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateKey: %s", err)
+	}
+	keyID := aws.ToString(output.KeyMetadata.KeyId)
+
+	if _, err := conn.CreateAlias(ctx, &kms.CreateAliasInput{
+		AliasName:   aws.String("alias/test"),
+		TargetKeyId: aws.String(keyID),
+	}); err != nil {
+		t.Fatalf("CreateAlias: %s", err)
+	}
+
+	key, err := conn.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String("alias/test")})
+	if err != nil {
+		t.Fatalf("DescribeKey: %s", err)
+	}
+	if got, want := aws.ToString(key.KeyMetadata.KeyId), keyID; got != want {
+		t.Errorf("KeyId = %q, want %q", got, want)
+	}
+	if got, want := key.KeyMetadata.KeyState, kmstypes.KeyStateEnabled; got != want {
+		t.Errorf("KeyState = %q, want %q", got, want)
+	}
+
+	if _, err := conn.EnableKeyRotation(ctx, &kms.EnableKeyRotationInput{KeyId: aws.String(keyID)}); err != nil {
+		t.Fatalf("EnableKeyRotation: %s", err)
+	}
+
+	rotation, err := conn.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		t.Fatalf("GetKeyRotationStatus: %s", err)
+	}
+	if !rotation.KeyRotationEnabled {
+		t.Error("KeyRotationEnabled = false, want true")
+	}
+
+	if _, err := conn.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyID),
+		PendingWindowInDays: aws.Int32(7),
+	}); err != nil {
+		t.Fatalf("ScheduleKeyDeletion: %s", err)
+	}
+
+	key, err = conn.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		t.Fatalf("DescribeKey: %s", err)
+	}
+	if got, want := key.KeyMetadata.KeyState, kmstypes.KeyStatePendingDeletion; got != want {
+		t.Errorf("KeyState = %q, want %q", got, want)
+	}
+
+	_, err = conn.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String("alias/missing")})
+	if !errs.IsA[*kmstypes.NotFoundException](err) {
+		t.Errorf("DescribeKey: got error %v, want NotFoundException", err)
+	}
+}
+
+func TestBackend_iam(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	backend := fakeaws.New()
+	conn := iam.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(document),
+		RoleName:                 aws.String("test"),
+		Path:                     aws.String("/service/"),
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	role, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(role.Role.Arn), "arn:aws:iam::123456789012:role/service/test"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+	if got, err := url.QueryUnescape(aws.ToString(role.Role.AssumeRolePolicyDocument)); err != nil || got != document {
+		t.Errorf("AssumeRolePolicyDocument = %q, want %q", got, document)
+	}
+
+	policy, err := conn.CreatePolicy(ctx, &iam.CreatePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+		PolicyName:     aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreatePolicy: %s", err)
+	}
+	policyARN := aws.ToString(policy.Policy.Arn)
+
+	if _, err := conn.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+		PolicyArn: aws.String(policyARN),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("AttachRolePolicy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")})
+	if !tfawserr.ErrCodeEquals(err, "DeleteConflict") {
+		t.Errorf("DeleteRole: got error %v, want DeleteConflict", err)
+	}
+
+	attached, err := conn.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("ListAttachedRolePolicies: %s", err)
+	}
+	if got, want := len(attached.AttachedPolicies), 1; got != want {
+		t.Errorf("len(AttachedPolicies) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+		PolicyArn: aws.String(policyARN),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("DetachRolePolicy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchEntity") {
+		t.Errorf("GetRole after delete: got error %v, want NoSuchEntity", err)
+	}
+}
+
+func TestBackend_s3(t *testing.T) {
+	t.Parallel()
+
+	for name, usePathStyle := range map[string]bool{
+		"virtual host": false,
+		"path style":   true,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			backend := fakeaws.New()
+			conn := s3.NewFromConfig(backend.Config(fakeaws.DefaultRegion), func(o *s3.Options) {
+				o.UsePathStyle = usePathStyle
+			})
+			bucket := "test-bucket"
+
+			if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+				Bucket: aws.String(bucket),
+				CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+					LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+				},
+			}); err != nil {
+				t.Fatalf("CreateBucket: %s", err)
+			}
+
+			// The bucket's Region is looked up using anonymous credentials.
+			region, err := manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
+				o.UsePathStyle = usePathStyle
+			})
+			if err != nil {
+				t.Fatalf("GetBucketRegion: %s", err)
+			}
+			if got, want := region, fakeaws.DefaultRegion; got != want {
+				t.Errorf("GetBucketRegion = %q, want %q", got, want)
+			}
+
+			_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String(bucket)})
+			if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+				t.Errorf("GetBucketPolicy: got error %v, want NoSuchBucketPolicy", err)
+			}
+
+			if _, err := conn.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+				Bucket: aws.String(bucket),
+				Tagging: &s3types.Tagging{
+					TagSet: []s3types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+				},
+			}); err != nil {
+				t.Fatalf("PutBucketTagging: %s", err)
+			}
+
+			tagging, err := conn.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+			if err != nil {
+				t.Fatalf("GetBucketTagging: %s", err)
+			}
+			if got, want := len(tagging.TagSet), 1; got != want {
+				t.Errorf("len(TagSet) = %d, want %d", got, want)
+			}
+
+			if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+				Body:        strings.NewReader("hello"),
+				Bucket:      aws.String(bucket),
+				ContentType: aws.String("text/plain"),
+				Key:         aws.String("dir/object.txt"),
+			}); err != nil {
+				t.Fatalf("PutObject: %s", err)
+			}
+
+			object, err := conn.GetObject(ctx, &s3.GetObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String("dir/object.txt"),
+			})
+			if err != nil {
+				t.Fatalf("GetObject: %s", err)
+			}
+			body, err := io.ReadAll(object.Body)
+			object.Body.Close()
+			if err != nil {
+				t.Fatalf("reading object body: %s", err)
+			}
+			if got, want := string(body), "hello"; got != want {
+				t.Errorf("object body = %q, want %q", got, want)
+			}
+
+			objects, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(bucket),
+				Prefix: aws.String("dir/"),
+			})
+			if err != nil {
+				t.Fatalf("ListObjectsV2: %s", err)
+			}
+			if got, want := aws.ToInt32(objects.KeyCount), int32(1); got != want {
+				t.Errorf("KeyCount = %d, want %d", got, want)
+			}
+
+			_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+			if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+				t.Errorf("DeleteBucket: got error %v, want BucketNotEmpty", err)
+			}
+
+			if _, err := conn.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucket),
+				Delete: &s3types.Delete{
+					Objects: []s3types.ObjectIdentifier{{Key: aws.String("dir/object.txt")}},
+				},
+			}); err != nil {
+				t.Fatalf("DeleteObjects: %s", err)
+			}
+
+			if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+				t.Fatalf("DeleteBucket: %s", err)
+			}
+
+			_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+			if !errs.IsA[*s3types.NotFound](err) {
+				t.Errorf("HeadBucket after delete: got error %v, want NotFound", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,791 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// iamService is a fake of the AWS Identity and Access Management API.
+// Only roles and managed policies are implemented. IAM is a global service,
+// so resources are shared by all Regions.
+type iamService struct {
+	queryHandler
+	nextID   int
+	policies map[string]*iamPolicy // Keyed by ARN.
+	roles    map[string]*iamRole   // Keyed by name.
+}
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	id                       string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	tags                     tags
+}
+
+type iamPolicy struct {
+	arn              string
+	attachmentCount  int
+	createDate       time.Time
+	defaultVersionID string
+	description      string
+	id               string
+	name             string
+	nextVersion      int
+	path             string
+	tags             tags
+	updateDate       time.Time
+	versions         map[string]*iamPolicyVersion // Keyed by version ID.
+}
+
+type iamPolicyVersion struct {
+	createDate time.Time
+	document   string
+	versionID  string
+}
+
+func newIAM() *iamService {
+	s := &iamService{
+		policies: make(map[string]*iamPolicy),
+		roles:    make(map[string]*iamRole),
+	}
+	s.queryHandler = queryHandler{
+		xmlns: "https://iam.amazonaws.com/doc/2010-05-08/",
+		operations: map[string]queryOperation{
+			"AttachRolePolicy":              s.attachRolePolicy,
+			"CreatePolicy":                  s.createPolicy,
+			"CreatePolicyVersion":           s.createPolicyVersion,
+			"CreateRole":                    s.createRole,
+			"DeletePolicy":                  s.deletePolicy,
+			"DeletePolicyVersion":           s.deletePolicyVersion,
+			"DeleteRole":                    s.deleteRole,
+			"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+			"DeleteRolePolicy":              s.deleteRolePolicy,
+			"DetachRolePolicy":              s.detachRolePolicy,
+			"GetPolicy":                     s.getPolicy,
+			"GetPolicyVersion":              s.getPolicyVersion,
+			"GetRole":                       s.getRole,
+			"GetRolePolicy":                 s.getRolePolicy,
+			"ListAttachedRolePolicies":      s.listAttachedRolePolicies,
+			"ListInstanceProfilesForRole":   s.listInstanceProfilesForRole,
+			"ListPolicyTags":                s.listPolicyTags,
+			"ListPolicyVersions":            s.listPolicyVersions,
+			"ListRolePolicies":              s.listRolePolicies,
+			"ListRoleTags":                  s.listRoleTags,
+			"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+			"PutRolePolicy":                 s.putRolePolicy,
+			"TagPolicy":                     s.tagPolicy,
+			"TagRole":                       s.tagRole,
+			"UntagPolicy":                   s.untagPolicy,
+			"UntagRole":                     s.untagRole,
+			"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+			"UpdateRole":                    s.updateRole,
+			"UpdateRoleDescription":         s.updateRole,
+		},
+	}
+
+	return s
+}
+
+func (s *iamService) signingName() string {
+	return "iam"
+}
+
+// newID returns a unique ID with the specified prefix, e.g. "AROA" for roles.
+func (s *iamService) newID(prefix string) string {
+	s.nextID++
+
+	return fmt.Sprintf("%sFAKE%013d", prefix, s.nextID)
+}
+
+func iamNoSuchEntityError(format string, a ...any) *apiError {
+	return notFoundError("NoSuchEntity", format, a...)
+}
+
+func iamConflictError(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusConflict, code, format, a...)
+}
+
+// iamPath returns the normalized value of a Path parameter.
+func iamPath(input url.Values) (string, error) {
+	path := input.Get("Path")
+	if path == "" {
+		return "/", nil
+	}
+
+	if !strings.HasPrefix(path, "/") || !strings.HasSuffix(path, "/") {
+		return "", validationError("The specified value for path is invalid. It must begin and end with / and contain only alphanumeric characters and/or / characters.")
+	}
+
+	return path, nil
+}
+
+func (s *iamService) findRole(input url.Values) (*iamRole, error) {
+	name := input.Get("RoleName")
+
+	r, ok := s.roles[name]
+	if !ok {
+		return nil, iamNoSuchEntityError("The role with name %s cannot be found.", name)
+	}
+
+	return r, nil
+}
+
+func (s *iamService) findPolicy(input url.Values) (*iamPolicy, error) {
+	arn := input.Get("PolicyArn")
+
+	p, ok := s.policies[arn]
+	if !ok {
+		return nil, iamNoSuchEntityError("Policy %s does not exist or is not attachable.", arn)
+	}
+
+	return p, nil
+}
+
+// iamPage returns a page of values using the Marker and MaxItems parameters.
+func iamPage[T any](values []T, input url.Values) ([]T, iamPagination, error) {
+	maxItems, _ := strconv.Atoi(input.Get("MaxItems"))
+
+	page, marker, err := paginate(values, input.Get("Marker"), maxItems, 100)
+	if err != nil {
+		return nil, iamPagination{}, err
+	}
+
+	return page, iamPagination{IsTruncated: marker != "", Marker: marker}, nil
+}
+
+type iamPagination struct {
+	IsTruncated bool   `xml:"IsTruncated"`
+	Marker      string `xml:"Marker,omitempty"`
+}
+
+type iamRoleOutput struct {
+	Arn                      string               `xml:"Arn"`
+	AssumeRolePolicyDocument string               `xml:"AssumeRolePolicyDocument"`
+	CreateDate               time.Time            `xml:"CreateDate"`
+	Description              string               `xml:"Description,omitempty"`
+	MaxSessionDuration       int                  `xml:"MaxSessionDuration"`
+	Path                     string               `xml:"Path"`
+	PermissionsBoundary      *iamAttachedBoundary `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string               `xml:"RoleId"`
+	RoleName                 string               `xml:"RoleName"`
+	Tags                     []tag                `xml:"Tags>member,omitempty"`
+}
+
+type iamAttachedBoundary struct {
+	PermissionsBoundaryArn  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+func (r *iamRole) output() iamRoleOutput {
+	v := iamRoleOutput{
+		Arn: r.arn,
+		// Policy documents are returned URL-encoded.
+		AssumeRolePolicyDocument: url.QueryEscape(r.assumeRolePolicyDocument),
+		CreateDate:               r.createDate,
+		Description:              r.description,
+		MaxSessionDuration:       r.maxSessionDuration,
+		Path:                     r.path,
+		RoleID:                   r.id,
+		RoleName:                 r.name,
+		Tags:                     r.tags.list(),
+	}
+
+	if r.permissionsBoundary != "" {
+		v.PermissionsBoundary = &iamAttachedBoundary{
+			PermissionsBoundaryArn:  r.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+type iamRoleResult struct {
+	Role iamRoleOutput `xml:"Role"`
+}
+
+func (s *iamService) createRole(rc *requestContext, input url.Values) (any, error) {
+	name := input.Get("RoleName")
+	if name == "" {
+		return nil, validationError("1 validation error detected: Value null at 'roleName' failed to satisfy constraint: Member must not be null")
+	}
+
+	if _, ok := s.roles[name]; ok {
+		return nil, iamConflictError("EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	path, err := iamPath(input)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSessionDuration := 3600
+	if v := input.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	r := &iamRole{
+		arn:                      rc.globalARN("iam", "role"+path+name),
+		assumeRolePolicyDocument: input.Get("AssumeRolePolicyDocument"),
+		createDate:               time.Now().UTC().Truncate(time.Second),
+		description:              input.Get("Description"),
+		id:                       s.newID("AROA"),
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     path,
+		permissionsBoundary:      input.Get("PermissionsBoundary"),
+		tags:                     make(tags),
+	}
+	r.tags.set(queryTags(input, "Tags"))
+	s.roles[name] = r
+
+	return iamRoleResult{Role: r.output()}, nil
+}
+
+func (s *iamService) getRole(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return iamRoleResult{Role: r.output()}, nil
+}
+
+func (s *iamService) updateRole(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := input["Description"]; ok {
+		r.description = input.Get("Description")
+	}
+	if v := input.Get("MaxSessionDuration"); v != "" {
+		r.maxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	if input.Get("Action") == "UpdateRoleDescription" {
+		return iamRoleResult{Role: r.output()}, nil
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.assumeRolePolicyDocument = input.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = input.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRole(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.attachedPolicyARNs) > 0 || len(r.inlinePolicies) > 0 {
+		return nil, iamConflictError("DeleteConflict", "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(s.roles, r.name)
+
+	return nil, nil
+}
+
+func (s *iamService) tagRole(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags.set(queryTags(input, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags.unset(queryList(input, "TagKeys"))
+
+	return nil, nil
+}
+
+type iamTagsResult struct {
+	iamPagination
+	Tags []tag `xml:"Tags>member"`
+}
+
+func (s *iamService) listRoleTags(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pagination, err := iamPage(r.tags.list(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return iamTagsResult{iamPagination: pagination, Tags: page}, nil
+}
+
+func (s *iamService) putRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.inlinePolicies[input.Get("PolicyName")] = input.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+type getRolePolicyResult struct {
+	PolicyDocument string `xml:"PolicyDocument"`
+	PolicyName     string `xml:"PolicyName"`
+	RoleName       string `xml:"RoleName"`
+}
+
+func (s *iamService) getRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.Get("PolicyName")
+	document, ok := r.inlinePolicies[name]
+	if !ok {
+		return nil, iamNoSuchEntityError("The role policy with name %s cannot be found.", name)
+	}
+
+	return getRolePolicyResult{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     name,
+		RoleName:       r.name,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.Get("PolicyName")
+	if _, ok := r.inlinePolicies[name]; !ok {
+		return nil, iamNoSuchEntityError("The role policy with name %s cannot be found.", name)
+	}
+
+	delete(r.inlinePolicies, name)
+
+	return nil, nil
+}
+
+type listRolePoliciesResult struct {
+	iamPagination
+	PolicyNames []string `xml:"PolicyNames>member"`
+}
+
+func (s *iamService) listRolePolicies(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pagination, err := iamPage(sortedKeys(r.inlinePolicies), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return listRolePoliciesResult{iamPagination: pagination, PolicyNames: page}, nil
+}
+
+func (s *iamService) attachRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(r.attachedPolicyARNs, p.arn) {
+		r.attachedPolicyARNs = append(r.attachedPolicyARNs, p.arn)
+		p.attachmentCount++
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	arn := input.Get("PolicyArn")
+	i := slices.Index(r.attachedPolicyARNs, arn)
+	if i < 0 {
+		return nil, iamNoSuchEntityError("Policy %s was not found.", arn)
+	}
+
+	r.attachedPolicyARNs = slices.Delete(r.attachedPolicyARNs, i, i+1)
+	if p, ok := s.policies[arn]; ok {
+		p.attachmentCount--
+	}
+
+	return nil, nil
+}
+
+type iamAttachedPolicy struct {
+	PolicyArn  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+type listAttachedRolePoliciesResult struct {
+	iamPagination
+	AttachedPolicies []iamAttachedPolicy `xml:"AttachedPolicies>member"`
+}
+
+func (s *iamService) listAttachedRolePolicies(_ *requestContext, input url.Values) (any, error) {
+	r, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []iamAttachedPolicy
+	for _, arn := range r.attachedPolicyARNs {
+		policies = append(policies, iamAttachedPolicy{
+			PolicyArn:  arn,
+			PolicyName: arn[strings.LastIndex(arn, "/")+1:],
+		})
+	}
+
+	page, pagination, err := iamPage(policies, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAttachedRolePoliciesResult{iamPagination: pagination, AttachedPolicies: page}, nil
+}
+
+type listInstanceProfilesForRoleResult struct {
+	iamPagination
+	InstanceProfiles []struct{} `xml:"InstanceProfiles"`
+}
+
+// listInstanceProfilesForRole always returns an empty list, as instance profiles are not implemented.
+func (s *iamService) listInstanceProfilesForRole(_ *requestContext, input url.Values) (any, error) {
+	if _, err := s.findRole(input); err != nil {
+		return nil, err
+	}
+
+	return listInstanceProfilesForRoleResult{}, nil
+}
+
+type iamPolicyOutput struct {
+	Arn              string    `xml:"Arn"`
+	AttachmentCount  int       `xml:"AttachmentCount"`
+	CreateDate       time.Time `xml:"CreateDate"`
+	DefaultVersionID string    `xml:"DefaultVersionId"`
+	Description      string    `xml:"Description,omitempty"`
+	IsAttachable     bool      `xml:"IsAttachable"`
+	Path             string    `xml:"Path"`
+	PolicyID         string    `xml:"PolicyId"`
+	PolicyName       string    `xml:"PolicyName"`
+	Tags             []tag     `xml:"Tags>member,omitempty"`
+	UpdateDate       time.Time `xml:"UpdateDate"`
+}
+
+func (p *iamPolicy) output() iamPolicyOutput {
+	return iamPolicyOutput{
+		Arn:              p.arn,
+		AttachmentCount:  p.attachmentCount,
+		CreateDate:       p.createDate,
+		DefaultVersionID: p.defaultVersionID,
+		Description:      p.description,
+		IsAttachable:     true,
+		Path:             p.path,
+		PolicyID:         p.id,
+		PolicyName:       p.name,
+		Tags:             p.tags.list(),
+		UpdateDate:       p.updateDate,
+	}
+}
+
+type iamPolicyResult struct {
+	Policy iamPolicyOutput `xml:"Policy"`
+}
+
+type iamPolicyVersionOutput struct {
+	CreateDate       time.Time `xml:"CreateDate"`
+	Document         string    `xml:"Document,omitempty"`
+	IsDefaultVersion bool      `xml:"IsDefaultVersion"`
+	VersionID        string    `xml:"VersionId"`
+}
+
+func (p *iamPolicy) versionOutput(v *iamPolicyVersion, withDocument bool) iamPolicyVersionOutput {
+	output := iamPolicyVersionOutput{
+		CreateDate:       v.createDate,
+		IsDefaultVersion: v.versionID == p.defaultVersionID,
+		VersionID:        v.versionID,
+	}
+
+	if withDocument {
+		output.Document = url.QueryEscape(v.document)
+	}
+
+	return output
+}
+
+type iamPolicyVersionResult struct {
+	PolicyVersion iamPolicyVersionOutput `xml:"PolicyVersion"`
+}
+
+func (p *iamPolicy) addVersion(document string, setAsDefault bool) *iamPolicyVersion {
+	p.nextVersion++
+
+	v := &iamPolicyVersion{
+		createDate: time.Now().UTC().Truncate(time.Second),
+		document:   document,
+		versionID:  fmt.Sprintf("v%d", p.nextVersion),
+	}
+	p.versions[v.versionID] = v
+	p.updateDate = v.createDate
+
+	if setAsDefault {
+		p.defaultVersionID = v.versionID
+	}
+
+	return v
+}
+
+func (s *iamService) createPolicy(rc *requestContext, input url.Values) (any, error) {
+	name := input.Get("PolicyName")
+	if name == "" {
+		return nil, validationError("1 validation error detected: Value null at 'policyName' failed to satisfy constraint: Member must not be null")
+	}
+
+	path, err := iamPath(input)
+	if err != nil {
+		return nil, err
+	}
+
+	arn := rc.globalARN("iam", "policy"+path+name)
+	if _, ok := s.policies[arn]; ok {
+		return nil, iamConflictError("EntityAlreadyExists", "A policy called %s already exists. Duplicate names are not allowed.", name)
+	}
+
+	p := &iamPolicy{
+		arn:         arn,
+		createDate:  time.Now().UTC().Truncate(time.Second),
+		description: input.Get("Description"),
+		id:          s.newID("ANPA"),
+		name:        name,
+		path:        path,
+		tags:        make(tags),
+		versions:    make(map[string]*iamPolicyVersion),
+	}
+	p.tags.set(queryTags(input, "Tags"))
+	p.addVersion(input.Get("PolicyDocument"), true)
+	s.policies[arn] = p
+
+	return iamPolicyResult{Policy: p.output()}, nil
+}
+
+func (s *iamService) getPolicy(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return iamPolicyResult{Policy: p.output()}, nil
+}
+
+func (s *iamService) deletePolicy(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.attachmentCount > 0 {
+		return nil, iamConflictError("DeleteConflict", "Cannot delete a policy attached to entities.")
+	}
+	if len(p.versions) > 1 {
+		return nil, iamConflictError("DeleteConflict", "This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+	}
+
+	delete(s.policies, p.arn)
+
+	return nil, nil
+}
+
+func (s *iamService) createPolicyVersion(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.versions) >= 5 {
+		return nil, iamConflictError("LimitExceeded", "A managed policy can have up to 5 versions. Before you create a new version, you must delete an existing version.")
+	}
+
+	v := p.addVersion(input.Get("PolicyDocument"), input.Get("SetAsDefault") == "true")
+
+	return iamPolicyVersionResult{PolicyVersion: p.versionOutput(v, false)}, nil
+}
+
+func (s *iamService) findPolicyVersion(input url.Values) (*iamPolicy, *iamPolicyVersion, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := input.Get("VersionId")
+	v, ok := p.versions[id]
+	if !ok {
+		return nil, nil, iamNoSuchEntityError("Policy %s version %s does not exist or is not attachable.", p.arn, id)
+	}
+
+	return p, v, nil
+}
+
+func (s *iamService) getPolicyVersion(_ *requestContext, input url.Values) (any, error) {
+	p, v, err := s.findPolicyVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return iamPolicyVersionResult{PolicyVersion: p.versionOutput(v, true)}, nil
+}
+
+func (s *iamService) deletePolicyVersion(_ *requestContext, input url.Values) (any, error) {
+	p, v, err := s.findPolicyVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.versionID == p.defaultVersionID {
+		return nil, iamConflictError("DeleteConflict", "Cannot delete the default version of a policy.")
+	}
+
+	delete(p.versions, v.versionID)
+
+	return nil, nil
+}
+
+type listPolicyVersionsResult struct {
+	iamPagination
+	Versions []iamPolicyVersionOutput `xml:"Versions>member"`
+}
+
+func (s *iamService) listPolicyVersions(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []iamPolicyVersionOutput
+	for _, v := range p.versions {
+		versions = append(versions, p.versionOutput(v, false))
+	}
+	// Newest first.
+	slices.SortFunc(versions, func(a, b iamPolicyVersionOutput) int {
+		x, _ := strconv.Atoi(strings.TrimPrefix(a.VersionID, "v"))
+		y, _ := strconv.Atoi(strings.TrimPrefix(b.VersionID, "v"))
+		return y - x
+	})
+
+	page, pagination, err := iamPage(versions, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return listPolicyVersionsResult{iamPagination: pagination, Versions: page}, nil
+}
+
+func (s *iamService) tagPolicy(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.set(queryTags(input, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagPolicy(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.unset(queryList(input, "TagKeys"))
+
+	return nil, nil
+}
+
+func (s *iamService) listPolicyTags(_ *requestContext, input url.Values) (any, error) {
+	p, err := s.findPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pagination, err := iamPage(p.tags.list(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return iamTagsResult{iamPagination: pagination, Tags: page}, nil
+}
@@ -0,0 +1,608 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// kmsService is a fake of the AWS Key Management Service API.
+// Cryptographic operations are not implemented.
+type kmsService struct {
+	jsonHandler
+	aliases map[string]map[string]*kmsAlias // Keyed by Region, then alias name.
+	keys    map[string]map[string]*kmsKey   // Keyed by Region, then key ID.
+	nextID  int
+}
+
+type kmsKey struct {
+	arn                 string
+	creationDate        time.Time
+	deletionDate        time.Time
+	description         string
+	enabled             bool
+	id                  string
+	keySpec             string
+	keyState            string
+	keyUsage            string
+	multiRegion         bool
+	origin              string
+	pendingWindowInDays int
+	policy              string
+	rotationEnabled     bool
+	rotationPeriod      int
+	tags                tags
+}
+
+type kmsAlias struct {
+	arn          string
+	creationDate time.Time
+	name         string
+	targetKeyID  string
+}
+
+func newKMS() *kmsService {
+	s := &kmsService{
+		aliases: make(map[string]map[string]*kmsAlias),
+		keys:    make(map[string]map[string]*kmsKey),
+	}
+	s.jsonHandler = jsonHandler{
+		contentType: "application/x-amz-json-1.1",
+		operations: map[string]jsonOperation{
+			"CancelKeyDeletion":    jsonOperationFunc(s.cancelKeyDeletion),
+			"CreateAlias":          jsonOperationFunc(s.createAlias),
+			"CreateKey":            jsonOperationFunc(s.createKey),
+			"DeleteAlias":          jsonOperationFunc(s.deleteAlias),
+			"DescribeKey":          jsonOperationFunc(s.describeKey),
+			"DisableKey":           jsonOperationFunc(s.disableKey),
+			"DisableKeyRotation":   jsonOperationFunc(s.disableKeyRotation),
+			"EnableKey":            jsonOperationFunc(s.enableKey),
+			"EnableKeyRotation":    jsonOperationFunc(s.enableKeyRotation),
+			"GetKeyPolicy":         jsonOperationFunc(s.getKeyPolicy),
+			"GetKeyRotationStatus": jsonOperationFunc(s.getKeyRotationStatus),
+			"ListAliases":          jsonOperationFunc(s.listAliases),
+			"ListKeys":             jsonOperationFunc(s.listKeys),
+			"ListResourceTags":     jsonOperationFunc(s.listResourceTags),
+			"PutKeyPolicy":         jsonOperationFunc(s.putKeyPolicy),
+			"ScheduleKeyDeletion":  jsonOperationFunc(s.scheduleKeyDeletion),
+			"TagResource":          jsonOperationFunc(s.tagResource),
+			"UntagResource":        jsonOperationFunc(s.untagResource),
+			"UpdateAlias":          jsonOperationFunc(s.updateAlias),
+			"UpdateKeyDescription": jsonOperationFunc(s.updateKeyDescription),
+		},
+	}
+
+	return s
+}
+
+func (s *kmsService) signingName() string {
+	return "kms"
+}
+
+func (s *kmsService) regionalKeys(rc *requestContext) map[string]*kmsKey {
+	v, ok := s.keys[rc.region]
+	if !ok {
+		v = make(map[string]*kmsKey)
+		s.keys[rc.region] = v
+	}
+
+	return v
+}
+
+func (s *kmsService) regionalAliases(rc *requestContext) map[string]*kmsAlias {
+	v, ok := s.aliases[rc.region]
+	if !ok {
+		v = make(map[string]*kmsAlias)
+		s.aliases[rc.region] = v
+	}
+
+	return v
+}
+
+// findKey returns the key with the specified key ID, key ARN, alias name or alias ARN.
+func (s *kmsService) findKey(rc *requestContext, keyID string) (*kmsKey, error) {
+	id := keyID
+
+	if strings.HasPrefix(id, "arn:") {
+		_, id, _ = strings.Cut(id, ":key/")
+		if _, alias, ok := strings.Cut(keyID, ":alias/"); ok {
+			id = "alias/" + alias
+		}
+	}
+
+	if strings.HasPrefix(id, "alias/") {
+		alias, ok := s.regionalAliases(rc)[id]
+		if !ok {
+			return nil, badRequestError("NotFoundException", "Alias %s is not found.", rc.arn("kms", id))
+		}
+		id = alias.targetKeyID
+	}
+
+	k, ok := s.regionalKeys(rc)[id]
+	if !ok {
+		return nil, badRequestError("NotFoundException", "Key '%s' does not exist", rc.arn("kms", "key/"+id))
+	}
+
+	return k, nil
+}
+
+// findUsableKey returns the specified key if it isn't pending deletion.
+func (s *kmsService) findUsableKey(rc *requestContext, keyID string) (*kmsKey, error) {
+	k, err := s.findKey(rc, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.keyState == "PendingDeletion" {
+		return nil, badRequestError("KMSInvalidStateException", "%s is pending deletion.", k.arn)
+	}
+
+	return k, nil
+}
+
+func kmsDefaultKeyPolicy(rc *requestContext) string {
+	return fmt.Sprintf(`{
+  "Version" : "2012-10-17",
+  "Id" : "key-default-1",
+  "Statement" : [ {
+    "Sid" : "Enable IAM User Permissions",
+    "Effect" : "Allow",
+    "Principal" : {
+      "AWS" : "%s"
+    },
+    "Action" : "kms:*",
+    "Resource" : "*"
+  } ]
+}`, rc.globalARN("iam", "root"))
+}
+
+type kmsTag struct {
+	TagKey   string
+	TagValue string
+}
+
+type kmsKeyMetadata struct {
+	AWSAccountID                string   `json:"AWSAccountId"`
+	Arn                         string   `json:"Arn"`
+	CreationDate                float64  `json:"CreationDate"`
+	CustomerMasterKeySpec       string   `json:"CustomerMasterKeySpec"`
+	DeletionDate                *float64 `json:"DeletionDate,omitempty"`
+	Description                 string   `json:"Description"`
+	Enabled                     bool     `json:"Enabled"`
+	EncryptionAlgorithms        []string `json:"EncryptionAlgorithms,omitempty"`
+	KeyID                       string   `json:"KeyId"`
+	KeyManager                  string   `json:"KeyManager"`
+	KeySpec                     string   `json:"KeySpec"`
+	KeyState                    string   `json:"KeyState"`
+	KeyUsage                    string   `json:"KeyUsage"`
+	MultiRegion                 bool     `json:"MultiRegion"`
+	Origin                      string   `json:"Origin"`
+	PendingDeletionWindowInDays *int     `json:"PendingDeletionWindowInDays,omitempty"`
+}
+
+func (k *kmsKey) metadata(rc *requestContext) kmsKeyMetadata {
+	v := kmsKeyMetadata{
+		AWSAccountID:          rc.accountID,
+		Arn:                   k.arn,
+		CreationDate:          epochSeconds(k.creationDate),
+		CustomerMasterKeySpec: k.keySpec,
+		Description:           k.description,
+		Enabled:               k.enabled,
+		KeyID:                 k.id,
+		KeyManager:            "CUSTOMER",
+		KeySpec:               k.keySpec,
+		KeyState:              k.keyState,
+		KeyUsage:              k.keyUsage,
+		MultiRegion:           k.multiRegion,
+		Origin:                k.origin,
+	}
+
+	if k.keyUsage == "ENCRYPT_DECRYPT" && k.keySpec == "SYMMETRIC_DEFAULT" {
+		v.EncryptionAlgorithms = []string{"SYMMETRIC_DEFAULT"}
+	}
+
+	if k.keyState == "PendingDeletion" {
+		deletionDate := epochSeconds(k.deletionDate)
+		v.DeletionDate = &deletionDate
+		v.PendingDeletionWindowInDays = &k.pendingWindowInDays
+	}
+
+	return v
+}
+
+type createKeyInput struct {
+	CustomerMasterKeySpec string
+	Description           string
+	KeySpec               string
+	KeyUsage              string
+	MultiRegion           bool
+	Origin                string
+	Policy                string
+	Tags                  []kmsTag
+}
+
+func (s *kmsService) createKey(rc *requestContext, input *createKeyInput) (any, error) {
+	s.nextID++
+	id := fmt.Sprintf("%08x-0000-4000-8000-%012x", s.nextID, s.nextID)
+	if input.MultiRegion {
+		id = "mrk-" + strings.ReplaceAll(id, "-", "")
+	}
+
+	k := &kmsKey{
+		arn:          rc.arn("kms", "key/"+id),
+		creationDate: time.Now(),
+		description:  input.Description,
+		enabled:      true,
+		id:           id,
+		keySpec:      "SYMMETRIC_DEFAULT",
+		keyState:     "Enabled",
+		keyUsage:     "ENCRYPT_DECRYPT",
+		multiRegion:  input.MultiRegion,
+		origin:       "AWS_KMS",
+		policy:       input.Policy,
+		tags:         make(tags),
+	}
+
+	if v := input.CustomerMasterKeySpec; v != "" {
+		k.keySpec = v
+	}
+	if v := input.KeySpec; v != "" {
+		k.keySpec = v
+	}
+	if v := input.KeyUsage; v != "" {
+		k.keyUsage = v
+	}
+	if v := input.Origin; v != "" {
+		k.origin = v
+	}
+	if k.policy == "" {
+		k.policy = kmsDefaultKeyPolicy(rc)
+	}
+	for _, v := range input.Tags {
+		k.tags[v.TagKey] = v.TagValue
+	}
+
+	s.regionalKeys(rc)[id] = k
+
+	return map[string]any{
+		"KeyMetadata": k.metadata(rc),
+	}, nil
+}
+
+type kmsKeyInput struct {
+	Description          string
+	KeyID                string `json:"KeyId"`
+	PendingWindowInDays  int
+	Policy               string
+	PolicyName           string
+	RotationPeriodInDays int
+	TagKeys              []string
+	Tags                 []kmsTag
+}
+
+func (s *kmsService) describeKey(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"KeyMetadata": k.metadata(rc),
+	}, nil
+}
+
+func (s *kmsService) updateKeyDescription(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k.description = input.Description
+
+	return nil, nil
+}
+
+func (s *kmsService) enableKey(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k.enabled = true
+	k.keyState = "Enabled"
+
+	return nil, nil
+}
+
+func (s *kmsService) disableKey(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k.enabled = false
+	k.keyState = "Disabled"
+
+	return nil, nil
+}
+
+func (s *kmsService) scheduleKeyDeletion(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	days := input.PendingWindowInDays
+	if days == 0 {
+		days = 30
+	}
+	if days < 7 || days > 30 {
+		return nil, validationError("1 validation error detected: Value '%d' at 'pendingWindowInDays' failed to satisfy constraint: Member must have value between 7 and 30", days)
+	}
+
+	k.deletionDate = time.Now().AddDate(0, 0, days)
+	k.enabled = false
+	k.keyState = "PendingDeletion"
+	k.pendingWindowInDays = days
+
+	return map[string]any{
+		"DeletionDate":        epochSeconds(k.deletionDate),
+		"KeyId":               k.arn,
+		"KeyState":            k.keyState,
+		"PendingWindowInDays": days,
+	}, nil
+}
+
+func (s *kmsService) cancelKeyDeletion(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.keyState != "PendingDeletion" {
+		return nil, badRequestError("KMSInvalidStateException", "%s is not pending deletion.", k.arn)
+	}
+
+	k.keyState = "Disabled"
+
+	return map[string]any{
+		"KeyId": k.arn,
+	}, nil
+}
+
+func (s *kmsService) getKeyPolicy(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Policy":     k.policy,
+		"PolicyName": "default",
+	}, nil
+}
+
+func (s *kmsService) putKeyPolicy(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.PolicyName != "" && input.PolicyName != "default" {
+		return nil, validationError("Policy name %s is not valid", input.PolicyName)
+	}
+
+	k.policy = input.Policy
+
+	return nil, nil
+}
+
+func (s *kmsService) getKeyRotationStatus(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{
+		"KeyId":              k.arn,
+		"KeyRotationEnabled": k.rotationEnabled,
+	}
+	if k.rotationEnabled {
+		output["RotationPeriodInDays"] = k.rotationPeriod
+	}
+
+	return output, nil
+}
+
+func (s *kmsService) enableKeyRotation(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.keySpec != "SYMMETRIC_DEFAULT" {
+		return nil, badRequestError("UnsupportedOperationException", "%s key does not support key rotation.", k.keySpec)
+	}
+
+	k.rotationEnabled = true
+	k.rotationPeriod = 365
+	if v := input.RotationPeriodInDays; v != 0 {
+		k.rotationPeriod = v
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) disableKeyRotation(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k.rotationEnabled = false
+
+	return nil, nil
+}
+
+type kmsListInput struct {
+	KeyID  string `json:"KeyId"`
+	Limit  int
+	Marker string
+}
+
+func kmsPage[T any](values []T, input *kmsListInput, key string) (any, error) {
+	page, nextMarker, err := paginate(values, input.Marker, input.Limit, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{
+		key:         page,
+		"Truncated": nextMarker != "",
+	}
+	if nextMarker != "" {
+		output["NextMarker"] = nextMarker
+	}
+
+	return output, nil
+}
+
+func (s *kmsService) listKeys(rc *requestContext, input *kmsListInput) (any, error) {
+	keys := s.regionalKeys(rc)
+	var values []map[string]string
+
+	for _, id := range sortedKeys(keys) {
+		values = append(values, map[string]string{
+			"KeyArn": keys[id].arn,
+			"KeyId":  id,
+		})
+	}
+
+	return kmsPage(values, input, "Keys")
+}
+
+func (s *kmsService) listResourceTags(rc *requestContext, input *kmsListInput) (any, error) {
+	k, err := s.findKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []kmsTag
+	for _, v := range k.tags.list() {
+		values = append(values, kmsTag{TagKey: v.Key, TagValue: v.Value})
+	}
+
+	return kmsPage(values, input, "Tags")
+}
+
+func (s *kmsService) tagResource(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range input.Tags {
+		k.tags[v.TagKey] = v.TagValue
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) untagResource(rc *requestContext, input *kmsKeyInput) (any, error) {
+	k, err := s.findUsableKey(rc, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k.tags.unset(input.TagKeys)
+
+	return nil, nil
+}
+
+type kmsAliasInput struct {
+	AliasName   string
+	TargetKeyID string `json:"TargetKeyId"`
+}
+
+func (s *kmsService) createAlias(rc *requestContext, input *kmsAliasInput) (any, error) {
+	if !strings.HasPrefix(input.AliasName, "alias/") || strings.HasPrefix(input.AliasName, "alias/aws/") {
+		return nil, validationError("Alias must start with the prefix \"alias/\". Please see https://docs.aws.amazon.com/kms/latest/developerguide/kms-alias.html")
+	}
+
+	aliases := s.regionalAliases(rc)
+	if _, ok := aliases[input.AliasName]; ok {
+		return nil, badRequestError("AlreadyExistsException", "An alias with the name %s already exists", rc.arn("kms", input.AliasName))
+	}
+
+	k, err := s.findUsableKey(rc, input.TargetKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases[input.AliasName] = &kmsAlias{
+		arn:          rc.arn("kms", input.AliasName),
+		creationDate: time.Now(),
+		name:         input.AliasName,
+		targetKeyID:  k.id,
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) updateAlias(rc *requestContext, input *kmsAliasInput) (any, error) {
+	alias, ok := s.regionalAliases(rc)[input.AliasName]
+	if !ok {
+		return nil, badRequestError("NotFoundException", "Alias %s is not found.", rc.arn("kms", input.AliasName))
+	}
+
+	k, err := s.findUsableKey(rc, input.TargetKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	alias.targetKeyID = k.id
+
+	return nil, nil
+}
+
+func (s *kmsService) deleteAlias(rc *requestContext, input *kmsAliasInput) (any, error) {
+	aliases := s.regionalAliases(rc)
+	if _, ok := aliases[input.AliasName]; !ok {
+		return nil, badRequestError("NotFoundException", "Alias %s is not found.", rc.arn("kms", input.AliasName))
+	}
+
+	delete(aliases, input.AliasName)
+
+	return nil, nil
+}
+
+func (s *kmsService) listAliases(rc *requestContext, input *kmsListInput) (any, error) {
+	aliases := s.regionalAliases(rc)
+	var values []map[string]any
+
+	for _, name := range sortedKeys(aliases) {
+		alias := aliases[name]
+
+		if input.KeyID != "" {
+			k, err := s.findKey(rc, input.KeyID)
+			if err != nil {
+				return nil, err
+			}
+			if k.id != alias.targetKeyID {
+				continue
+			}
+		}
+
+		values = append(values, map[string]any{
+			"AliasArn":     alias.arn,
+			"AliasName":    alias.name,
+			"CreationDate": epochSeconds(alias.creationDate),
+			"TargetKeyId":  alias.targetKeyID,
+		})
+	}
+
+	return kmsPage(values, input, "Aliases")
+}
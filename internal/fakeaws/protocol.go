@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// apiError is an AWS API error response.
+type apiError struct {
+	code       string
+	message    string
+	statusCode int
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newAPIError(statusCode int, code, format string, a ...any) *apiError {
+	return &apiError{
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+		statusCode: statusCode,
+	}
+}
+
+func badRequestError(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusBadRequest, code, format, a...)
+}
+
+func notFoundError(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusNotFound, code, format, a...)
+}
+
+func validationError(format string, a ...any) *apiError {
+	return badRequestError("ValidationException", format, a...)
+}
+
+func asAPIError(err error) *apiError {
+	if v, ok := errs.As[*apiError](err); ok {
+		return v
+	}
+
+	return newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// jsonOperation implements an AWS JSON protocol operation, given the request body.
+type jsonOperation func(rc *requestContext, body []byte) (any, error)
+
+// jsonHandler handles AWS JSON 1.0 and 1.1 protocol requests.
+type jsonHandler struct {
+	contentType string
+	// errorNamespace qualifies error codes, e.g. "com.amazonaws.sqs#".
+	errorNamespace string
+	operations     map[string]jsonOperation
+	// queryErrorCodes maps error codes to the AWS Query protocol error codes returned
+	// to clients of services that have migrated from the Query protocol, e.g. SQS.
+	queryErrorCodes map[string]string
+}
+
+func (h jsonHandler) handle(w http.ResponseWriter, r *http.Request, rc *requestContext) {
+	_, operation, _ := strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+
+	output, err := func() (any, error) {
+		f, ok := h.operations[operation]
+		if !ok {
+			return nil, badRequestError("UnknownOperationException", "operation %q is not implemented", operation)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return f(rc, body)
+	}()
+
+	w.Header().Set("Content-Type", h.contentType)
+
+	if err != nil {
+		apiErr := asAPIError(err)
+		w.Header().Set("X-Amzn-Errortype", apiErr.code)
+		if v, ok := h.queryErrorCodes[apiErr.code]; ok {
+			w.Header().Set("X-Amzn-Query-Error", v+";Sender")
+		}
+		w.WriteHeader(apiErr.statusCode)
+		json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+			"__type":  h.errorNamespace + apiErr.code,
+			"message": apiErr.message,
+		})
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output) //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+}
+
+// decodeJSON decodes an AWS JSON protocol request body.
+func decodeJSON(body []byte, v any) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return badRequestError("SerializationException", "%s", err)
+	}
+
+	return nil
+}
+
+// jsonOperationFunc adapts a typed operation implementation to a jsonOperation.
+func jsonOperationFunc[I any](f func(*requestContext, *I) (any, error)) jsonOperation {
+	return func(rc *requestContext, body []byte) (any, error) {
+		var input I
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		return f(rc, &input)
+	}
+}
+
+// queryOperation implements an AWS Query protocol operation.
+// The returned value is marshaled as the operation's <OperationResult> element.
+type queryOperation func(rc *requestContext, input url.Values) (any, error)
+
+// queryHandler handles AWS Query protocol requests.
+type queryHandler struct {
+	xmlns      string
+	operations map[string]queryOperation
+}
+
+func (h queryHandler) handle(w http.ResponseWriter, r *http.Request, rc *requestContext) {
+	var operation string
+
+	output, err := func() (any, error) {
+		if err := r.ParseForm(); err != nil {
+			return nil, badRequestError("MalformedQueryString", "%s", err)
+		}
+
+		operation = r.Form.Get("Action")
+		f, ok := h.operations[operation]
+		if !ok {
+			return nil, badRequestError("InvalidAction", "operation %q is not implemented", operation)
+		}
+
+		return f(rc, r.Form)
+	}()
+
+	w.Header().Set("Content-Type", "text/xml")
+
+	if err != nil {
+		apiErr := asAPIError(err)
+		w.WriteHeader(apiErr.statusCode)
+		writeXML(w, queryErrorResponse{
+			Error: xmlError{
+				Type:    "Sender",
+				Code:    apiErr.code,
+				Message: apiErr.message,
+			},
+			RequestID: rc.requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	writeXML(w, queryResponse{
+		XMLName:   xml.Name{Local: operation + "Response"},
+		Xmlns:     h.xmlns,
+		Result:    queryResult{XMLName: xml.Name{Local: operation + "Result"}, Value: output},
+		RequestID: rc.requestID,
+	})
+}
+
+type queryResponse struct {
+	XMLName   xml.Name
+	Xmlns     string `xml:"xmlns,attr"`
+	Result    queryResult
+	RequestID string `xml:"ResponseMetadata>RequestId"`
+}
+
+type queryResult struct {
+	XMLName xml.Name
+	Value   any
+}
+
+func (v queryResult) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = v.XMLName
+
+	if v.Value == nil {
+		return e.EncodeElement(struct{}{}, start)
+	}
+
+	return e.EncodeElement(v.Value, start)
+}
+
+type queryErrorResponse struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Error     xmlError `xml:"Error"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type xmlError struct {
+	Type    string `xml:"Type,omitempty"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func writeXML(w io.Writer, v any) {
+	io.WriteString(w, xml.Header) //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+	xml.NewEncoder(w).Encode(v)   //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+}
+
+// queryList returns the values of a Query protocol list parameter, e.g. "Tags.member.1.Key".
+func queryList(input url.Values, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		v, ok := input[fmt.Sprintf("%s.member.%d", prefix, i)]
+		if !ok {
+			return values
+		}
+		values = append(values, v[0])
+	}
+}
+
+// queryTags returns the value of a Query protocol list of tags parameter.
+func queryTags(input url.Values, prefix string) []tag {
+	var tags []tag
+
+	for i := 1; ; i++ {
+		k, ok := input[fmt.Sprintf("%s.member.%d.Key", prefix, i)]
+		if !ok {
+			return tags
+		}
+		tags = append(tags, tag{Key: k[0], Value: input.Get(fmt.Sprintf("%s.member.%d.Value", prefix, i))})
+	}
+}
+
+// tag is a resource tag.
+type tag struct {
+	Key   string `json:"Key" xml:"Key"`
+	Value string `json:"Value" xml:"Value"`
+}
+
+// tags is a set of resource tags.
+type tags map[string]string
+
+func (t tags) set(v []tag) {
+	for _, v := range v {
+		t[v.Key] = v.Value
+	}
+}
+
+func (t tags) unset(keys []string) {
+	for _, k := range keys {
+		delete(t, k)
+	}
+}
+
+func (t tags) list() []tag {
+	v := make([]tag, 0, len(t))
+	for _, k := range sortedKeys(t) {
+		v = append(v, tag{Key: k, Value: t[k]})
+	}
+
+	return v
+}
+
+// epochSeconds returns the AWS JSON protocol representation of a timestamp.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// paginate returns a page of values and the token for the next page.
+// Tokens are the index of the first value in a page.
+func paginate[T any](values []T, token string, maxResults, defaultMaxResults int) ([]T, string, error) {
+	start := 0
+	if token != "" {
+		v, err := strconv.Atoi(token)
+		if err != nil || v < 0 || v > len(values) {
+			return nil, "", badRequestError("InvalidNextToken", "The specified token is not valid.")
+		}
+		start = v
+	}
+
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+
+	end := min(start+maxResults, len(values))
+	page := values[start:end]
+	if page == nil {
+		page = []T{}
+	}
+
+	if end < len(values) {
+		return page, strconv.Itoa(end), nil
+	}
+
+	return page, "", nil
+}
+
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,679 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"crypto/md5" //nolint:gosec // S3 ETags are MD5 digests.
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// s3Service is a fake of the Amazon Simple Storage Service API.
+// Bucket configurations are stored and returned verbatim, without validation.
+// Versioning, multipart uploads and object subresources are not implemented.
+type s3Service struct {
+	buckets map[string]*s3Bucket // Keyed by name. Bucket names are global.
+}
+
+type s3Bucket struct {
+	creationDate time.Time
+	name         string
+	objects      map[string]*s3Object // Keyed by key.
+	region       string
+	// subresources are the bucket's configurations, e.g. "cors" or "tagging".
+	subresources map[string][]byte
+}
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	key          string
+	lastModified time.Time
+	metadata     http.Header
+}
+
+// s3Subresource describes a bucket configuration subresource.
+type s3Subresource struct {
+	// defaultValue is returned if the configuration hasn't been set.
+	defaultValue func(*requestContext) string
+	// notFoundCode is the error code returned if the configuration hasn't been set and there is no default value.
+	notFoundCode string
+}
+
+const s3Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+var s3Subresources = map[string]s3Subresource{
+	"accelerate": {
+		defaultValue: func(*requestContext) string {
+			return `<AccelerateConfiguration xmlns="` + s3Xmlns + `"/>`
+		},
+	},
+	"acl": {
+		defaultValue: func(rc *requestContext) string {
+			return `<AccessControlPolicy xmlns="` + s3Xmlns + `"><Owner><ID>` + rc.accountID + `</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + rc.accountID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`
+		},
+	},
+	"cors": {
+		notFoundCode: "NoSuchCORSConfiguration",
+	},
+	"encryption": {
+		defaultValue: func(*requestContext) string {
+			return `<ServerSideEncryptionConfiguration xmlns="` + s3Xmlns + `"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`
+		},
+	},
+	"lifecycle": {
+		notFoundCode: "NoSuchLifecycleConfiguration",
+	},
+	"logging": {
+		defaultValue: func(*requestContext) string {
+			return `<BucketLoggingStatus xmlns="` + s3Xmlns + `"/>`
+		},
+	},
+	"object-lock": {
+		notFoundCode: "ObjectLockConfigurationNotFoundError",
+	},
+	"ownershipControls": {
+		defaultValue: func(*requestContext) string {
+			return `<OwnershipControls xmlns="` + s3Xmlns + `"><Rule><ObjectOwnership>BucketOwnerEnforced</ObjectOwnership></Rule></OwnershipControls>`
+		},
+	},
+	"policy": {
+		notFoundCode: "NoSuchBucketPolicy",
+	},
+	"publicAccessBlock": {
+		defaultValue: func(*requestContext) string {
+			return `<PublicAccessBlockConfiguration xmlns="` + s3Xmlns + `"><BlockPublicAcls>true</BlockPublicAcls><IgnorePublicAcls>true</IgnorePublicAcls><BlockPublicPolicy>true</BlockPublicPolicy><RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>`
+		},
+	},
+	"replication": {
+		notFoundCode: "ReplicationConfigurationNotFoundError",
+	},
+	"requestPayment": {
+		defaultValue: func(*requestContext) string {
+			return `<RequestPaymentConfiguration xmlns="` + s3Xmlns + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`
+		},
+	},
+	"tagging": {
+		notFoundCode: "NoSuchTagSet",
+	},
+	"versioning": {
+		defaultValue: func(*requestContext) string {
+			return `<VersioningConfiguration xmlns="` + s3Xmlns + `"/>`
+		},
+	},
+	"website": {
+		notFoundCode: "NoSuchWebsiteConfiguration",
+	},
+}
+
+// s3UnimplementedSubresources are query parameters that select an unimplemented operation.
+var s3UnimplementedSubresources = []string{
+	"analytics",
+	"intelligent-tiering",
+	"inventory",
+	"metrics",
+	"notification",
+	"restore",
+	"select",
+	"uploadId",
+	"uploads",
+}
+
+func newS3() *s3Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+func (s *s3Service) signingName() string {
+	return "s3"
+}
+
+// s3Address returns the bucket name and object key addressed by a request.
+// Both virtual-hosted and path-style requests are supported.
+func s3Address(r *http.Request) (string, string) {
+	host := r.URL.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	p := strings.TrimPrefix(r.URL.Path, "/")
+
+	if i := strings.Index(host, ".s3."); i > 0 {
+		return host[:i], p
+	}
+
+	bucket, key, _ := strings.Cut(p, "/")
+
+	return bucket, key
+}
+
+func (s *s3Service) handle(w http.ResponseWriter, r *http.Request, rc *requestContext) {
+	if err := s.serve(w, r, rc); err != nil {
+		apiErr := asAPIError(err)
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(apiErr.statusCode)
+		// Responses to HEAD requests have no body.
+		if r.Method != http.MethodHead {
+			writeXML(w, s3ErrorResponse{
+				Code:      apiErr.code,
+				Message:   apiErr.message,
+				RequestID: rc.requestID,
+			})
+		}
+	}
+}
+
+type s3ErrorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+func s3NotImplementedError(r *http.Request) *apiError {
+	return newAPIError(http.StatusNotImplemented, "NotImplemented", "%s %s is not implemented", r.Method, r.URL.RequestURI())
+}
+
+func (s *s3Service) serve(w http.ResponseWriter, r *http.Request, rc *requestContext) error {
+	query := r.URL.Query()
+	// The SDK identifies some operations with an "x-id" query parameter.
+	query.Del("x-id")
+
+	for _, v := range s3UnimplementedSubresources {
+		if query.Has(v) {
+			return s3NotImplementedError(r)
+		}
+	}
+
+	bucketName, key := s3Address(r)
+
+	if bucketName == "" {
+		if r.Method == http.MethodGet {
+			return s.listBuckets(w, rc)
+		}
+		return s3NotImplementedError(r)
+	}
+
+	if r.Method == http.MethodPut && key == "" && len(query) == 0 {
+		return s.createBucket(w, r, rc, bucketName)
+	}
+
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return notFoundError("NoSuchBucket", "The specified bucket does not exist")
+	}
+
+	if key != "" {
+		return s.serveObject(w, r, bucket, key)
+	}
+
+	switch {
+	case len(query) == 0 && r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case len(query) == 0 && r.Method == http.MethodDelete:
+		return s.deleteBucket(w, bucket)
+	case query.Has("location") && r.Method == http.MethodGet:
+		return s.getBucketLocation(w, bucket)
+	case query.Has("list-type") && r.Method == http.MethodGet:
+		return s.listObjectsV2(w, bucket, query)
+	case query.Has("versions") && r.Method == http.MethodGet:
+		return s.listObjectVersions(w, bucket, query)
+	case query.Has("delete") && r.Method == http.MethodPost:
+		return s.deleteObjects(w, r, bucket)
+	}
+
+	for name, subresource := range s3Subresources {
+		if !query.Has(name) {
+			continue
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			v, ok := bucket.subresources[name]
+			if !ok {
+				if subresource.defaultValue == nil {
+					return notFoundError(subresource.notFoundCode, "The %s configuration does not exist", name)
+				}
+				v = []byte(subresource.defaultValue(rc))
+			}
+			if name == "policy" {
+				w.Header().Set("Content-Type", "application/json")
+			} else {
+				w.Header().Set("Content-Type", "application/xml")
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(v) //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+			return nil
+		case http.MethodPut:
+			body, err := s3RequestBody(r)
+			if err != nil {
+				return err
+			}
+			bucket.subresources[name] = body
+			w.WriteHeader(http.StatusOK)
+			return nil
+		case http.MethodDelete:
+			delete(bucket.subresources, name)
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+	}
+
+	return s3NotImplementedError(r)
+}
+
+// s3RequestBody returns a request's body, decoding any aws-chunked content encoding.
+func s3RequestBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") && !strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	br := bufio.NewReader(r.Body)
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, badRequestError("IncompleteBody", "reading aws-chunked chunk header: %s", err)
+		}
+
+		// Signed chunks have a ";chunk-signature=..." extension.
+		size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, badRequestError("IncompleteBody", "invalid aws-chunked chunk size %q", size)
+		}
+
+		// The final chunk is empty and is followed by any trailing headers.
+		if n == 0 {
+			return body.Bytes(), nil
+		}
+
+		if _, err := io.CopyN(&body, br, n); err != nil {
+			return nil, badRequestError("IncompleteBody", "reading aws-chunked chunk: %s", err)
+		}
+		if _, err := br.Discard(2); err != nil {
+			return nil, badRequestError("IncompleteBody", "reading aws-chunked chunk: %s", err)
+		}
+	}
+}
+
+type createBucketConfiguration struct {
+	LocationConstraint string `xml:"LocationConstraint"`
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *http.Request, rc *requestContext, name string) error {
+	if _, ok := s.buckets[name]; ok {
+		return newAPIError(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	body, err := s3RequestBody(r)
+	if err != nil {
+		return err
+	}
+
+	region := rc.region
+	if len(bytes.TrimSpace(body)) > 0 {
+		var configuration createBucketConfiguration
+		if err := xml.Unmarshal(body, &configuration); err != nil {
+			return badRequestError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		}
+		if v := configuration.LocationConstraint; v != "" {
+			region = v
+		}
+	}
+
+	s.buckets[name] = &s3Bucket{
+		creationDate: time.Now().UTC().Truncate(time.Second),
+		name:         name,
+		objects:      make(map[string]*s3Object),
+		region:       region,
+		subresources: make(map[string][]byte),
+	}
+
+	w.Header().Set("Location", "/"+name)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
+
+func (s *s3Service) deleteBucket(w http.ResponseWriter, bucket *s3Bucket) error {
+	if len(bucket.objects) > 0 {
+		return newAPIError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(s.buckets, bucket.name)
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Owner   struct {
+		ID string `xml:"ID"`
+	} `xml:"Owner"`
+	Buckets []s3BucketOutput `xml:"Buckets>Bucket"`
+}
+
+type s3BucketOutput struct {
+	BucketRegion string    `xml:"BucketRegion"`
+	CreationDate time.Time `xml:"CreationDate"`
+	Name         string    `xml:"Name"`
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter, rc *requestContext) error {
+	output := listAllMyBucketsResult{Xmlns: s3Xmlns}
+	output.Owner.ID = rc.accountID
+
+	for _, name := range sortedKeys(s.buckets) {
+		bucket := s.buckets[name]
+		output.Buckets = append(output.Buckets, s3BucketOutput{
+			BucketRegion: bucket.region,
+			CreationDate: bucket.creationDate,
+			Name:         bucket.name,
+		})
+	}
+
+	writeS3XML(w, output)
+
+	return nil
+}
+
+type locationConstraint struct {
+	XMLName xml.Name `xml:"LocationConstraint"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Value   string   `xml:",chardata"`
+}
+
+func (s *s3Service) getBucketLocation(w http.ResponseWriter, bucket *s3Bucket) error {
+	output := locationConstraint{Xmlns: s3Xmlns}
+	// Buckets in us-east-1 have a null location constraint.
+	if bucket.region != "us-east-1" { // lintignore:AWSAT003
+		output.Value = bucket.region
+	}
+
+	writeS3XML(w, output)
+
+	return nil
+}
+
+func writeS3XML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	writeXML(w, v)
+}
+
+func (s *s3Service) serveObject(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string) error {
+	if query := r.URL.Query(); len(query) > 1 || (len(query) == 1 && !query.Has("x-id")) {
+		return s3NotImplementedError(r)
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("X-Amz-Copy-Source") != "" {
+			return s3NotImplementedError(r)
+		}
+
+		body, err := s3RequestBody(r)
+		if err != nil {
+			return err
+		}
+
+		digest := md5.Sum(body) //nolint:gosec // S3 ETags are MD5 digests.
+		o := &s3Object{
+			body:         body,
+			contentType:  r.Header.Get("Content-Type"),
+			etag:         `"` + hex.EncodeToString(digest[:]) + `"`,
+			key:          key,
+			lastModified: time.Now().UTC().Truncate(time.Second),
+			metadata:     make(http.Header),
+		}
+		if o.contentType == "" {
+			o.contentType = "binary/octet-stream"
+		}
+		for k, v := range r.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+				o.metadata[k] = v
+			}
+		}
+		bucket.objects[key] = o
+
+		w.Header().Set("ETag", o.etag)
+		w.WriteHeader(http.StatusOK)
+
+		return nil
+	case http.MethodGet, http.MethodHead:
+		o, ok := bucket.objects[key]
+		if !ok {
+			if r.Method == http.MethodHead {
+				return notFoundError("NotFound", "Not Found")
+			}
+			return notFoundError("NoSuchKey", "The specified key does not exist.")
+		}
+
+		for k, v := range o.metadata {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.body)))
+		w.Header().Set("Content-Type", o.contentType)
+		w.Header().Set("ETag", o.etag)
+		w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(o.body) //nolint:errcheck // Writes to an httptest.ResponseRecorder don't fail.
+		}
+
+		return nil
+	case http.MethodDelete:
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+		return nil
+	}
+
+	return s3NotImplementedError(r)
+}
+
+type s3ObjectOutput struct {
+	ETag         string    `xml:"ETag"`
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	Size         int       `xml:"Size"`
+	StorageClass string    `xml:"StorageClass"`
+}
+
+func (o *s3Object) output() s3ObjectOutput {
+	return s3ObjectOutput{
+		ETag:         o.etag,
+		Key:          o.key,
+		LastModified: o.lastModified,
+		Size:         len(o.body),
+		StorageClass: "STANDARD",
+	}
+}
+
+// listObjects returns a page of the bucket's objects whose keys have the specified prefix.
+func (b *s3Bucket) listObjects(prefix, token string, maxKeys int) ([]s3ObjectOutput, string, error) {
+	var objects []s3ObjectOutput
+
+	for _, key := range sortedKeys(b.objects) {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, b.objects[key].output())
+		}
+	}
+
+	page, nextToken, err := paginate(objects, token, maxKeys, 1000)
+	if err != nil {
+		return nil, "", badRequestError("InvalidArgument", "The continuation token provided is incorrect")
+	}
+
+	return page, nextToken, nil
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name         `xml:"ListBucketResult"`
+	Xmlns                 string           `xml:"xmlns,attr"`
+	Contents              []s3ObjectOutput `xml:"Contents"`
+	ContinuationToken     string           `xml:"ContinuationToken,omitempty"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	KeyCount              int              `xml:"KeyCount"`
+	MaxKeys               int              `xml:"MaxKeys"`
+	Name                  string           `xml:"Name"`
+	NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+	Prefix                string           `xml:"Prefix"`
+}
+
+func (s *s3Service) listObjectsV2(w http.ResponseWriter, bucket *s3Bucket, query url.Values) error {
+	maxKeys, _ := strconv.Atoi(query.Get("max-keys"))
+
+	page, nextToken, err := bucket.listObjects(query.Get("prefix"), query.Get("continuation-token"), maxKeys)
+	if err != nil {
+		return err
+	}
+
+	writeS3XML(w, listBucketResult{
+		Xmlns:                 s3Xmlns,
+		Contents:              page,
+		ContinuationToken:     query.Get("continuation-token"),
+		IsTruncated:           nextToken != "",
+		KeyCount:              len(page),
+		MaxKeys:               cmp.Or(maxKeys, 1000),
+		Name:                  bucket.name,
+		NextContinuationToken: nextToken,
+		Prefix:                query.Get("prefix"),
+	})
+
+	return nil
+}
+
+type s3ObjectVersionOutput struct {
+	s3ObjectOutput
+	IsLatest  bool   `xml:"IsLatest"`
+	VersionID string `xml:"VersionId"`
+}
+
+type listVersionsResult struct {
+	XMLName             xml.Name                `xml:"ListVersionsResult"`
+	Xmlns               string                  `xml:"xmlns,attr"`
+	IsTruncated         bool                    `xml:"IsTruncated"`
+	KeyMarker           string                  `xml:"KeyMarker"`
+	MaxKeys             int                     `xml:"MaxKeys"`
+	Name                string                  `xml:"Name"`
+	NextKeyMarker       string                  `xml:"NextKeyMarker,omitempty"`
+	NextVersionIDMarker string                  `xml:"NextVersionIdMarker,omitempty"`
+	Prefix              string                  `xml:"Prefix"`
+	Versions            []s3ObjectVersionOutput `xml:"Version"`
+}
+
+// listObjectVersions returns each object as its only, "null", version.
+func (s *s3Service) listObjectVersions(w http.ResponseWriter, bucket *s3Bucket, query url.Values) error {
+	maxKeys, _ := strconv.Atoi(query.Get("max-keys"))
+
+	// The key marker is used as the pagination token.
+	page, nextToken, err := bucket.listObjects(query.Get("prefix"), query.Get("key-marker"), maxKeys)
+	if err != nil {
+		return err
+	}
+
+	output := listVersionsResult{
+		Xmlns:         s3Xmlns,
+		IsTruncated:   nextToken != "",
+		KeyMarker:     query.Get("key-marker"),
+		MaxKeys:       cmp.Or(maxKeys, 1000),
+		Name:          bucket.name,
+		NextKeyMarker: nextToken,
+		Prefix:        query.Get("prefix"),
+	}
+	if nextToken != "" {
+		output.NextVersionIDMarker = "null"
+	}
+	for _, v := range page {
+		output.Versions = append(output.Versions, s3ObjectVersionOutput{
+			s3ObjectOutput: v,
+			IsLatest:       true,
+			VersionID:      "null",
+		})
+	}
+
+	writeS3XML(w, output)
+
+	return nil
+}
+
+type deleteObjectsRequest struct {
+	Objects []struct {
+		Key       string `xml:"Key"`
+		VersionID string `xml:"VersionId"`
+	} `xml:"Object"`
+	Quiet bool `xml:"Quiet"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name          `xml:"DeleteResult"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	Deleted []s3DeletedObject `xml:"Deleted"`
+}
+
+type s3DeletedObject struct {
+	Key       string `xml:"Key"`
+	VersionID string `xml:"VersionId,omitempty"`
+}
+
+func (s *s3Service) deleteObjects(w http.ResponseWriter, r *http.Request, bucket *s3Bucket) error {
+	body, err := s3RequestBody(r)
+	if err != nil {
+		return err
+	}
+
+	var input deleteObjectsRequest
+	if err := xml.Unmarshal(body, &input); err != nil {
+		return badRequestError("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	output := deleteResult{Xmlns: s3Xmlns}
+	for _, v := range input.Objects {
+		delete(bucket.objects, v.Key)
+		if !input.Quiet {
+			output.Deleted = append(output.Deleted, s3DeletedObject{Key: v.Key, VersionID: v.VersionID})
+		}
+	}
+
+	writeS3XML(w, output)
+
+	return nil
+}
+
+// s3UnsignedRequestRegion returns the AWS Region of an unsigned S3 request from its host name.
+func s3UnsignedRequestRegion(r *http.Request) (string, bool) {
+	host := r.URL.Hostname()
+
+	i := strings.Index(host, "s3.")
+	if i < 0 || (i > 0 && host[i-1] != '.') {
+		return "", false
+	}
+
+	region, _, _ := strings.Cut(host[i+len("s3."):], ".")
+	if region == "amazonaws" {
+		return "us-east-1", true // lintignore:AWSAT003
+	}
+
+	return region, true
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqsService is a fake of the Amazon Simple Queue Service API.
+// Messages are not implemented.
+type sqsService struct {
+	jsonHandler
+	queues map[string]map[string]*sqsQueue // Keyed by Region, then name.
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       tags
+	url        string
+}
+
+func newSQS() *sqsService {
+	s := &sqsService{
+		queues: make(map[string]map[string]*sqsQueue),
+	}
+	s.jsonHandler = jsonHandler{
+		contentType:    "application/x-amz-json-1.0",
+		errorNamespace: "com.amazonaws.sqs#",
+		operations: map[string]jsonOperation{
+			"CreateQueue":        jsonOperationFunc(s.createQueue),
+			"DeleteQueue":        jsonOperationFunc(s.deleteQueue),
+			"GetQueueAttributes": jsonOperationFunc(s.getQueueAttributes),
+			"GetQueueUrl":        jsonOperationFunc(s.getQueueURL),
+			"ListQueueTags":      jsonOperationFunc(s.listQueueTags),
+			"ListQueues":         jsonOperationFunc(s.listQueues),
+			"SetQueueAttributes": jsonOperationFunc(s.setQueueAttributes),
+			"TagQueue":           jsonOperationFunc(s.tagQueue),
+			"UntagQueue":         jsonOperationFunc(s.untagQueue),
+		},
+		queryErrorCodes: map[string]string{
+			"QueueDoesNotExist": "AWS.SimpleQueueService.NonExistentQueue",
+			"QueueNameExists":   "QueueAlreadyExists",
+		},
+	}
+
+	return s
+}
+
+func (s *sqsService) signingName() string {
+	return "sqs"
+}
+
+func (s *sqsService) regionalQueues(rc *requestContext) map[string]*sqsQueue {
+	v, ok := s.queues[rc.region]
+	if !ok {
+		v = make(map[string]*sqsQueue)
+		s.queues[rc.region] = v
+	}
+
+	return v
+}
+
+func (s *sqsService) findQueueByURL(rc *requestContext, url string) (*sqsQueue, error) {
+	if q, ok := s.regionalQueues(rc)[path.Base(url)]; ok && q.url == url {
+		return q, nil
+	}
+
+	return nil, badRequestError("QueueDoesNotExist", "The specified queue does not exist.")
+}
+
+// sqsQueueAttributeDefaults are the default values of the settable queue attributes.
+var sqsQueueAttributeDefaults = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"SqsManagedSseEnabled":          "true",
+	"VisibilityTimeout":             "30",
+}
+
+type createQueueInput struct {
+	Attributes map[string]string
+	QueueName  string
+	Tags       map[string]string `json:"tags"`
+}
+
+func (s *sqsService) createQueue(rc *requestContext, input *createQueueInput) (any, error) {
+	fifo := input.Attributes["FifoQueue"] == "true"
+	if fifo != strings.HasSuffix(input.QueueName, ".fifo") {
+		return nil, badRequestError("InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix and be 1 to 80 in length.")
+	}
+
+	queues := s.regionalQueues(rc)
+
+	if q, ok := queues[input.QueueName]; ok {
+		for k, v := range input.Attributes {
+			if q.attributes[k] != v {
+				return nil, badRequestError("QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return map[string]string{
+			"QueueUrl": q.url,
+		}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	attributes := maps.Clone(sqsQueueAttributeDefaults)
+	if fifo {
+		attributes["ContentBasedDeduplication"] = "false"
+		attributes["DeduplicationScope"] = "queue"
+		attributes["FifoQueue"] = "true"
+		attributes["FifoThroughputLimit"] = "perQueue"
+	}
+	maps.Copy(attributes, input.Attributes)
+	if _, ok := input.Attributes["KmsMasterKeyId"]; ok {
+		attributes["SqsManagedSseEnabled"] = "false"
+		if _, ok := attributes["KmsDataKeyReusePeriodSeconds"]; !ok {
+			attributes["KmsDataKeyReusePeriodSeconds"] = "300"
+		}
+	}
+	attributes["ApproximateNumberOfMessages"] = "0"
+	attributes["ApproximateNumberOfMessagesDelayed"] = "0"
+	attributes["ApproximateNumberOfMessagesNotVisible"] = "0"
+	attributes["CreatedTimestamp"] = now
+	attributes["LastModifiedTimestamp"] = now
+	attributes["QueueArn"] = rc.arn("sqs", input.QueueName)
+
+	q := &sqsQueue{
+		attributes: attributes,
+		name:       input.QueueName,
+		tags:       make(tags),
+		url:        fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", rc.region, rc.accountID, input.QueueName),
+	}
+	maps.Copy(q.tags, input.Tags)
+	queues[input.QueueName] = q
+
+	return map[string]string{
+		"QueueUrl": q.url,
+	}, nil
+}
+
+type sqsQueueInput struct {
+	AttributeNames []string
+	Attributes     map[string]string
+	QueueURL       string `json:"QueueUrl"`
+	TagKeys        []string
+	Tags           map[string]string
+}
+
+func (s *sqsService) deleteQueue(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.regionalQueues(rc), q.name)
+
+	return nil, nil
+}
+
+func (s *sqsService) getQueueAttributes(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range input.AttributeNames {
+		if name == "All" {
+			maps.Copy(attributes, q.attributes)
+			break
+		}
+		if v, ok := q.attributes[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	return map[string]any{
+		"Attributes": attributes,
+	}, nil
+}
+
+func (s *sqsService) setQueueAttributes(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Attributes {
+		// Setting an attribute to the empty string removes it.
+		if v == "" {
+			if d, ok := sqsQueueAttributeDefaults[k]; ok {
+				q.attributes[k] = d
+			} else {
+				delete(q.attributes, k)
+			}
+			continue
+		}
+		q.attributes[k] = v
+	}
+	q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+type getQueueURLInput struct {
+	QueueName              string
+	QueueOwnerAWSAccountID string `json:"QueueOwnerAWSAccountId"`
+}
+
+func (s *sqsService) getQueueURL(rc *requestContext, input *getQueueURLInput) (any, error) {
+	q, ok := s.regionalQueues(rc)[input.QueueName]
+	if !ok || (input.QueueOwnerAWSAccountID != "" && input.QueueOwnerAWSAccountID != rc.accountID) {
+		return nil, badRequestError("QueueDoesNotExist", "The specified queue does not exist.")
+	}
+
+	return map[string]string{
+		"QueueUrl": q.url,
+	}, nil
+}
+
+type listQueuesInput struct {
+	MaxResults      int
+	NextToken       string
+	QueueNamePrefix string
+}
+
+func (s *sqsService) listQueues(rc *requestContext, input *listQueuesInput) (any, error) {
+	queues := s.regionalQueues(rc)
+	var urls []string
+
+	for _, name := range sortedKeys(queues) {
+		if strings.HasPrefix(name, input.QueueNamePrefix) {
+			urls = append(urls, queues[name].url)
+		}
+	}
+
+	page, nextToken, err := paginate(urls, input.NextToken, input.MaxResults, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{
+		"QueueUrls": page,
+	}
+	if nextToken != "" {
+		output["NextToken"] = nextToken
+	}
+
+	return output, nil
+}
+
+func (s *sqsService) listQueueTags(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Tags": q.tags,
+	}, nil
+}
+
+func (s *sqsService) tagQueue(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(q.tags, input.Tags)
+
+	return nil, nil
+}
+
+func (s *sqsService) untagQueue(rc *requestContext, input *sqsQueueInput) (any, error) {
+	q, err := s.findQueueByURL(rc, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.tags.unset(input.TagKeys)
+
+	return nil, nil
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ssmService is a fake of the AWS Systems Manager Parameter Store API.
+type ssmService struct {
+	jsonHandler
+	parameters map[string]map[string]*ssmParameter // Keyed by Region, then name.
+}
+
+type ssmParameter struct {
+	allowedPattern   string
+	arn              string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             tags
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+func newSSM() *ssmService {
+	s := &ssmService{
+		parameters: make(map[string]map[string]*ssmParameter),
+	}
+	s.jsonHandler = jsonHandler{
+		contentType: "application/x-amz-json-1.1",
+		operations: map[string]jsonOperation{
+			"AddTagsToResource":      jsonOperationFunc(s.addTagsToResource),
+			"DeleteParameter":        jsonOperationFunc(s.deleteParameter),
+			"DescribeParameters":     jsonOperationFunc(s.describeParameters),
+			"GetParameter":           jsonOperationFunc(s.getParameter),
+			"GetParameters":          jsonOperationFunc(s.getParameters),
+			"ListTagsForResource":    jsonOperationFunc(s.listTagsForResource),
+			"PutParameter":           jsonOperationFunc(s.putParameter),
+			"RemoveTagsFromResource": jsonOperationFunc(s.removeTagsFromResource),
+		},
+	}
+
+	return s
+}
+
+func (s *ssmService) signingName() string {
+	return "ssm"
+}
+
+func (s *ssmService) regionalParameters(rc *requestContext) map[string]*ssmParameter {
+	v, ok := s.parameters[rc.region]
+	if !ok {
+		v = make(map[string]*ssmParameter)
+		s.parameters[rc.region] = v
+	}
+
+	return v
+}
+
+func (s *ssmService) findParameter(rc *requestContext, name string) (*ssmParameter, error) {
+	parameters := s.regionalParameters(rc)
+
+	// The parameter may be specified by ARN.
+	if strings.HasPrefix(name, "arn:") {
+		_, v, _ := strings.Cut(name, ":parameter")
+		if p, ok := parameters[v]; ok {
+			return p, nil
+		}
+		name = strings.TrimPrefix(v, "/")
+	}
+
+	p, ok := parameters[name]
+	if !ok {
+		return nil, badRequestError("ParameterNotFound", "Parameter %s not found.", name)
+	}
+
+	return p, nil
+}
+
+type ssmParameterOutput struct {
+	ARN              string  `json:"ARN"`
+	DataType         string  `json:"DataType"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Type             string  `json:"Type"`
+	Value            string  `json:"Value"`
+	Version          int64   `json:"Version"`
+}
+
+func (p *ssmParameter) output(withDecryption bool) ssmParameterOutput {
+	value := p.value
+	if p.typ == "SecureString" && !withDecryption {
+		value = base64.StdEncoding.EncodeToString([]byte(p.keyID + ":" + p.value))
+	}
+
+	return ssmParameterOutput{
+		ARN:              p.arn,
+		DataType:         p.dataType,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Type:             p.typ,
+		Value:            value,
+		Version:          p.version,
+	}
+}
+
+type ssmParameterMetadata struct {
+	ARN              string  `json:"ARN"`
+	AllowedPattern   string  `json:"AllowedPattern,omitempty"`
+	DataType         string  `json:"DataType"`
+	Description      string  `json:"Description,omitempty"`
+	KeyID            string  `json:"KeyId,omitempty"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Tier             string  `json:"Tier"`
+	Type             string  `json:"Type"`
+	Version          int64   `json:"Version"`
+}
+
+func (p *ssmParameter) metadata() ssmParameterMetadata {
+	return ssmParameterMetadata{
+		ARN:              p.arn,
+		AllowedPattern:   p.allowedPattern,
+		DataType:         p.dataType,
+		Description:      p.description,
+		KeyID:            p.keyID,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Tier:             p.tier,
+		Type:             p.typ,
+		Version:          p.version,
+	}
+}
+
+type putParameterInput struct {
+	AllowedPattern string
+	DataType       string
+	Description    string
+	KeyID          string `json:"KeyId"`
+	Name           string
+	Overwrite      bool
+	Tags           []tag
+	Tier           string
+	Type           string
+	Value          string
+}
+
+func (s *ssmService) putParameter(rc *requestContext, input *putParameterInput) (any, error) {
+	if input.Name == "" {
+		return nil, validationError("1 validation error detected: Value null at 'name' failed to satisfy constraint: Member must not be null")
+	}
+
+	if input.AllowedPattern != "" {
+		re, err := regexp.Compile(input.AllowedPattern)
+		if err != nil {
+			return nil, validationError("Invalid AllowedPattern %s", input.AllowedPattern)
+		}
+		if !re.MatchString(input.Value) {
+			return nil, badRequestError("ParameterPatternMismatchException", "Parameter value, cannot be validated against allowedPattern: %s", input.AllowedPattern)
+		}
+	}
+
+	parameters := s.regionalParameters(rc)
+	p, ok := parameters[input.Name]
+
+	if ok {
+		if !input.Overwrite {
+			return nil, badRequestError("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+		if len(input.Tags) > 0 {
+			return nil, validationError("Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+		}
+	} else {
+		if input.Type == "" {
+			return nil, validationError("A parameter type is required when you create a parameter.")
+		}
+
+		arn := rc.arn("ssm", "parameter/"+input.Name)
+		if strings.HasPrefix(input.Name, "/") {
+			arn = rc.arn("ssm", "parameter"+input.Name)
+		}
+
+		p = &ssmParameter{
+			arn:      arn,
+			dataType: "text",
+			name:     input.Name,
+			tags:     make(tags),
+			tier:     "Standard",
+		}
+		p.tags.set(input.Tags)
+		parameters[input.Name] = p
+	}
+
+	if input.Type != "" {
+		p.typ = input.Type
+	}
+	if input.DataType != "" {
+		p.dataType = input.DataType
+	}
+	if input.Tier != "" && input.Tier != "Intelligent-Tiering" {
+		p.tier = input.Tier
+	}
+	if p.typ == "SecureString" {
+		p.keyID = "alias/aws/ssm"
+		if input.KeyID != "" {
+			p.keyID = input.KeyID
+		}
+	} else {
+		p.keyID = ""
+	}
+	p.allowedPattern = input.AllowedPattern
+	p.description = input.Description
+	p.lastModifiedDate = time.Now()
+	p.value = input.Value
+	p.version++
+
+	return map[string]any{
+		"Tier":    p.tier,
+		"Version": p.version,
+	}, nil
+}
+
+type getParameterInput struct {
+	Name           string
+	WithDecryption bool
+}
+
+func (s *ssmService) getParameter(rc *requestContext, input *getParameterInput) (any, error) {
+	name, selector, _ := strings.Cut(input.Name, ":")
+	if strings.HasPrefix(input.Name, "arn:") {
+		name, selector = input.Name, ""
+	}
+
+	p, err := s.findParameter(rc, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector != "" && selector != strconv.FormatInt(p.version, 10) {
+		return nil, badRequestError("ParameterVersionNotFound", "Systems Manager could not find version %s of %s.", selector, name)
+	}
+
+	return map[string]any{
+		"Parameter": p.output(input.WithDecryption),
+	}, nil
+}
+
+type getParametersInput struct {
+	Names          []string
+	WithDecryption bool
+}
+
+func (s *ssmService) getParameters(rc *requestContext, input *getParametersInput) (any, error) {
+	parameters := []ssmParameterOutput{}
+	invalid := []string{}
+
+	for _, name := range input.Names {
+		p, err := s.findParameter(rc, name)
+		if err != nil {
+			invalid = append(invalid, name)
+			continue
+		}
+		parameters = append(parameters, p.output(input.WithDecryption))
+	}
+
+	return map[string]any{
+		"InvalidParameters": invalid,
+		"Parameters":        parameters,
+	}, nil
+}
+
+type deleteParameterInput struct {
+	Name string
+}
+
+func (s *ssmService) deleteParameter(rc *requestContext, input *deleteParameterInput) (any, error) {
+	p, err := s.findParameter(rc, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.regionalParameters(rc), p.name)
+
+	return nil, nil
+}
+
+type describeParametersInput struct {
+	MaxResults       int
+	NextToken        string
+	ParameterFilters []struct {
+		Key    string
+		Option string
+		Values []string
+	}
+}
+
+func (s *ssmService) describeParameters(rc *requestContext, input *describeParametersInput) (any, error) {
+	parameters := s.regionalParameters(rc)
+	var metadata []ssmParameterMetadata
+
+	for _, name := range sortedKeys(parameters) {
+		p := parameters[name]
+		match := true
+
+		for _, filter := range input.ParameterFilters {
+			var value string
+			switch filter.Key {
+			case "Name":
+				value = p.name
+			case "Type":
+				value = p.typ
+			case "Tier":
+				value = p.tier
+			case "DataType":
+				value = p.dataType
+			default:
+				return nil, badRequestError("InvalidFilterKey", "The following filter key is not valid: %s.", filter.Key)
+			}
+
+			matchesAny := false
+			for _, v := range filter.Values {
+				switch filter.Option {
+				case "BeginsWith":
+					matchesAny = matchesAny || strings.HasPrefix(value, v)
+				case "", "Equals":
+					matchesAny = matchesAny || value == v
+				default:
+					return nil, badRequestError("InvalidFilterOption", "The following filter option is not valid: %s.", filter.Option)
+				}
+			}
+			match = match && matchesAny
+		}
+
+		if match {
+			metadata = append(metadata, p.metadata())
+		}
+	}
+
+	page, nextToken, err := paginate(metadata, input.NextToken, input.MaxResults, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{
+		"Parameters": page,
+	}
+	if nextToken != "" {
+		output["NextToken"] = nextToken
+	}
+
+	return output, nil
+}
+
+type ssmTagsInput struct {
+	ResourceID   string `json:"ResourceId"`
+	ResourceType string
+	TagKeys      []string
+	Tags         []tag
+}
+
+func (s *ssmService) findTaggedResource(rc *requestContext, input *ssmTagsInput) (tags, error) {
+	if input.ResourceType != "Parameter" {
+		return nil, badRequestError("InvalidResourceType", "resource type %q is not implemented", input.ResourceType)
+	}
+
+	p, err := s.findParameter(rc, input.ResourceID)
+	if err != nil {
+		return nil, badRequestError("InvalidResourceId", "The resource ID %q is not valid. Verify the ID and try again.", input.ResourceID)
+	}
+
+	return p.tags, nil
+}
+
+func (s *ssmService) addTagsToResource(rc *requestContext, input *ssmTagsInput) (any, error) {
+	tags, err := s.findTaggedResource(rc, input)
+	if err != nil {
+		return nil, err
+	}
+
+	tags.set(input.Tags)
+
+	return nil, nil
+}
+
+func (s *ssmService) removeTagsFromResource(rc *requestContext, input *ssmTagsInput) (any, error) {
+	tags, err := s.findTaggedResource(rc, input)
+	if err != nil {
+		return nil, err
+	}
+
+	tags.unset(input.TagKeys)
+
+	return nil, nil
+}
+
+func (s *ssmService) listTagsForResource(rc *requestContext, input *ssmTagsInput) (any, error) {
+	tags, err := s.findTaggedResource(rc, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"TagList": tags.list(),
+	}, nil
+}
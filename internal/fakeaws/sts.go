@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"net/url"
+)
+
+// stsService is a fake of the AWS Security Token Service API.
+// It identifies every caller as the Backend account's root user.
+type stsService struct {
+	queryHandler
+}
+
+func newSTS() *stsService {
+	s := &stsService{}
+	s.queryHandler = queryHandler{
+		xmlns: "https://sts.amazonaws.com/doc/2011-06-15/",
+		operations: map[string]queryOperation{
+			"GetCallerIdentity": s.getCallerIdentity,
+		},
+	}
+
+	return s
+}
+
+func (s *stsService) signingName() string {
+	return "sts"
+}
+
+type getCallerIdentityResult struct {
+	Account string `xml:"Account"`
+	Arn     string `xml:"Arn"`
+	UserID  string `xml:"UserId"`
+}
+
+func (s *stsService) getCallerIdentity(rc *requestContext, _ url.Values) (any, error) {
+	return getCallerIdentityResult{
+		Account: rc.accountID,
+		Arn:     rc.globalARN("iam", "root"),
+		UserID:  rc.accountID,
+	}, nil
+}
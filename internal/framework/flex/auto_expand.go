@@ -5,8 +5,10 @@ package flex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -485,6 +487,26 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
+		opts := expander.getOptions()
+		if f, ok := opts.smithyDocumentConstructor(tTo); ok {
+			//
+			// types.String -> Smithy document.
+			//
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a Smithy document")
+
+			var data any
+			if err := json.Unmarshal([]byte(v.ValueString()), &data); err != nil {
+				tflog.SubsystemError(ctx, subsystemName, "Unmarshalling JSON document", map[string]any{
+					logAttrKeyError: err.Error(),
+				})
+				diags.Append(diagExpandingUnmarshalSmithyDocument(tTo, err))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(f(data)))
+			return diags
+		}
+
 		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
 			v, d := s.ValueInterface()
 			diags.Append(d...)
@@ -1260,6 +1282,95 @@ func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, t
 	return diags
 }
 
+// expandUnion copies the single set field of a Plugin Framework struct value to the corresponding member of an AWS API union interface value.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+
+	var (
+		fromField  reflect.StructField
+		memberType reflect.Type
+		setFields  []string
+	)
+	for _, member := range members {
+		field, ok := findUnionMemberField(typeFrom, member)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+				logAttrKeyTargetFieldname: unionMemberName(member),
+			})
+			continue
+		}
+
+		if !isUnionMemberFieldSet(valFrom.FieldByIndex(field.Index)) {
+			continue
+		}
+
+		fromField, memberType = field, member
+		setFields = append(setFields, field.Name)
+	}
+
+	switch len(setFields) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding union with no member set")
+		return diags
+
+	case 1:
+
+	default:
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union with multiple members set", map[string]any{
+			"fields": setFields,
+		})
+		diags.Append(diagExpandingMultipleUnionMembers(typeFrom, setFields))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: fromField.Name,
+		"member":                  fullTypeName(memberType),
+	})
+
+	tMember := memberType
+	if tMember.Kind() == reflect.Pointer {
+		tMember = tMember.Elem()
+	}
+
+	to := reflect.New(tMember)
+	toFieldVal := to.Elem().FieldByName(unionMemberValueFieldName)
+	if !toFieldVal.IsValid() {
+		diags.Append(diagExpandingIncompatibleTypes(typeFrom, memberType))
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(unionMemberValueFieldName), toFieldVal, fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if memberType.Kind() == reflect.Pointer {
+		valTo.Set(to)
+	} else {
+		valTo.Set(to.Elem())
+	}
+
+	return diags
+}
+
+// isUnionMemberFieldSet returns whether a union member field has a value.
+// Null, unknown and empty collection values are not considered set.
+func isUnionMemberFieldSet(v reflect.Value) bool {
+	value, ok := v.Interface().(attr.Value)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	if value, ok := value.(valueWithElementsAs); ok {
+		return len(value.Elements()) > 0
+	}
+
+	return true
+}
+
 func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1329,3 +1440,21 @@ func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.Er
 			fmt.Sprintf("Source type %q cannot be expanded to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagExpandingUnmarshalSmithyDocument(targetType reflect.Type, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Unmarshalling JSON document of type %q failed: %s", fullTypeName(targetType), err.Error()),
+	)
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldNames []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		"An error occurred while expanding configuration. "+
+			fmt.Sprintf("At most one union member may be set in %q, got: %s.", fullTypeName(sourceType), strings.Join(fieldNames, ", ")),
+	)
+}
@@ -6,6 +6,7 @@ package flex
 import (
 	"bytes"
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -5997,6 +5998,237 @@ func TestExpandTypedExpander(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"null value": {
+			Options:    []AutoFlexOptionsFunc{WithSmithyDocument(newTestDocument)},
+			Source:     &tfSmithyDocument{Field1: types.StringNull()},
+			Target:     &awsSmithyDocument{},
+			WantTarget: &awsSmithyDocument{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				traceExpandingNullValue("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+			},
+		},
+		"JSON value": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocument(newTestDocument)},
+			Source:  &tfSmithyDocument{Field1: types.StringValue(`{"field1": "a"}`)},
+			Target:  &awsSmithyDocument{},
+			WantTarget: &awsSmithyDocument{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				infoTargetIsSmithyDocument("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+			},
+		},
+		"invalid JSON value": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocument(newTestDocument)},
+			Source:  &tfSmithyDocument{Field1: types.StringValue(`{"field1": `)},
+			Target:  &awsSmithyDocument{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnmarshalSmithyDocument(reflect.TypeFor[testDocumentInterface](), errors.New("unexpected end of JSON input")),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				infoTargetIsSmithyDocument("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				errorUnmarshallingJSONDocument("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface](), errors.New("unexpected end of JSON input")),
+			},
+		},
+		"no option": {
+			Source:     &tfSmithyDocument{Field1: types.StringValue(`{"field1": "a"}`)},
+			Target:     &awsSmithyDocument{},
+			WantTarget: &awsSmithyDocument{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[testDocumentInterface]()),
+				{
+					"@level":             "error",
+					"@module":            "provider.autoflex",
+					"@message":           "AutoFlex Expand; incompatible types",
+					"from":               map[string]any{},
+					"to":                 float64(reflect.Interface),
+					logAttrKeySourcePath: "Field1",
+					logAttrKeySourceType: fullTypeName(reflect.TypeFor[types.String]()),
+					logAttrKeyTargetPath: "Field1",
+					logAttrKeyTargetType: fullTypeName(reflect.TypeFor[testDocumentInterface]()),
+				},
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	withUnion := WithUnion[awsUnion](&awsUnionMemberString{}, &awsUnionMemberObject{})
+
+	testCases := autoFlexTestCases{
+		"no member set": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			Target:     &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingUnionNoMemberSet("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"primitive member": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("value1"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion](), reflect.TypeFor[*awsUnionMemberString]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"nested object member": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value1"),
+					}),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberObject{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("Field1[0]", "Object", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion](), reflect.TypeFor[*awsUnionMemberObject]()),
+				infoConvertingWithPath("Field1[0].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Object[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Object[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"multiple members set": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("value1"),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value2"),
+					}),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), []string{"String", "Object"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				errorExpandingMultipleUnionMembers("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion](), []string{"String", "Object"}),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value2"),
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{
+						Value: "value1",
+					},
+					&awsUnionMemberObject{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion](), reflect.TypeFor[*awsUnionMemberString]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoTargetIsUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("Field1[1]", "Object", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion](), reflect.TypeFor[*awsUnionMemberObject]()),
+				infoConvertingWithPath("Field1[1].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1].Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].Object[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1].Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].Object[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Options          []AutoFlexOptionsFunc
 	Source           any
@@ -190,7 +190,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -609,7 +609,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +653,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1215,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1235,6 +1235,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if _, ok := to.(Flattener); !ok && flattener.Options.isUnionMember(vFrom.Elem().Type()) {
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1567,6 +1586,40 @@ func newStringValueFromReflectPointerValue(v reflect.Value) (attr.Value, diag.Di
 	return newStringValueFromReflectValue(v.Elem())
 }
 
+// flattenUnionMember copies the value of an AWS API union member to the corresponding field of a Plugin Framework struct value.
+// All other fields are set to null.
+func flattenUnionMember(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	toField, ok := findUnionMemberField(valTo.Type(), typeFrom)
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: unionMemberName(typeFrom),
+		})
+		return diags
+	}
+
+	fromFieldVal := valFrom.FieldByName(unionMemberValueFieldName)
+	if !fromFieldVal.IsValid() {
+		diags.Append(DiagFlatteningIncompatibleTypes(typeFrom, valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), fromFieldVal, targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Flattener) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -5372,6 +5372,172 @@ func TestFlattenStructSetOfStringEnum(t *testing.T) {
 	}
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	withUnion := WithUnion[awsUnion](&awsUnionMemberString{}, &awsUnionMemberObject{})
+
+	testCases := autoFlexTestCases{
+		"nil union": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source:  awsUnionSingle{},
+			Target:  &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"primitive member": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("value1"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberString](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningMatchedUnionMember("Field1", reflect.TypeFor[awsUnionMemberString](), "Field1", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nested object member": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObject{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("value1"),
+					}),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberObject](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningMatchedUnionMember("Field1", reflect.TypeFor[awsUnionMemberObject](), "Field1", "Object", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Object", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Object.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{withUnion},
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{
+						Value: "value1",
+					},
+					&awsUnionMemberObject{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value2"),
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1[0]", reflect.TypeFor[awsUnionMemberString](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningMatchedUnionMember("Field1[0]", reflect.TypeFor[awsUnionMemberString](), "Field1[0]", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].String", reflect.TypeFor[types.String]()),
+				infoSourceIsUnionMember("Field1[1]", reflect.TypeFor[awsUnionMemberObject](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningMatchedUnionMember("Field1[1]", reflect.TypeFor[awsUnionMemberObject](), "Field1[1]", "Object", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Object", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Value.Field1", reflect.TypeFor[string](), "Field1[1].Object.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"no option": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				{
+					"@level":   "error",
+					"@module":  "provider.autoflex",
+					"@message": "AutoFlex Flatten; incompatible types",
+					"from":     float64(reflect.Interface),
+					"to": map[string]any{
+						"ElemType": map[string]any{
+							"AttrTypes": map[string]any{
+								"object": map[string]any{
+									"ElemType": map[string]any{
+										"AttrTypes": map[string]any{
+											"field1": map[string]any{},
+										},
+									},
+								},
+								"string": map[string]any{},
+							},
+						},
+					},
+					logAttrKeySourcePath: "Field1",
+					logAttrKeySourceType: fullTypeName(reflect.TypeFor[awsUnion]()),
+					logAttrKeyTargetPath: "Field1",
+					logAttrKeyTargetType: fullTypeName(reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				},
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -100,8 +100,16 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	opts := flexer.getOptions()
+
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := opts.unionMembers(valTo.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,10 +124,16 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if opts.isUnionMember(valFrom.Type()) {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source is a union member")
+		diags.Append(flattenUnionMember(ctx, sourcePath, valFrom, targetPath, valTo, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
-	opts := flexer.getOptions()
 	for i := 0; i < typeFrom.NumField(); i++ {
 		fromField := typeFrom.Field(i)
 		if fromField.PkgPath != "" {
@@ -289,6 +303,40 @@ type fieldOpts struct {
 	omitempty bool
 }
 
+// unionMemberValueFieldName is the name of the field holding an AWS API union member's value.
+const unionMemberValueFieldName = "Value"
+
+// unionMemberName returns the name of an AWS API union member type, e.g. "Static" for "PolicyDefinitionMemberStatic".
+func unionMemberName(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	name := t.Name()
+	if i := strings.LastIndex(name, "Member"); i >= 0 {
+		return name[i+len("Member"):]
+	}
+
+	return name
+}
+
+// findUnionMemberField returns the field of struct type `t` corresponding to union member type `member`.
+func findUnionMemberField(t reflect.Type, member reflect.Type) (reflect.StructField, bool) {
+	name := unionMemberName(member)
+
+	if field, ok := t.FieldByName(name); ok {
+		return field, true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() && strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
 // valueWithElementsAs extends the Value interface for values that have an ElementsAs method.
 type valueWithElementsAs interface {
 	attr.Value
@@ -484,6 +484,59 @@ type tfJSONStringer struct {
 	Field1 fwtypes.SmithyJSON[smithyjson.JSONStringer] `tfsdk:"field1"`
 }
 
+// testDocumentInterface mimics an AWS SDK for Go v2 service's `document.Interface`.
+type testDocumentInterface interface {
+	smithydocument.Marshaler
+	smithydocument.Unmarshaler
+}
+
+func newTestDocument(v any) testDocumentInterface {
+	return &testJSONDocument{Value: v}
+}
+
+type awsSmithyDocument struct {
+	Field1 testDocumentInterface
+}
+
+type tfSmithyDocument struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+// awsUnion mimics an AWS SDK for Go v2 union type.
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnion() {}
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnion() {}
+
+var (
+	_ awsUnion = &awsUnionMemberString{}
+	_ awsUnion = &awsUnionMemberObject{}
+)
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type tfUnion struct {
+	String types.String                                         `tfsdk:"string"`
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+}
+
 type tfListNestedObject[T any] struct {
 	Field1 fwtypes.ListNestedObjectValueOf[T] `tfsdk:"field1"`
 }
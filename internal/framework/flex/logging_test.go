@@ -493,6 +493,88 @@ func infoSourceImplementsJSONStringer(sourcePath string, sourceType reflect.Type
 	}
 }
 
+func infoTargetIsSmithyDocument(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Target is a Smithy document", sourcePath, sourceType, targetPath, targetType)
+}
+
+func infoTargetIsUnion(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Target is a union", sourcePath, sourceType, targetPath, targetType)
+}
+
+func infoSourceIsUnionMember(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Source is a union member", sourcePath, sourceType, targetPath, targetType)
+}
+
+func traceExpandingUnionNoMemberSet(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Trace.String(),
+		"@module":            logModule,
+		"@message":           "Expanding union with no member set",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func traceExpandingMatchedUnionMember(sourcePath, sourceFieldName string, sourceType reflect.Type, targetPath string, targetType, memberType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		"member":                  fullTypeName(memberType),
+	}
+}
+
+func traceFlatteningMatchedUnionMember(sourcePath string, sourceType reflect.Type, targetPath, targetFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
+func errorExpandingMultipleUnionMembers(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type, fieldNames []string) map[string]any {
+	fields := make([]any, len(fieldNames))
+	for i, v := range fieldNames {
+		fields[i] = v
+	}
+
+	return map[string]any{
+		"@level":             hclog.Error.String(),
+		"@module":            logModule,
+		"@message":           "Expanding union with multiple members set",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+		"fields":             fields,
+	}
+}
+
+func errorUnmarshallingJSONDocument(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type, err error) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Error.String(),
+		"@module":            logModule,
+		"@message":           "Unmarshalling JSON document",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+		logAttrKeyError:      err.Error(),
+	}
+}
+
 func errorSourceDoesNotImplementAttrValue(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Error.String(),
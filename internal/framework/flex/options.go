@@ -3,6 +3,10 @@
 
 package flex
 
+import (
+	"reflect"
+)
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -25,6 +29,14 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// smithyDocumentConstructors stores the constructors of Smithy document
+	// interface types, keyed by interface type
+	smithyDocumentConstructors map[reflect.Type]func(any) any
+
+	// unionMemberTypes stores the member types of AWS SDK for Go v2 union
+	// interface types, keyed by interface type
+	unionMemberTypes map[reflect.Type][]reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +87,43 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithSmithyDocument registers the constructor for a service's Smithy document
+// interface type, usually the service's `document.NewLazyDocument` function
+//
+// Use this option to expand JSON strings (e.g. fwtypes.SmithyJSON) into fields
+// of the document interface type. Flattening documents requires no option.
+func WithSmithyDocument[T any](f func(any) T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.smithyDocumentConstructors == nil {
+			o.smithyDocumentConstructors = make(map[reflect.Type]func(any) any)
+		}
+		o.smithyDocumentConstructors[reflect.TypeFor[T]()] = func(v any) any {
+			return f(v)
+		}
+	}
+}
+
+// WithUnion registers the member types of an AWS SDK for Go v2 union interface
+// type, e.g.
+//
+//	WithUnion[awstypes.PolicyDefinition](&awstypes.PolicyDefinitionMemberStatic{}, &awstypes.PolicyDefinitionMemberTemplateLinked{})
+//
+// Use this option to expand and flatten union values to and from a nested
+// object with one field per union member. Fields are matched to union members
+// by name, e.g. field "Static" to member type "PolicyDefinitionMemberStatic".
+// When expanding, at most one of the fields may be set.
+func WithUnion[T any](members ...T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.unionMemberTypes == nil {
+			o.unionMemberTypes = make(map[reflect.Type][]reflect.Type)
+		}
+		t := reflect.TypeFor[T]()
+		for _, member := range members {
+			o.unionMemberTypes[t] = append(o.unionMemberTypes[t], reflect.TypeOf(member))
+		}
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
@@ -84,3 +133,27 @@ func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	}
 	return false
 }
+
+// smithyDocumentConstructor returns the registered constructor for the specified Smithy document interface type
+func (o *AutoFlexOptions) smithyDocumentConstructor(t reflect.Type) (func(any) any, bool) {
+	f, ok := o.smithyDocumentConstructors[t]
+	return f, ok
+}
+
+// unionMembers returns the registered member types of the specified union interface type
+func (o *AutoFlexOptions) unionMembers(t reflect.Type) ([]reflect.Type, bool) {
+	members, ok := o.unionMemberTypes[t]
+	return members, ok
+}
+
+// isUnionMember returns true if t, or a pointer to t, is a registered union member type
+func (o *AutoFlexOptions) isUnionMember(t reflect.Type) bool {
+	for _, members := range o.unionMemberTypes {
+		for _, member := range members {
+			if member == t || member == reflect.PointerTo(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// SDKv2StateUpgrader returns a state upgrader for a resource migrated from Plugin SDKv2 without changes to its schema.
+// The prior state is passed through the specified Plugin SDKv2 state upgrade functions in order, as Plugin SDKv2 would have done,
+// and the result is decoded using the resource's current schema.
+// Because Plugin Framework does not chain state upgraders, the state upgrader for a prior version must be passed
+// all Plugin SDKv2 state upgrade functions from that version onwards.
+func (r *ResourceWithConfigure) SDKv2StateUpgrader(upgraders ...schema.StateUpgradeFunc) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		if request.RawState == nil || request.RawState.JSON == nil {
+			response.Diagnostics.AddError("Unable to Upgrade Resource State", "Prior resource state is not JSON-encoded. Refresh the resource with an earlier version of the provider.")
+
+			return
+		}
+
+		var state map[string]any
+		if err := json.Unmarshal(request.RawState.JSON, &state); err != nil {
+			response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("decoding prior resource state: %s", err))
+
+			return
+		}
+
+		for _, upgrader := range upgraders {
+			var err error
+			state, err = upgrader(ctx, state, r.Meta())
+
+			if err != nil {
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+
+				return
+			}
+		}
+
+		b, err := json.Marshal(state)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("encoding upgraded resource state: %s", err))
+
+			return
+		}
+
+		// Attributes removed by the Plugin SDKv2 resource's schema, but not by its state upgrade functions, are ignored.
+		rawState := tfprotov6.RawState{JSON: b}
+		v, err := rawState.UnmarshalWithOpts(response.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+				IgnoreUndefinedAttributes: true,
+			},
+		})
+		if err != nil {
+			response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("decoding upgraded resource state: %s", err))
+
+			return
+		}
+
+		response.State.Raw = v
+	}
+}
+
+// SDKv2StateTypeDiffs returns the differences between a Plugin Framework resource's state type and the
+// JSON-encoded state type (the implied type of the core configuration schema) of the Plugin SDKv2 resource it replaces.
+// No differences means that state written by the Plugin SDKv2 resource can be read by the Plugin Framework resource without upgrade.
+func SDKv2StateTypeDiffs(ctx context.Context, r resource.Resource, sdkv2StateType []byte) ([]string, error) {
+	want, err := ctyjson.UnmarshalType(sdkv2StateType)
+	if err != nil {
+		return nil, fmt.Errorf("decoding Plugin SDKv2 state type: %w", err)
+	}
+
+	var response resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		return nil, fmt.Errorf("reading Plugin Framework schema: %v", response.Diagnostics.Errors())
+	}
+
+	got, err := ctyTypeFromTerraformType(response.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return stateTypeDiffs("", got, want), nil
+}
+
+func ctyTypeFromTerraformType(t tftypes.Type) (cty.Type, error) {
+	switch t := t.(type) {
+	case tftypes.Object:
+		attributeTypes := make(map[string]cty.Type, len(t.AttributeTypes))
+		for k, v := range t.AttributeTypes {
+			attributeType, err := ctyTypeFromTerraformType(v)
+			if err != nil {
+				return cty.NilType, err
+			}
+			attributeTypes[k] = attributeType
+		}
+		return cty.Object(attributeTypes), nil
+	case tftypes.List:
+		elementType, err := ctyTypeFromTerraformType(t.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.List(elementType), nil
+	case tftypes.Set:
+		elementType, err := ctyTypeFromTerraformType(t.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Set(elementType), nil
+	case tftypes.Map:
+		elementType, err := ctyTypeFromTerraformType(t.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Map(elementType), nil
+	case tftypes.Tuple:
+		elementTypes := make([]cty.Type, len(t.ElementTypes))
+		for i, v := range t.ElementTypes {
+			elementType, err := ctyTypeFromTerraformType(v)
+			if err != nil {
+				return cty.NilType, err
+			}
+			elementTypes[i] = elementType
+		}
+		return cty.Tuple(elementTypes), nil
+	}
+
+	switch {
+	case t.Is(tftypes.Bool):
+		return cty.Bool, nil
+	case t.Is(tftypes.Number):
+		return cty.Number, nil
+	case t.Is(tftypes.String):
+		return cty.String, nil
+	case t.Is(tftypes.DynamicPseudoType):
+		return cty.DynamicPseudoType, nil
+	}
+
+	return cty.NilType, fmt.Errorf("unsupported Terraform type: %s", t)
+}
+
+func stateTypeDiffs(path string, got, want cty.Type) []string {
+	var diffs []string
+
+	switch {
+	case got.IsObjectType() && want.IsObjectType():
+		gotAttributeTypes, wantAttributeTypes := got.AttributeTypes(), want.AttributeTypes()
+		keys := tfmaps.Keys(gotAttributeTypes)
+		for k := range wantAttributeTypes {
+			if _, ok := gotAttributeTypes[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			attributePath := k
+			if path != "" {
+				attributePath = path + "." + k
+			}
+
+			gotAttributeType, gotOK := gotAttributeTypes[k]
+			wantAttributeType, wantOK := wantAttributeTypes[k]
+			switch {
+			case !gotOK:
+				diffs = append(diffs, fmt.Sprintf("%s: missing attribute of type %s", attributePath, wantAttributeType.FriendlyName()))
+			case !wantOK:
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected attribute of type %s", attributePath, gotAttributeType.FriendlyName()))
+			default:
+				diffs = append(diffs, stateTypeDiffs(attributePath, gotAttributeType, wantAttributeType)...)
+			}
+		}
+	case got.IsListType() && want.IsListType(), got.IsSetType() && want.IsSetType(), got.IsMapType() && want.IsMapType():
+		diffs = append(diffs, stateTypeDiffs(path+"[*]", got.ElementType(), want.ElementType())...)
+	case !got.Equals(want):
+		diffs = append(diffs, fmt.Sprintf("%s: got type %s, want %s", path, got.FriendlyName(), want.FriendlyName()))
+	}
+
+	return diffs
+}
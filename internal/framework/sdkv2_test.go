@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (*testResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"count": schema.Int64Attribute{
+				Optional: true,
+			},
+			"names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"config": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (*testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+func (*testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse)       {}
+func (*testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+func (*testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func TestSDKv2StateTypeDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stateType cty.Type
+		want      []string
+	}{
+		"identical": {
+			stateType: cty.Object(map[string]cty.Type{
+				names.AttrID: cty.String,
+				"count":      cty.Number,
+				"names":      cty.Set(cty.String),
+				"config": cty.List(cty.Object(map[string]cty.Type{
+					names.AttrEnabled: cty.Bool,
+				})),
+			}),
+		},
+		"differences": {
+			stateType: cty.Object(map[string]cty.Type{
+				names.AttrID: cty.String,
+				"count":      cty.String,
+				"names":      cty.List(cty.String),
+				"config": cty.List(cty.Object(map[string]cty.Type{
+					names.AttrEnabled: cty.Bool,
+					"mode":            cty.String,
+				})),
+				"removed": cty.Bool,
+			}),
+			want: []string{
+				"config[*].mode: missing attribute of type string",
+				"count: got type number, want string",
+				"names: got type set of string, want list of string",
+				"removed: missing attribute of type bool",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			stateType, err := ctyjson.MarshalType(testCase.stateType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := framework.SDKv2StateTypeDiffs(ctx, &testResource{}, stateType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSDKv2StateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &testResource{}
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	upgradeV0 := func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+		rawState["count"] = rawState["number"]
+		delete(rawState, "number")
+		return rawState, nil
+	}
+	upgradeV1 := func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+		rawState["names"] = []any{rawState["name"]}
+		return rawState, nil
+	}
+
+	request := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"test","number":3,"name":"n1","config":[]}`),
+		},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+		},
+	}
+
+	r.SDKv2StateUpgrader(upgradeV0, upgradeV1)(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var got struct {
+		Config types.List   `tfsdk:"config"`
+		Count  types.Int64  `tfsdk:"count"`
+		ID     types.String `tfsdk:"id"`
+		Names  types.Set    `tfsdk:"names"`
+	}
+	response.Diagnostics.Append(response.State.Get(ctx, &got)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	if got, want := got.Count.ValueInt64(), int64(3); got != want {
+		t.Errorf("count = %d, want %d", got, want)
+	}
+	if got, want := got.ID.ValueString(), "test"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+	if got, want := len(got.Names.Elements()), 1; got != want {
+		t.Errorf("len(names) = %d, want %d", got, want)
+	}
+
+	// Attributes not in the schema are ignored.
+	response = resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+		},
+	}
+	r.SDKv2StateUpgrader()(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var count types.Int64
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("count"), &count)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	if !count.IsNull() {
+		t.Errorf("count = %s, want null", count)
+	}
+}
@@ -37,7 +37,7 @@ type listTypeOf[T attr.Value] struct {
 	basetypes.ListType
 }
 
-func newListTypeOf[T attr.Value](ctx context.Context) listTypeOf[T] {
+func NewListTypeOf[T attr.Value](ctx context.Context) listTypeOf[T] {
 	return listTypeOf[T]{basetypes.ListType{ElemType: newAttrTypeOf[T](ctx)}}
 }
 
@@ -122,7 +122,7 @@ func (v ListValueOf[T]) Equal(o attr.Value) bool {
 }
 
 func (v ListValueOf[T]) Type(ctx context.Context) attr.Type {
-	return newListTypeOf[T](ctx)
+	return NewListTypeOf[T](ctx)
 }
 
 func NewListValueOfNull[T attr.Value](ctx context.Context) ListValueOf[T] {
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// policyVersion is the current version of the IAM policy language.
+	policyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges the statements of IAM policy JSON documents into a single normalized policy document. " +
+			"Equivalent statements are included once. Statements with the same `Sid` must be equivalent.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy JSON documents to merge",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies returns a normalized policy document containing the distinct statements of the specified policies.
+func mergePolicies(policies []string) (string, error) {
+	statements := make([]any, 0)
+
+	for i, policy := range policies {
+		doc, err := decodePolicy(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		for _, statement := range policyStatements(doc) {
+			duplicate, err := containsEquivalentStatement(statements, statement)
+			if err != nil {
+				return "", fmt.Errorf("policy %d: %w", i, err)
+			}
+
+			if !duplicate {
+				statements = append(statements, statement)
+			}
+		}
+	}
+
+	policy, err := tfjson.EncodeToString(map[string]any{
+		"Version":   policyVersion,
+		"Statement": statements,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(policy)
+}
+
+// containsEquivalentStatement returns whether the statements contain a statement equivalent to the specified statement.
+// An error is returned if a non-equivalent statement has the same Sid.
+func containsEquivalentStatement(statements []any, statement any) (bool, error) {
+	sid := statementSID(statement)
+
+	for _, v := range statements {
+		equivalent, err := statementsEquivalent(v, statement)
+		if err != nil {
+			return false, err
+		}
+
+		if equivalent {
+			return true, nil
+		}
+
+		if sid != "" && statementSID(v) == sid {
+			return false, fmt.Errorf("statements with Sid %q are not equivalent", sid)
+		}
+	}
+
+	return false, nil
+}
+
+// statementsEquivalent returns whether two policy statements are semantically equivalent.
+func statementsEquivalent(s1, s2 any) (bool, error) {
+	p1, err := tfjson.EncodeToString(map[string]any{"Version": policyVersion, "Statement": []any{s1}})
+	if err != nil {
+		return false, err
+	}
+
+	p2, err := tfjson.EncodeToString(map[string]any{"Version": policyVersion, "Statement": []any{s2}})
+	if err != nil {
+		return false, err
+	}
+
+	return verify.PolicyStringsEquivalent(p1, p2), nil
+}
+
+func statementSID(statement any) string {
+	if m, ok := statement.(map[string]any); ok {
+		if v, ok := m["Sid"].(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// decodePolicy decodes an IAM policy JSON document.
+func decodePolicy(policy string) (map[string]any, error) {
+	var doc map[string]any
+
+	if err := tfjson.DecodeFromString(policy, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if doc == nil {
+		return nil, errors.New("policy must be a JSON object")
+	}
+
+	if _, ok := doc["Statement"]; !ok {
+		return nil, errors.New("policy has no Statement element")
+	}
+
+	return doc, nil
+}
+
+// policyStatements returns a policy's statements. The Statement element may be a single statement or a list.
+func policyStatements(doc map[string]any) []any {
+	switch v := doc["Statement"].(type) {
+	case []any:
+		return v
+	case nil:
+		return nil
+	default:
+		return []any{v}
+	}
+}
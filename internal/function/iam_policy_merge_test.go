@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]},{"Effect":"Deny","Action":"iam:*","Resource":"*"}]}`,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"iam:*","Effect":"Deny","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(args...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_conflictingSid(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":{"Sid":"Object","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		`{"Version":"2012-10-17","Statement":{"Sid":"Object","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}}`,
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(args...),
+				ExpectError: regexache.MustCompile(`Sid[\s\n]*"Object"[\s\n]*are[\s\n]*not[\s\n]*equivalent`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = fmt.Sprintf("%q", arg)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]s])
+}
+`, strings.Join(quoted, ", "))
+}
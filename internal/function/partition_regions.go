@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = partitionRegionsFunction{}
+
+func NewPartitionRegionsFunction() function.Function {
+	return &partitionRegionsFunction{}
+}
+
+type partitionRegionsFunction struct{}
+
+func (f partitionRegionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "partition_regions"
+}
+
+func (f partitionRegionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "partition_regions Function",
+		MarkdownDescription: "Lists the Regions known to the provider in an AWS partition",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "AWS partition, e.g. `aws` or `aws-us-gov`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f partitionRegionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	partitions := endpoints.DefaultPartitions()
+	idx := slices.IndexFunc(partitions, func(p endpoints.Partition) bool {
+		return p.ID() == arg
+	})
+	if idx == -1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unknown partition: %s", arg)))
+		return
+	}
+
+	result := slices.Sorted(maps.Keys(partitions[idx].Regions()))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
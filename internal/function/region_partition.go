@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var regionPartitionResultAttrTypes = map[string]attr.Type{
+	"partition":          types.StringType,
+	"partition_name":     types.StringType,
+	"dns_suffix":         types.StringType,
+	"region_description": types.StringType,
+}
+
+var _ function.Function = regionPartitionFunction{}
+
+func NewRegionPartitionFunction() function.Function {
+	return &regionPartitionFunction{}
+}
+
+type regionPartitionFunction struct{}
+
+func (f regionPartitionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_partition"
+}
+
+func (f regionPartitionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "region_partition Function",
+		MarkdownDescription: "Looks up the AWS partition of a Region. " +
+			"Regions not yet known to the provider are matched by their partition's Region naming pattern.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: regionPartitionResultAttrTypes,
+		},
+	}
+}
+
+func (f regionPartitionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), arg)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unknown Region: %s", arg)))
+		return
+	}
+
+	// Regions matched only by pattern have no description.
+	value := map[string]attr.Value{
+		"partition":          types.StringValue(partition.ID()),
+		"partition_name":     types.StringValue(partition.Name()),
+		"dns_suffix":         types.StringValue(partition.DNSSuffix()),
+		"region_description": types.StringValue(partition.Regions()[arg].Description()),
+	}
+
+	result, d := types.ObjectValue(regionPartitionResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRegionPartitionFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionPartitionFunctionConfig("cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-cn"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionPartitionFunction_unknown(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRegionPartitionFunctionConfig("mars-north-1"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*Region`),
+			},
+		},
+	})
+}
+
+func testRegionPartitionFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  partition = provider::aws::region_partition(%[1]q)
+}
+
+output "partition" {
+  value = local.partition.partition
+}
+
+output "dns_suffix" {
+  value = local.partition.dns_suffix
+}
+`, arg)
+}
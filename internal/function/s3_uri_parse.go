@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket": types.StringType,
+	"key":    types.StringType,
+	"region": types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI into its constituent parts. Both `s3://` URIs and " +
+			"virtual-hosted-style and path-style HTTPS URLs are supported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket": types.StringValue(uri.bucket),
+		"key":    types.StringValue(uri.key),
+		"region": types.StringValue(uri.region),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type s3URI struct {
+	bucket string
+	key    string
+	region string
+}
+
+const (
+	s3URIScheme = "s3://"
+)
+
+// parseS3URI parses an S3 URI of the form `s3://bucket/key` or an S3 HTTPS URL.
+func parseS3URI(s string) (s3URI, error) {
+	// s3:// URIs are not URL-encoded.
+	if v, ok := strings.CutPrefix(s, s3URIScheme); ok {
+		bucket, key, _ := strings.Cut(v, "/")
+		if bucket == "" {
+			return s3URI{}, errors.New("bucket must not be empty")
+		}
+
+		return s3URI{bucket: bucket, key: key}, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s3URI{}, err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return s3URI{}, fmt.Errorf(`scheme must be one of "s3", "https" or "http"`)
+	}
+
+	host, dnsSuffix, ok := trimPartitionDNSSuffix(u.Hostname())
+	if !ok {
+		return s3URI{}, fmt.Errorf("unsupported S3 endpoint: %s", u.Hostname())
+	}
+
+	// The S3 label is one of the last three labels, e.g.
+	//   bucket.s3, bucket.s3.us-west-2, bucket.s3-us-west-2, bucket.s3.dualstack.us-west-2, s3.us-west-2.
+	labels := strings.Split(host, ".")
+	i := len(labels) - 1
+	for ; i >= 0 && i >= len(labels)-3; i-- {
+		if labels[i] == "s3" || strings.HasPrefix(labels[i], "s3-") {
+			break
+		}
+	}
+	if i < 0 || i < len(labels)-3 {
+		return s3URI{}, fmt.Errorf("unsupported S3 endpoint: %s", u.Hostname())
+	}
+
+	var region string
+	if v, ok := strings.CutPrefix(labels[i], "s3-"); ok {
+		region = v
+	}
+	for _, label := range labels[i+1:] {
+		if label != "dualstack" {
+			region = label
+		}
+	}
+	if region != "" {
+		if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok || partition.DNSSuffix() != dnsSuffix {
+			return s3URI{}, fmt.Errorf("unsupported S3 endpoint: %s", u.Hostname())
+		}
+	}
+
+	uri := s3URI{region: region}
+	path := strings.TrimPrefix(u.Path, "/")
+
+	if i > 0 {
+		// Virtual-hosted-style.
+		uri.bucket = strings.Join(labels[:i], ".")
+		uri.key = path
+	} else {
+		// Path-style.
+		uri.bucket, uri.key, _ = strings.Cut(path, "/")
+	}
+
+	if uri.bucket == "" {
+		return s3URI{}, errors.New("bucket must not be empty")
+	}
+
+	return uri, nil
+}
+
+// trimPartitionDNSSuffix removes an AWS partition's DNS suffix from a host name.
+// Partitions may share a DNS suffix. The longest matching suffix is removed.
+func trimPartitionDNSSuffix(host string) (string, string, bool) {
+	var dnsSuffix string
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		if v := partition.DNSSuffix(); strings.HasSuffix(host, "."+v) && len(v) > len(dnsSuffix) {
+			dnsSuffix = v
+		}
+	}
+
+	if dnsSuffix == "" {
+		return "", "", false
+	}
+
+	return strings.TrimSuffix(host, "."+dnsSuffix), dnsSuffix, true
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// userDataMIMEBoundary is the fixed MIME boundary used so that the function's result is deterministic.
+	userDataMIMEBoundary = "MIMEBOUNDARY"
+)
+
+var userDataMIMEPartAttrTypes = map[string]attr.Type{
+	"content_type": types.StringType,
+	"content":      types.StringType,
+}
+
+var _ function.Function = userDataMIMEMultipartFunction{}
+
+func NewUserDataMIMEMultipartFunction() function.Function {
+	return &userDataMIMEMultipartFunction{}
+}
+
+type userDataMIMEMultipartFunction struct{}
+
+type userDataMIMEPart struct {
+	ContentType string `tfsdk:"content_type"`
+	Content     string `tfsdk:"content"`
+}
+
+func (f userDataMIMEMultipartFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_data_mime_multipart"
+}
+
+func (f userDataMIMEMultipartFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_data_mime_multipart Function",
+		MarkdownDescription: "Assembles a MIME multi-part document from parts such as cloud-init configuration and shell scripts, " +
+			"suitable for use as EC2 instance user data.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name: "parts",
+				ElementType: types.ObjectType{
+					AttrTypes: userDataMIMEPartAttrTypes,
+				},
+				MarkdownDescription: "Parts of the document, each with a `content_type` and `content`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userDataMIMEMultipartFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []userDataMIMEPart
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildMIMEMultipart(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// buildMIMEMultipart returns a multipart/mixed MIME document containing the specified parts.
+// Parts that are not 7-bit ASCII are base64-encoded.
+func buildMIMEMultipart(parts []userDataMIMEPart) (string, error) {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+	if err := w.SetBoundary(userDataMIMEBoundary); err != nil {
+		return "", err
+	}
+
+	for i, part := range parts {
+		if part.ContentType == "" {
+			return "", fmt.Errorf("part %d: content_type must not be empty", i)
+		}
+
+		if _, _, err := mime.ParseMediaType(part.ContentType); err != nil {
+			return "", fmt.Errorf("part %d: invalid content_type: %w", i, err)
+		}
+
+		if strings.Contains(part.Content, "--"+userDataMIMEBoundary) {
+			return "", fmt.Errorf("part %d: content must not contain the MIME boundary (%s)", i, userDataMIMEBoundary)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("MIME-Version", "1.0")
+
+		content := part.Content
+		if isASCII(content) {
+			header.Set("Content-Transfer-Encoding", "7bit")
+		} else {
+			header.Set("Content-Transfer-Encoding", "base64")
+			content = base64.StdEncoding.EncodeToString([]byte(content))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := pw.Write([]byte(content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Content-Type: multipart/mixed; boundary=%q\r\n", userDataMIMEBoundary)
+	sb.WriteString("MIME-Version: 1.0\r\n\r\n")
+	sb.Write(body.Bytes())
+
+	return sb.String(), nil
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestUserDataMIMEMultipartFunction_valid(t *testing.T) {
+	t.Parallel()
+	expected := "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"--MIMEBOUNDARY\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/cloud-config\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#cloud-config\n" +
+		"\r\n" +
+		"--MIMEBOUNDARY\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"Content-Type: text/x-shellscript\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"IyEvYmluL3NoCmVjaG8gaMOpbGxvCg==\r\n" +
+		"--MIMEBOUNDARY--\r\n"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::user_data_mime_multipart([
+    {
+      content_type = "text/cloud-config"
+      content      = "#cloud-config\n"
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/sh\necho héllo\n"
+    },
+  ])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataMIMEMultipartFunction_invalidContentType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::user_data_mime_multipart([
+    {
+      content_type = ""
+      content      = "#cloud-config\n"
+    },
+  ])
+}
+`,
+				ExpectError: regexache.MustCompile(`content_type[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// VPC and subnet CIDR block size limits.
+	// See https://docs.aws.amazon.com/vpc/latest/userguide/vpc-cidr-blocks.html
+	// and https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+	vpcIPv4MinPrefixLength    = 16
+	vpcIPv4MaxPrefixLength    = 28
+	vpcIPv6MinPrefixLength    = 44
+	vpcIPv6MaxPrefixLength    = 60
+	subnetIPv6MaxPrefixLength = 64
+	// IPv6 CIDR blocks must be allocated in increments of /4.
+	ipv6PrefixLengthIncrement = 4
+)
+
+var _ function.Function = vpcSubnetCIDRsFunction{}
+
+func NewVPCSubnetCIDRsFunction() function.Function {
+	return &vpcSubnetCIDRsFunction{}
+}
+
+type vpcSubnetCIDRsFunction struct{}
+
+func (f vpcSubnetCIDRsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_cidrs"
+}
+
+func (f vpcSubnetCIDRsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_cidrs Function",
+		MarkdownDescription: "Carves consecutive subnet CIDR blocks of the specified prefix lengths from a VPC CIDR block. " +
+			"The VPC and subnet CIDR blocks must be within the size limits imposed by Amazon VPC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr_block",
+				MarkdownDescription: "VPC IPv4 or IPv6 CIDR block",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Prefix length of each subnet CIDR block",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f vpcSubnetCIDRsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := carveSubnetCIDRs(vpcCIDRBlock, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// carveSubnetCIDRs allocates subnet CIDR blocks in order from the start of the VPC CIDR block.
+// Each subnet CIDR block is aligned on its own size, so space may be skipped between subnets.
+func carveSubnetCIDRs(vpcCIDRBlock string, prefixLengths []int64) ([]string, error) {
+	vpc, err := netip.ParsePrefix(vpcCIDRBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpc.Masked() != vpc {
+		return nil, fmt.Errorf("VPC CIDR block (%s) is not a network address, expected %s", vpc, vpc.Masked())
+	}
+
+	minSubnetPrefixLength, maxSubnetPrefixLength := vpc.Bits(), vpcIPv4MaxPrefixLength
+	if vpc.Addr().Is4() {
+		if vpc.Bits() < vpcIPv4MinPrefixLength || vpc.Bits() > vpcIPv4MaxPrefixLength {
+			return nil, fmt.Errorf("VPC IPv4 CIDR block prefix length must be between /%d and /%d", vpcIPv4MinPrefixLength, vpcIPv4MaxPrefixLength)
+		}
+	} else {
+		if vpc.Bits() < vpcIPv6MinPrefixLength || vpc.Bits() > vpcIPv6MaxPrefixLength || vpc.Bits()%ipv6PrefixLengthIncrement != 0 {
+			return nil, fmt.Errorf("VPC IPv6 CIDR block prefix length must be between /%d and /%d in increments of /%d", vpcIPv6MinPrefixLength, vpcIPv6MaxPrefixLength, ipv6PrefixLengthIncrement)
+		}
+		maxSubnetPrefixLength = subnetIPv6MaxPrefixLength
+	}
+
+	addressBits := vpc.Addr().BitLen()
+	start := new(big.Int).SetBytes(vpc.Addr().AsSlice())
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(addressBits-vpc.Bits())))
+	next := new(big.Int).Set(start)
+
+	subnets := make([]string, 0, len(prefixLengths))
+
+	for i, v := range prefixLengths {
+		prefixLength := int(v)
+
+		if prefixLength < minSubnetPrefixLength || prefixLength > maxSubnetPrefixLength {
+			return nil, fmt.Errorf("subnet %d: prefix length must be between /%d and /%d", i, minSubnetPrefixLength, maxSubnetPrefixLength)
+		}
+
+		if vpc.Addr().Is6() && prefixLength%ipv6PrefixLengthIncrement != 0 {
+			return nil, fmt.Errorf("subnet %d: IPv6 prefix length must be in increments of /%d", i, ipv6PrefixLengthIncrement)
+		}
+
+		size := new(big.Int).Lsh(big.NewInt(1), uint(addressBits-prefixLength))
+
+		// Round up to the next multiple of the subnet's size.
+		offset := new(big.Int).Sub(next, start)
+		if rem := new(big.Int).Mod(offset, size); rem.Sign() != 0 {
+			next.Add(next, new(big.Int).Sub(size, rem))
+		}
+
+		if new(big.Int).Add(next, size).Cmp(end) > 0 {
+			return nil, fmt.Errorf("subnet %d: insufficient space in VPC CIDR block (%s) for a /%d subnet", i, vpc, prefixLength)
+		}
+
+		addr, ok := netip.AddrFromSlice(next.FillBytes(make([]byte, addressBits/8)))
+		if !ok {
+			return nil, fmt.Errorf("subnet %d: invalid address", i)
+		}
+
+		subnets = append(subnets, netip.PrefixFrom(addr, prefixLength).String())
+		next.Add(next, size)
+	}
+
+	return subnets, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetCIDRsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetCIDRsFunctionConfig("10.0.0.0/16", "[24, 24, 20, 28]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/24,10.0.1.0/24,10.0.16.0/20,10.0.32.0/28"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetCIDRsFunctionConfig("2600:1f14:abc:de00::/56", "[64, 64, 60]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:abc:de00::/64,2600:1f14:abc:de01::/64,2600:1f14:abc:de10::/60"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRsFunction_invalidVPCPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetCIDRsFunctionConfig("10.0.0.0/8", "[24]"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*/16[\s\n]*and[\s\n]*/28`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRsFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetCIDRsFunctionConfig("10.0.0.0/24", "[26, 26, 26, 26, 28]"),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetCIDRsFunctionConfig(vpcCIDRBlock, prefixLengths string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::vpc_subnet_cidrs(%[1]q, %[2]s))
+}
+`, vpcCIDRBlock, prefixLengths)
+}
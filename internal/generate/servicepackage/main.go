@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -58,11 +59,15 @@ func main() {
 		v := &visitor{
 			g: g,
 
-			ephemeralResources:   make(map[string]ResourceDatum, 0),
-			frameworkDataSources: make(map[string]ResourceDatum, 0),
-			frameworkResources:   make(map[string]ResourceDatum, 0),
-			sdkDataSources:       make(map[string]ResourceDatum),
-			sdkResources:         make(map[string]ResourceDatum),
+			// Global services are marked explicitly in names/data/names_data.hcl.
+			isGlobalService: l.IsGlobal(),
+
+			ephemeralResources:     make(map[string]ResourceDatum, 0),
+			frameworkDataSources:   make(map[string]ResourceDatum, 0),
+			frameworkListResources: make(map[string]ResourceDatum, 0),
+			frameworkResources:     make(map[string]ResourceDatum, 0),
+			sdkDataSources:         make(map[string]ResourceDatum),
+			sdkResources:           make(map[string]ResourceDatum),
 		}
 
 		v.processDir(".")
@@ -79,6 +84,7 @@ func main() {
 			ProviderNameUpper:       l.ProviderNameUpper(),
 			EphemeralResources:      v.ephemeralResources,
 			FrameworkDataSources:    v.frameworkDataSources,
+			FrameworkListResources:  v.frameworkListResources,
 			FrameworkResources:      v.frameworkResources,
 			SDKDataSources:          v.sdkDataSources,
 			SDKResources:            v.sdkResources,
@@ -120,6 +126,22 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	RegionDeclared          bool
+	IsGlobal                bool
+	IsRegionOverrideEnabled bool
+	ARNIdentity             bool
+	IdentityAttributes      []IdentityAttributeDatum
+	AdoptionFinder          string
+}
+
+// HasIdentity returns whether the resource publishes an identity.
+func (d ResourceDatum) HasIdentity() bool {
+	return d.ARNIdentity || len(d.IdentityAttributes) > 0
+}
+
+type IdentityAttributeDatum struct {
+	Name     string
+	Optional bool
 }
 
 type ServiceDatum struct {
@@ -130,6 +152,7 @@ type ServiceDatum struct {
 	ProviderNameUpper       string
 	EphemeralResources      map[string]ResourceDatum
 	FrameworkDataSources    map[string]ResourceDatum
+	FrameworkListResources  map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
@@ -155,11 +178,14 @@ type visitor struct {
 	functionName string
 	packageName  string
 
-	ephemeralResources   map[string]ResourceDatum
-	frameworkDataSources map[string]ResourceDatum
-	frameworkResources   map[string]ResourceDatum
-	sdkDataSources       map[string]ResourceDatum
-	sdkResources         map[string]ResourceDatum
+	isGlobalService bool
+
+	ephemeralResources     map[string]ResourceDatum
+	frameworkDataSources   map[string]ResourceDatum
+	frameworkListResources map[string]ResourceDatum
+	frameworkResources     map[string]ResourceDatum
+	sdkDataSources         map[string]ResourceDatum
+	sdkResources           map[string]ResourceDatum
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -201,12 +227,47 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
-	d := ResourceDatum{}
+	// Look first for tagging, Region and identity annotations.
+	d := ResourceDatum{
+		IsRegionOverrideEnabled: true,
+	}
+
+	if v.isGlobalService {
+		d.RegionDeclared = true
+		d.IsGlobal = true
+		d.IsRegionOverrideEnabled = false
+	}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			d.RegionDeclared = true
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsGlobal = b
+					if b {
+						d.IsRegionOverrideEnabled = false
+					}
+				}
+			}
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else if b && v.isGlobalService {
+					v.errs = append(v.errs, fmt.Errorf("Region override cannot be enabled in a global service: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.IsRegionOverrideEnabled = b
+				}
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -224,6 +285,65 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Adoption" {
+			args := common.ParseArgs(m[3])
+
+			attr, ok := args.Keyword["finder"]
+			if !ok {
+				v.errs = append(v.errs, fmt.Errorf("no Adoption finder: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.AdoptionFinder = attr
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ArnIdentity" {
+			args := common.ParseArgs(m[3])
+
+			if d.HasIdentity() {
+				v.errs = append(v.errs, fmt.Errorf("multiple identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := "arn"
+			if len(args.Positional) > 0 {
+				attr = args.Positional[0]
+			}
+
+			d.ARNIdentity = true
+			d.IdentityAttributes = []IdentityAttributeDatum{
+				{Name: namesgen.ConstOrQuote(attr)},
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNIdentity {
+				v.errs = append(v.errs, fmt.Errorf("multiple identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			identityAttribute := IdentityAttributeDatum{
+				Name: namesgen.ConstOrQuote(args.Positional[0]),
+			}
+
+			if attr, ok := args.Keyword["optional"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid IdentityAttribute/optional value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					identityAttribute.Optional = b
+				}
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, identityAttribute)
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -238,8 +358,21 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.Name = attr
 			}
 
+			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource", "FrameworkDataSource", "FrameworkListResource", "FrameworkResource", "SDKDataSource":
+				if d.AdoptionFinder != "" {
+					v.errs = append(v.errs, fmt.Errorf("adoption is only supported for SDK Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+			}
+
 			switch annotationName := m[1]; annotationName {
 			case "EphemeralResource":
+				if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("identity is only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -263,6 +396,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.ephemeralResources[typeName] = d
 				}
 			case "FrameworkDataSource":
+				if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("identity is only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -285,6 +423,34 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkDataSources[typeName] = d
 				}
+			case "FrameworkListResource":
+				if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("identity is only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.frameworkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Framework List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.frameworkListResources[typeName] = d
+				}
 			case "FrameworkResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -309,6 +475,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.frameworkResources[typeName] = d
 				}
 			case "SDKDataSource":
+				if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("identity is only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -332,6 +503,16 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkDataSources[typeName] = d
 				}
 			case "SDKResource":
+				if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("identity is only supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.AdoptionFinder != "" && !d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("adoption requires transparent tagging: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -354,7 +535,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Adoption", "ArnIdentity", "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glob
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Match reports whether the slash-separated name matches the pattern.
+// The pattern syntax is that of path.Match, with the addition that a "**" path segment matches zero or more segments.
+func Match(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if matchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(patterns[0], names[0]); err != nil || !ok {
+			return false
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0
+}
+
+// Selected returns whether the slash-separated name matches any include pattern (or there are none)
+// and doesn't match any exclude pattern.
+func Selected(name string, includes, excludes []string) bool {
+	if len(includes) > 0 && !slices.ContainsFunc(includes, func(pattern string) bool {
+		return Match(pattern, name)
+	}) {
+		return false
+	}
+
+	return !slices.ContainsFunc(excludes, func(pattern string) bool {
+		return Match(pattern, name)
+	})
+}
+
+// ValidatePattern is a schema.SchemaValidateFunc that validates a glob pattern.
+func ValidatePattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, value, err))
+	}
+
+	return
+}
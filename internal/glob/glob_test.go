@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glob
+
+import (
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/guide/index.html", true},
+		{"docs/**", "docs/guide/index.html", true},
+		{"docs/**", "assets/app.js", false},
+		{"assets/*.js", "assets/app.js", true},
+		{"assets/*.js", "assets/vendor/app.js", false},
+		{"assets/**/*.js", "assets/vendor/app.js", true},
+		{"**", "a/b/c", true},
+		{"[", "[", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+" "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := Match(testCase.pattern, testCase.name), testCase.want; got != want {
+				t.Errorf("Match(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestSelected(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		includes []string
+		excludes []string
+		want     bool
+	}{
+		{"index.js", nil, nil, true},
+		{"index.js", []string{"*.js"}, nil, true},
+		{"README.md", []string{"*.js"}, nil, false},
+		{"test/index.js", nil, []string{"test/**"}, false},
+		{"lib/index.js", []string{"**/*.js"}, []string{"test/**"}, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := Selected(testCase.name, testCase.includes, testCase.excludes), testCase.want; got != want {
+				t.Errorf("Selected(%q, %q, %q) = %t, want %t", testCase.name, testCase.includes, testCase.excludes, got, want)
+			}
+		})
+	}
+}
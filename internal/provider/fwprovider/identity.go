@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that publishes a resource identity.
+// Only resources that publish an identity may implement resource.ResourceWithIdentity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func newWrappedResourceWithIdentity(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region *regionAttribute, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	w := &wrappedResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		interceptors:     interceptors,
+		region:           region,
+		identity:         identity,
+	}
+
+	return &wrappedResourceWithIdentity{
+		wrappedResource: w,
+	}
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identitySchema(w.identity)
+}
+
+// identitySchema returns the identity schema for the specified resource identity.
+// ARN-identified resources' identity is the ARN alone, otherwise the identity is
+// the account ID, Region (unless global) and the resource-specific identifying attributes.
+func identitySchema(identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute)
+
+	if !identity.IsARN {
+		attributes[names.AttrAccountID] = identityschema.StringAttribute{
+			OptionalForImport: true,
+			Description:       "The AWS account ID. Defaults to the account ID of the provider's credentials.",
+		}
+
+		if !identity.IsGlobal {
+			attributes[names.AttrRegion] = identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The AWS Region. Defaults to the Region set in the provider configuration.",
+			}
+		}
+	}
+
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: !v.Optional,
+			OptionalForImport: v.Optional,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// identityImport represents the values parsed from an import identity.
+type identityImport struct {
+	id             string            // The import ID passed to the resource's ImportState method
+	overrideRegion string            // Any per-resource Region override
+	values         map[string]string // Resource-specific identifying attribute values
+}
+
+// parseImportIdentity parses the specified import identity.
+// The import ID passed to the resource's ImportState method is the identifying attribute's value
+// or, for multiple identifying attributes, the values joined with flex.ResourceIdSeparator.
+func parseImportIdentity(ctx context.Context, identity *types.ServicePackageResourceIdentity, importIdentity tfsdk.ResourceIdentity, meta *conns.AWSClient, diags *diag.Diagnostics) identityImport {
+	var result identityImport
+	var accountID, region string
+
+	if !identity.IsARN {
+		var v *string
+		diags.Append(importIdentity.GetAttribute(ctx, path.Root(names.AttrAccountID), &v)...)
+		accountID = aws.ToString(v)
+
+		if !identity.IsGlobal {
+			var v *string
+			diags.Append(importIdentity.GetAttribute(ctx, path.Root(names.AttrRegion), &v)...)
+			region = aws.ToString(v)
+		}
+	}
+
+	result.values = make(map[string]string)
+	var ids []string
+	for _, attribute := range identity.Attributes {
+		var v *string
+		diags.Append(importIdentity.GetAttribute(ctx, path.Root(attribute.Name), &v)...)
+		result.values[attribute.Name] = aws.ToString(v)
+		ids = append(ids, aws.ToString(v))
+	}
+
+	if diags.HasError() {
+		return result
+	}
+
+	if identity.IsARN {
+		attributeName := identity.Attributes[0].Name
+		arn, err := arn.Parse(result.values[attributeName])
+		if err != nil {
+			diags.AddAttributeError(path.Root(attributeName), "Invalid Import Identity", fmt.Sprintf("parsing ARN: %s", err))
+			return result
+		}
+
+		if got, want := arn.Partition, meta.Partition(ctx); got != want {
+			diags.AddAttributeError(path.Root(attributeName), "Invalid Import Identity", fmt.Sprintf("ARN partition (%s) does not match the provider's partition (%s)", got, want))
+			return result
+		}
+
+		accountID = arn.AccountID
+		if !identity.IsGlobal {
+			region = arn.Region
+		}
+	}
+
+	if accountID != "" {
+		if got, want := accountID, meta.AccountID(ctx); got != want {
+			diags.AddError("Invalid Import Identity", fmt.Sprintf("account ID (%s) does not match the provider's account ID (%s)", got, want))
+			return result
+		}
+	}
+
+	if region != "" && region != meta.DefaultRegion(ctx) {
+		if err := meta.ValidateOverrideRegion(ctx, region); err != nil {
+			diags.AddError("Invalid Import Identity", err.Error())
+			return result
+		}
+
+		result.overrideRegion = region
+	}
+
+	result.id = strings.Join(ids, flex.ResourceIdSeparator)
+
+	return result
+}
+
+// identityResourceInterceptor sets a resource's identity after a successful Create, Read or Update.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// setIdentity sets the resource's identity from its state and the provider's account ID and effective Region.
+func (r identityResourceInterceptor) setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	if !r.identity.IsARN {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID(ctx))...)
+
+		if !r.identity.IsGlobal {
+			diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+		}
+	}
+
+	for _, attribute := range r.identity.Attributes {
+		var v *string
+		diags.Append(state.GetAttribute(ctx, path.Root(attribute.Name), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.Name), v)...)
+	}
+
+	return diags
+}
@@ -576,3 +576,113 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		// Set the per-resource Region override, if any, before any AWS API calls are made.
+		var region *string
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx, diags = withOverrideRegion(ctx, meta, region, diags)
+	case After:
+		// Set the Region in effect in state.
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region *string
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx, diags = withOverrideRegion(ctx, meta, region, diags)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region *string
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx, diags = withOverrideRegion(ctx, meta, region, diags)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region *string
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx, diags = withOverrideRegion(ctx, meta, region, diags)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region *string
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx, diags = withOverrideRegion(ctx, meta, region, diags)
+	}
+
+	return ctx, diags
+}
+
+// withOverrideRegion validates the specified per-resource Region override and sets it in Context.
+func withOverrideRegion(ctx context.Context, meta *conns.AWSClient, region *string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if region == nil {
+		return ctx, diags
+	}
+
+	if err := meta.ValidateOverrideRegion(ctx, *region); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+		return ctx, diags
+	}
+
+	return conns.WithOverrideRegion(ctx, *region), diags
+}
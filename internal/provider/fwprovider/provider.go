@@ -8,10 +8,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,13 +28,17 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -173,6 +182,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"credential_process": schema.StringAttribute{
+							Optional:    true,
+							Description: "Command whose output supplies the credentials used to assume the role. Only valid for the first role.",
+						},
 						"duration": schema.StringAttribute{
 							CustomType:  fwtypes.DurationType,
 							Optional:    true,
@@ -195,6 +208,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							Optional:    true, // For historical reasons, we allow an empty `assume_role` block
 							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
 						},
+						"serial_number": schema.StringAttribute{
+							Optional:    true,
+							Description: "Serial number or Amazon Resource Name (ARN) of the MFA device required to assume the role.",
+						},
 						"session_name": schema.StringAttribute{
 							Optional:    true,
 							Description: "An identifier for the assumed role session.",
@@ -208,6 +225,14 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							Optional:    true,
 							Description: "Assume role session tags.",
 						},
+						"token_code": schema.StringAttribute{
+							Optional:    true,
+							Description: "MFA token code.",
+						},
+						"token_command": schema.StringAttribute{
+							Optional:    true,
+							Description: "Command whose output is the MFA token code.",
+						},
 						"transitive_tag_keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -216,6 +241,45 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"assume_role_with_saml": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"policy": schema.StringAttribute{
+							Optional:    true,
+							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+						},
+						"policy_arns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+						},
+						"principal_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM SAML identity provider that issued the assertion.",
+						},
+						"role_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+						},
+						"saml_assertion": schema.StringAttribute{
+							Optional:    true,
+							Description: "Base64 encoded SAML authentication response provided by the identity provider.",
+						},
+						"saml_assertion_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "File containing a base64 encoded SAML authentication response provided by the identity provider.",
+						},
+					},
+				},
+			},
 			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -270,6 +334,27 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"http_recording": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to record or replay AWS API interactions.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(vcr.Modes()...),
+							},
+							Description: "Whether AWS API interactions are recorded or replayed. Valid values are `record` and `replay`.",
+						},
+						names.AttrPath: schema.StringAttribute{
+							Required:    true,
+							Description: "Path of the directory containing recorded AWS API interactions.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -292,6 +377,80 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side request rate limits for AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of requests that can be made in a burst. Defaults to `requests_per_second` rounded up.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("requests_per_second")),
+							},
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Maximum average number of requests per second to the service's API.",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0.001),
+							},
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service, e.g. `ec2`. Valid values are the keys of the `endpoints` configuration block.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(names.ProviderPackages()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"operation": schema.ListNestedBlock{
+							Description: "Configuration blocks with client-side request rate limits for individual API operations.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"burst": schema.Int64Attribute{
+										Optional:    true,
+										Description: "Maximum number of requests that can be made in a burst. Defaults to `requests_per_second` rounded up.",
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required:    true,
+										Description: "Name of the API operation, e.g. `DescribeInstances`.",
+									},
+									"requests_per_second": schema.Float64Attribute{
+										Required:    true,
+										Description: "Maximum average number of requests per second.",
+										Validators: []validator.Float64{
+											float64validator.AtLeast(0.001),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_adoption": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to adopt existing resources instead of failing to create them.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether existing resources are adopted on create. Can be overridden with a supported resource's `adopt_existing` argument.",
+						},
+						"tag_key": schema.StringAttribute{
+							Optional:    true,
+							Description: "Key of the resource tag used to identify existing resources for adoption.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -305,6 +464,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -353,6 +513,18 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			// Inject the per-resource Region override attribute unless the data source is global or already has a `region` attribute.
+			var region *regionAttribute
+			if v.Region.IsRegionOverrideEnabled() {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					region = newDataSourceRegionAttribute(schemaResponse.Schema)
+					interceptors = append(interceptors, regionDataSourceInterceptor{})
+				}
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -373,7 +545,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -429,6 +601,18 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			// Inject the per-resource Region override attribute unless the resource is global or already has a `region` attribute.
+			var region *regionAttribute
+			if v.Region.IsRegionOverrideEnabled() {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					region = newResourceRegionAttribute(schemaResponse.Schema)
+					interceptors = append(interceptors, regionResourceInterceptor{})
+				}
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -457,8 +641,39 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if identity := v.Identity; identity != nil {
+				// The resource publishes a resource identity.
+				// Ensure that the identifying attributes are in the schema.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if len(identity.Attributes) == 0 {
+					errs = append(errs, fmt.Errorf("no identity attributes defined: %s", typeName))
+					continue
+				}
+				if identity.IsARN && len(identity.Attributes) != 1 {
+					errs = append(errs, fmt.Errorf("ARN identity must have exactly one identity attribute: %s", typeName))
+					continue
+				}
+				if !tfslices.All(identity.Attributes, func(attribute itypes.ServicePackageResourceIdentityAttribute) bool {
+					_, ok := schemaResponse.Schema.Attributes[attribute.Name]
+					return ok
+				}) {
+					errs = append(errs, fmt.Errorf("identity attribute not defined in schema: %s", typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: identity})
+
+				resources = append(resources, func() resource.Resource {
+					return newWrappedResourceWithIdentity(bootstrapContext, inner, interceptors, region, identity)
+				})
+
+				continue
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -533,6 +748,86 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// The resource type name is determined by the List Resource implementing
+// the Metadata method. All resources must have unique names.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	// Listed resources are read by the corresponding wrapped resource, which must publish a resource identity.
+	resources := make(map[string]func() resource.Resource)
+	for _, f := range p.Resources(ctx) {
+		r := f()
+		if _, ok := r.(resource.ResourceWithIdentity); !ok {
+			continue
+		}
+
+		metadataResponse := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+		resources[metadataResponse.TypeName] = f
+	}
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.FrameworkListResources(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating list resource", map[string]interface{}{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				metadataResponse := resource.MetadataResponse{}
+				inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+				typeName := metadataResponse.TypeName
+
+				// Temporary check that type name from annotation equals Metadata response.
+				if typeName != v.TypeName {
+					errs = append(errs, fmt.Errorf("list resource %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, v.TypeName))
+				}
+
+				newResource, ok := resources[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("no resource with identity for list resource: %s", typeName))
+					continue
+				}
+
+				// bootstrapContext is run on all wrapped methods.
+				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					if meta != nil {
+						ctx = meta.RegisterLogger(ctx)
+						ctx = flex.RegisterLogger(ctx)
+					}
+
+					return ctx
+				}
+
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(bootstrapContext, inner, newResource().(resource.ResourceWithConfigure))
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -542,6 +837,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewPartitionRegionsFunction,
+		tffunction.NewRegionPartitionFunction,
+		tffunction.NewS3URIParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewUserDataMIMEMultipartFunction,
+		tffunction.NewVPCSubnetCIDRsFunction,
 	}
 }
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionAttribute implements the injected per-resource Region override attribute for a Plugin Framework
+// data source or resource whose own schema (and so model) does not declare a `region` attribute.
+// The attribute is removed from values passed to the inner data source or resource and restored in values returned from it.
+type regionAttribute struct {
+	inner tfsdk.State // Only the Schema field is used.
+}
+
+func newDataSourceRegionAttribute(innerSchema datasourceschema.Schema) *regionAttribute {
+	return &regionAttribute{
+		inner: tfsdk.State{Schema: innerSchema},
+	}
+}
+
+func newResourceRegionAttribute(innerSchema resourceschema.Schema) *regionAttribute {
+	return &regionAttribute{
+		inner: tfsdk.State{Schema: innerSchema},
+	}
+}
+
+// dataSourceRegionSchema returns the schema of the injected per-resource Region override attribute for data sources.
+func dataSourceRegionSchema() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// resourceRegionSchema returns the schema of the injected per-resource Region override attribute for resources.
+// Defaulting and replacement on change are handled in the wrapped resource's ModifyPlan.
+func resourceRegionSchema() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func (r *regionAttribute) config(ctx context.Context, outer tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	return tfsdk.Config{
+		Raw:    r.toInner(ctx, outer.Raw, diags),
+		Schema: r.inner.Schema,
+	}
+}
+
+func (r *regionAttribute) plan(ctx context.Context, outer tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{
+		Raw:    r.toInner(ctx, outer.Raw, diags),
+		Schema: r.inner.Schema,
+	}
+}
+
+func (r *regionAttribute) state(ctx context.Context, outer tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{
+		Raw:    r.toInner(ctx, outer.Raw, diags),
+		Schema: r.inner.Schema,
+	}
+}
+
+// outerPlan returns the inner plan with the specified Region restored, using the outer plan's schema.
+func (r *regionAttribute) outerPlan(ctx context.Context, inner, outer tfsdk.Plan, region tftypes.Value, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{
+		Raw:    r.toOuter(ctx, inner.Raw, outer.Schema.Type().TerraformType(ctx), region, diags),
+		Schema: outer.Schema,
+	}
+}
+
+// outerState returns the inner state with the specified Region restored, using the outer state's schema.
+func (r *regionAttribute) outerState(ctx context.Context, inner, outer tfsdk.State, region tftypes.Value, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{
+		Raw:    r.toOuter(ctx, inner.Raw, outer.Schema.Type().TerraformType(ctx), region, diags),
+		Schema: outer.Schema,
+	}
+}
+
+// toInner returns a copy of the specified object value with the `region` attribute removed.
+func (r *regionAttribute) toInner(ctx context.Context, v tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	typ := r.inner.Schema.Type().TerraformType(ctx)
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		diags.AddError("Removing Region attribute", fmt.Sprintf("converting value: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+
+	delete(attributes, names.AttrRegion)
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+// toOuter returns a copy of the specified object value with the `region` attribute added.
+func (r *regionAttribute) toOuter(_ context.Context, v tftypes.Value, typ tftypes.Type, region tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		diags.AddError("Adding Region attribute", fmt.Sprintf("converting value: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+
+	if region.Type() == nil {
+		region = tftypes.NewValue(tftypes.String, nil)
+	}
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+// regionValue returns the value of the `region` attribute in the specified object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if v.IsNull() || !v.IsKnown() {
+		return null
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return null
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		return v
+	}
+
+	return null
+}
@@ -5,12 +5,21 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	sweepfw "github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // contextFunc augments Context.
@@ -23,13 +32,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// region is non-nil if the per-resource Region override attribute is injected.
+	region *regionAttribute
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, region *regionAttribute) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
@@ -41,11 +53,34 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region != nil {
+		response.Schema.Attributes[names.AttrRegion] = dataSourceRegionSchema()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.region == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		innerRequest := request
+		innerRequest.Config = w.region.config(ctx, request.Config, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, regionValue(request.Config.Raw), &response.Diagnostics)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -140,6 +175,54 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 	}
 }
 
+// wrappedListResource represents a dispatcher for a Plugin Framework list resource.
+// Listed resources' state is read by the corresponding wrapped resource so that its interceptors are run.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            list.ListResourceWithConfigure
+	meta             *conns.AWSClient
+	resource         resource.ResourceWithConfigure
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResourceWithConfigure, resource resource.ResourceWithConfigure) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		resource:         resource,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+	w.resource.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if v, ok := w.inner.(sweepfw.ListResourceWithReader); ok {
+		v.ListWithReader(ctx, request, stream, w.resource)
+		return
+	}
+
+	w.inner.List(ctx, request, stream)
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -147,13 +230,18 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// region is non-nil if the per-resource Region override attribute is injected.
+	region *regionAttribute
+	// identity is non-nil if the resource publishes a resource identity.
+	identity *itypes.ServicePackageResourceIdentity
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region *regionAttribute) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
@@ -165,11 +253,35 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region != nil {
+		response.Schema.Attributes[names.AttrRegion] = resourceRegionSchema()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if w.region == nil {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		innerRequest := request
+		innerRequest.Config = w.region.config(ctx, request.Config, &response.Diagnostics)
+		innerRequest.Plan = w.region.plan(ctx, request.Plan, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		w.inner.Create(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, regionValue(request.Plan.Raw), &response.Diagnostics)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -179,7 +291,26 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.region == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		innerRequest := request
+		innerRequest.State = w.region.state(ctx, request.State, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, regionValue(request.State.Raw), &response.Diagnostics)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -189,7 +320,28 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if w.region == nil {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		innerRequest := request
+		innerRequest.Config = w.region.config(ctx, request.Config, &response.Diagnostics)
+		innerRequest.Plan = w.region.plan(ctx, request.Plan, &response.Diagnostics)
+		innerRequest.State = w.region.state(ctx, request.State, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		w.inner.Update(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, regionValue(request.Plan.Raw), &response.Diagnostics)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -199,7 +351,26 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if w.region == nil {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		innerRequest := request
+		innerRequest.State = w.region.state(ctx, request.State, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, regionValue(request.State.Raw), &response.Diagnostics)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -218,7 +389,76 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+
+		// Import by identity (an import block with an `identity` attribute) supplies no import ID.
+		var identityValues map[string]string
+		var overrideRegion string
+		if request.ID == "" && w.identity != nil && request.Identity != nil {
+			identityImport := parseImportIdentity(ctx, w.identity, *request.Identity, w.meta, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if identityImport.overrideRegion != "" && w.region == nil {
+				response.Diagnostics.AddError("Invalid Import Identity", fmt.Sprintf("resource does not support per-resource Region override: %s", identityImport.overrideRegion))
+
+				return
+			}
+
+			request.ID = identityImport.id
+			overrideRegion = identityImport.overrideRegion
+			identityValues = identityImport.values
+		}
+
+		if w.region == nil {
+			v.ImportState(ctx, request, response)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			response.Diagnostics.Append(setIdentityValues(ctx, &response.State, identityValues)...)
+
+			return
+		}
+
+		// An import ID of the form `id@region` sets a per-resource Region override.
+		if identityValues == nil {
+			var id string
+			id, overrideRegion = conns.SplitImportIDRegion(request.ID)
+
+			if overrideRegion != "" && w.meta != nil {
+				if err := w.meta.ValidateOverrideRegion(ctx, overrideRegion); err != nil {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+
+					return
+				}
+			}
+
+			request.ID = id
+		}
+
+		region := tftypes.NewValue(tftypes.String, nil)
+		if overrideRegion != "" {
+			region = tftypes.NewValue(tftypes.String, overrideRegion)
+			ctx = conns.WithOverrideRegion(ctx, overrideRegion)
+		}
+
+		innerResponse := *response
+		innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		v.ImportState(ctx, request, &innerResponse)
+
+		if !innerResponse.Diagnostics.HasError() {
+			innerResponse.Diagnostics.Append(setIdentityValues(ctx, &innerResponse.State, identityValues)...)
+		}
+
+		outer := response.State
+		*response = innerResponse
+		response.State = w.region.outerState(ctx, innerResponse.State, outer, region, &response.Diagnostics)
 
 		return
 	}
@@ -229,10 +469,100 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// setIdentityValues sets the specified resource-specific identifying attribute values in state.
+func setIdentityValues(ctx context.Context, state *tfsdk.State, values map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, value := range values {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diags
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.region != nil {
+		w.modifyPlanRegion(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.region == nil {
+			v.ModifyPlan(ctx, request, response)
+
+			return
+		}
+
+		region := regionValue(response.Plan.Raw)
+		innerRequest := request
+		innerRequest.Config = w.region.config(ctx, request.Config, &response.Diagnostics)
+		innerRequest.Plan = w.region.plan(ctx, response.Plan, &response.Diagnostics)
+		innerRequest.State = w.region.state(ctx, request.State, &response.Diagnostics)
+		innerResponse := *response
+		innerResponse.Plan = w.region.plan(ctx, response.Plan, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		innerResponse.Diagnostics = response.Diagnostics
+
+		v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+		outer := response.Plan
+		*response = innerResponse
+		response.Plan = w.region.outerPlan(ctx, innerResponse.Plan, outer, region, &response.Diagnostics)
+	}
+}
+
+// modifyPlanRegion plans the per-resource Region.
+// If no per-resource Region override is configured, the Region is the provider's configured Region on create and otherwise follows state,
+// so that a change to the provider's Region doesn't replace existing resources. Only a change to a configured Region forces resource replacement.
+func (w *wrappedResource) modifyPlanRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Create.
+	if request.State.Raw.IsNull() {
+		if configRegion.IsNull() && w.meta != nil {
+			if v := w.meta.DefaultRegion(ctx); v != "" {
+				response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), v)...)
+			}
+		}
+
+		return
+	}
+
+	var stateRegion types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created before per-resource Region override was supported have no Region in state.
+	// The next Read sets it.
+	if stateRegion.ValueString() == "" {
+		return
+	}
+
+	if configRegion.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), stateRegion)...)
+
+		return
+	}
+
+	if !configRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
 	}
 }
 
@@ -248,6 +578,14 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.region != nil {
+			request.Config = w.region.config(ctx, request.Config, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -256,7 +594,31 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.UpgradeState(ctx)
+		stateUpgraders := v.UpgradeState(ctx)
+
+		if w.region != nil {
+			for k, v := range stateUpgraders {
+				if f := v.StateUpgrader; f != nil {
+					v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+						innerResponse := *response
+						innerResponse.State = w.region.state(ctx, response.State, &response.Diagnostics)
+						if response.Diagnostics.HasError() {
+							return
+						}
+						innerResponse.Diagnostics = response.Diagnostics
+
+						f(ctx, request, &innerResponse)
+
+						outer := response.State
+						*response = innerResponse
+						response.State = w.region.outerState(ctx, innerResponse.State, outer, tftypes.NewValue(tftypes.String, nil), &response.Diagnostics)
+					}
+					stateUpgraders[k] = v
+				}
+			}
+		}
+
+		return stateUpgraders
 	}
 
 	return nil
@@ -266,7 +628,31 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.MoveState(ctx)
+		stateMovers := v.MoveState(ctx)
+
+		if w.region != nil {
+			for i, v := range stateMovers {
+				if f := v.StateMover; f != nil {
+					v.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+						innerResponse := *response
+						innerResponse.TargetState = w.region.state(ctx, response.TargetState, &response.Diagnostics)
+						if response.Diagnostics.HasError() {
+							return
+						}
+						innerResponse.Diagnostics = response.Diagnostics
+
+						f(ctx, request, &innerResponse)
+
+						outer := response.TargetState
+						*response = innerResponse
+						response.TargetState = w.region.outerState(ctx, innerResponse.TargetState, outer, tftypes.NewValue(tftypes.String, nil), &response.Diagnostics)
+					}
+					stateMovers[i] = v
+				}
+			}
+		}
+
+		return stateMovers
 	}
 
 	return nil
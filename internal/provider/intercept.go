@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -495,3 +496,171 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		switch why {
+		case Read:
+			// Set the per-resource Region override, if any, before any AWS API calls are made.
+			region := d.Get(names.AttrRegion).(string)
+			if err := c.ValidateOverrideRegion(ctx, region); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			ctx = conns.WithOverrideRegion(ctx, region)
+		}
+	case After:
+		switch why {
+		case Read:
+			// Set the Region in effect in state.
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		switch why {
+		case Create, Read, Update, Delete:
+			// Set the per-resource Region override, if any, before any AWS API calls are made.
+			// On Create the attribute is only known if it has been configured.
+			region := d.Get(names.AttrRegion).(string)
+			if err := c.ValidateOverrideRegion(ctx, region); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			ctx = conns.WithOverrideRegion(ctx, region)
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Set the Region in effect in state.
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionDataSourceSchema returns the schema of the injected per-resource Region override attribute for data sources.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema of the injected per-resource Region override attribute for resources.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the per-resource Region override attribute to the resource's schema.
+// It returns false if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
+
+// setRegionInPlan is a CustomizeDiff function that plans the per-resource Region.
+// If no per-resource Region override is configured, the Region is the provider's configured Region on create and otherwise follows state,
+// so that a change to the provider's Region doesn't replace existing resources. Only a change to a configured Region forces resource replacement.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	// Unconfigured provider, e.g. during validation.
+	region := c.DefaultRegion(ctx)
+	if region == "" {
+		return nil
+	}
+
+	if d.Id() != "" {
+		// Resources created before per-resource Region override was supported have no Region in state.
+		// The next Read sets it, so configuring the Region the resource is in doesn't replace it.
+		if o, n := d.GetChange(names.AttrRegion); o.(string) == "" && n.(string) == region {
+			return d.Clear(names.AttrRegion)
+		}
+
+		return nil
+	}
+
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, region)
+}
+
+// importRegion wraps a resource importer, handling a per-resource Region override suffix on the import ID.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region := conns.SplitImportIDRegion(d.Id()); region != "" {
+			if err := meta.(*conns.AWSClient).ValidateOverrideRegion(ctx, region); err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			ctx = conns.WithOverrideRegion(ctx, region)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
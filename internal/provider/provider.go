@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -53,6 +55,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
 			"assume_role":                   assumeRoleSchema(),
+			"assume_role_with_saml":         assumeRoleWithSAMLSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
@@ -103,6 +106,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. " +
 					"Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
 			},
+			"http_recording": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to record or replay AWS API interactions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Whether AWS API interactions are recorded or replayed. Valid values are `record` and `replay`.",
+							ValidateFunc: validation.StringInSlice(vcr.Modes(), false),
+						},
+						names.AttrPath: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path of the directory containing recorded AWS API interactions.",
+						},
+					},
+				},
+			},
 			"https_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -158,12 +182,86 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with client-side request rate limits for AWS services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Maximum number of requests that can be made in a burst. Defaults to `requests_per_second` rounded up.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"operation": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with client-side request rate limits for individual API operations.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"burst": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Description:  "Maximum number of requests that can be made in a burst. Defaults to `requests_per_second` rounded up.",
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrName: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the API operation, e.g. `DescribeInstances`.",
+									},
+									"requests_per_second": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										Description:  "Maximum average number of requests per second.",
+										ValidateFunc: validation.FloatAtLeast(0.001),
+									},
+								},
+							},
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  "Maximum average number of requests per second to the service's API.",
+							ValidateFunc: validation.FloatAtLeast(0.001),
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Service, e.g. `ec2`. Valid values are the keys of the `endpoints` configuration block.",
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"resource_adoption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to adopt existing resources instead of failing to create them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: "Whether existing resources are adopted on create. " +
+								"Can be overridden with a supported resource's `" + adoptExistingAttribute + "` argument.",
+						},
+						"tag_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Key of the resource tag used to identify existing resources for adoption.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -303,6 +401,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the per-resource Region override attribute unless the data source is global or already has a `region` attribute.
+			if v.Region.IsRegionOverrideEnabled() && injectRegionAttribute(r, regionDataSourceSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionDataSourceInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -379,6 +486,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the per-resource Region override attribute unless the resource is global or already has a `region` attribute.
+			if v.Region.IsRegionOverrideEnabled() && injectRegionAttribute(r, regionResourceSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionResourceInterceptor{},
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionInPlan, v)
+				} else {
+					r.CustomizeDiff = setRegionInPlan
+				}
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = importRegion(v)
+					}
+				}
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -414,6 +541,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Inject the adoption override attribute if the resource can adopt existing resources.
+			// Adoption runs after the tagging interceptor has calculated the resource's tags.
+			if v.Tags != nil && v.Adoption != nil && injectAdoptExistingAttribute(r) {
+				interceptors = append(interceptors, interceptorItem{
+					when: Before,
+					why:  Create,
+					interceptor: adoptionResourceInterceptor{
+						adoption: v.Adoption,
+					},
+				})
+
+				if v := r.CreateWithoutTimeout; v != nil {
+					r.CreateWithoutTimeout = adoptOrCreate(v, r.ReadWithoutTimeout)
+				}
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -551,6 +694,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("assume_role_with_saml"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.AssumeRoleWithSAML = expandAssumeRoleWithSAML(ctx, v.([]any)[0].(map[string]any))
+		tflog.Info(ctx, "assume_role_with_saml configuration set", map[string]any{
+			"tf_aws.assume_role_with_saml.role_arn":      config.AssumeRoleWithSAML.RoleARN,
+			"tf_aws.assume_role_with_saml.principal_arn": config.AssumeRoleWithSAML.PrincipalARN,
+		})
+	}
+
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRoleWithWebIdentity = expandAssumeRoleWithWebIdentity(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role_with_web_identity configuration set", map[string]any{
@@ -582,6 +733,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 			config.HTTPProxy = aws.String(s)
 		}
 	}
+	if v, ok := d.GetOk("http_recording"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		config.HTTPRecordingMode = tfMap[names.AttrMode].(string)
+		config.HTTPRecordingPath = tfMap[names.AttrPath].(string)
+	}
+
 	if v, ok := d.GetOkExists("https_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPSProxy = aws.String(s)
@@ -602,6 +759,19 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]any)) > 0 {
+		rateLimits, dx := expandRateLimits(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("resource_adoption"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.ResourceAdoptionConfig = expandResourceAdoption(ctx, v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -640,6 +810,11 @@ func assumeRoleSchema() *schema.Schema {
 		Optional: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"credential_process": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Command whose output supplies the credentials used to assume the role. Only valid for the first role.",
+				},
 				"duration": {
 					Type:         schema.TypeString,
 					Optional:     true,
@@ -676,6 +851,12 @@ func assumeRoleSchema() *schema.Schema {
 					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
 					ValidateFunc: verify.ValidARN,
 				},
+				"serial_number": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Serial number or Amazon Resource Name (ARN) of the MFA device required to assume the role.",
+					ValidateFunc: validation.StringLenBetween(9, 256),
+				},
 				"session_name": {
 					Type:         schema.TypeString,
 					Optional:     true,
@@ -694,6 +875,17 @@ func assumeRoleSchema() *schema.Schema {
 					Description: "Assume role session tags.",
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
+				"token_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "MFA token code.",
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]{6}$`), "must be 6 digits"),
+				},
+				"token_command": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Command whose output is the MFA token code.",
+				},
 				"transitive_tag_keys": {
 					Type:        schema.TypeSet,
 					Optional:    true,
@@ -705,6 +897,64 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithSAMLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"principal_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM SAML identity provider that issued the assertion.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"saml_assertion": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Base64 encoded SAML authentication response provided by the identity provider.",
+					ValidateFunc: validation.StringLenBetween(4, 100000),
+					ExactlyOneOf: []string{"assume_role_with_saml.0.saml_assertion", "assume_role_with_saml.0.saml_assertion_file"},
+				},
+				"saml_assertion_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "File containing a base64 encoded SAML authentication response provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_saml.0.saml_assertion", "assume_role_with_saml.0.saml_assertion_file"},
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -761,8 +1011,8 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
-func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
-	result = make([]awsbase.AssumeRole, len(tfList))
+func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []conns.AssumeRole, diags diag.Diagnostics) {
+	result = make([]conns.AssumeRole, len(tfList))
 
 	for i, v := range tfList {
 		path := path.IndexInt(i)
@@ -779,6 +1029,7 @@ func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result
 				"tf_aws.assume_role.session_name":    result[i].SessionName,
 				"tf_aws.assume_role.external_id":     result[i].ExternalID,
 				"tf_aws.assume_role.source_identity": result[i].SourceIdentity,
+				"tf_aws.assume_role.serial_number":   result[i].SerialNumber,
 			})
 		} else {
 			return result, append(diags, errs.NewAttributeRequiredError(path, "role_arn"))
@@ -788,7 +1039,7 @@ func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result
 	return result, diags
 }
 
-func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (result awsbase.AssumeRole, diags diag.Diagnostics) {
+func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (result conns.AssumeRole, diags diag.Diagnostics) {
 	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
 		result.RoleARN = v
 	} else {
@@ -828,9 +1079,85 @@ func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (r
 		result.TransitiveTagKeys = flex.ExpandStringValueSet(v)
 	}
 
+	if v, ok := tfMap["credential_process"].(string); ok && v != "" {
+		result.CredentialProcess = v
+	}
+
+	if v, ok := tfMap["serial_number"].(string); ok && v != "" {
+		result.SerialNumber = v
+	}
+
+	if v, ok := tfMap["token_code"].(string); ok && v != "" {
+		result.TokenCode = v
+	}
+
+	if v, ok := tfMap["token_command"].(string); ok && v != "" {
+		result.TokenCommand = v
+	}
+
+	switch {
+	case result.TokenCode != "" && result.TokenCommand != "":
+		return result, append(diags, errs.NewAttributeErrorDiagnostic(
+			path.GetAttr("token_code"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q cannot be specified when %q is specified.", errs.PathString(path.GetAttr("token_code")), errs.PathString(path.GetAttr("token_command"))),
+		))
+	case result.SerialNumber != "" && result.TokenCode == "" && result.TokenCommand == "":
+		return result, append(diags, errs.NewAttributeErrorDiagnostic(
+			path.GetAttr("serial_number"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("One of %q or %q must be specified when %q is specified.", errs.PathString(path.GetAttr("token_code")), errs.PathString(path.GetAttr("token_command")), errs.PathString(path.GetAttr("serial_number"))),
+		))
+	case result.SerialNumber == "" && (result.TokenCode != "" || result.TokenCommand != ""):
+		return result, append(diags, errs.NewAttributeErrorDiagnostic(
+			path.GetAttr("serial_number"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q must be specified when an MFA token is specified.", errs.PathString(path.GetAttr("serial_number"))),
+		))
+	}
+
 	return result, diags
 }
 
+func expandAssumeRoleWithSAML(_ context.Context, tfMap map[string]any) *conns.AssumeRoleWithSAML {
+	if tfMap == nil {
+		return nil
+	}
+
+	assumeRole := conns.AssumeRoleWithSAML{}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		assumeRole.Duration = duration
+	}
+
+	if v, ok := tfMap["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		assumeRole.PolicyARNs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["principal_arn"].(string); ok && v != "" {
+		assumeRole.PrincipalARN = v
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["saml_assertion"].(string); ok && v != "" {
+		assumeRole.SAMLAssertion = v
+	}
+
+	if v, ok := tfMap["saml_assertion_file"].(string); ok && v != "" {
+		assumeRole.SAMLAssertionFile = v
+	}
+
+	return &assumeRole
+}
+
 func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRoleWithWebIdentity {
 	if tfMap == nil {
 		return nil
@@ -942,6 +1269,73 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRateLimits(_ context.Context, tfList []any) (map[string]conns.ServiceRateLimits, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObject := make(map[string]conns.ServiceRateLimits)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := apiObject[service]; ok {
+			diags = sdkdiag.AppendErrorf(diags, "rate_limits: duplicate service: %s", service)
+			continue
+		}
+
+		if tfMap["burst"].(int) > 0 && tfMap["requests_per_second"].(float64) == 0 {
+			diags = sdkdiag.AppendErrorf(diags, "rate_limits: %s burst requires requests_per_second", service)
+			continue
+		}
+
+		limits := conns.ServiceRateLimits{
+			RateLimit: conns.RateLimit{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			},
+			Operations: make(map[string]conns.RateLimit),
+		}
+
+		for _, tfMapRaw := range tfMap["operation"].([]any) {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name := tfMap[names.AttrName].(string)
+			if _, ok := limits.Operations[name]; ok {
+				diags = sdkdiag.AppendErrorf(diags, "rate_limits: duplicate %s operation: %s", service, name)
+				continue
+			}
+
+			limits.Operations[name] = conns.RateLimit{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+		}
+
+		apiObject[service] = limits
+	}
+
+	return apiObject, diags
+}
+
+func expandResourceAdoption(_ context.Context, tfMap map[string]any) *conns.ResourceAdoptionConfig {
+	apiObject := &conns.ResourceAdoptionConfig{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["tag_key"].(string); ok {
+		apiObject.TagKey = v
+	}
+
+	return apiObject
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"maps"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
@@ -370,8 +372,10 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 	testCases := map[string]struct {
 		Config                   map[string]any
 		ExpectedCredentialsValue aws.Credentials
-		ExpectedDiags            diag.Diagnostics
-		MockStsEndpoints         []*servicemocks.MockEndpoint
+		// Whether the credentials used to make API calls are refreshed as they expire. Not checked if nil.
+		ExpectedCredentialsRefreshable *bool
+		ExpectedDiags                  diag.Diagnostics
+		MockStsEndpoints               []*servicemocks.MockEndpoint
 	}{
 		"config single": {
 			Config: map[string]any{
@@ -566,13 +570,140 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 				servicemocks.MockStsAssumeRoleValidEndpoint,
 			},
 		},
+
+		"config MFA token code": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":      servicemocks.MockStsAssumeRoleArn,
+						"session_name":  servicemocks.MockStsAssumeRoleSessionName,
+						"serial_number": testMFASerialNumber,
+						"token_code":    "123456",
+					},
+				},
+			},
+			ExpectedCredentialsValue:       mockdata.MockStsAssumeRoleCredentials,
+			ExpectedCredentialsRefreshable: aws.Bool(false),
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"SerialNumber": testMFASerialNumber,
+					"TokenCode":    "123456",
+				}),
+			},
+		},
+
+		"config MFA token command": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":      servicemocks.MockStsAssumeRoleArn,
+						"session_name":  servicemocks.MockStsAssumeRoleSessionName,
+						"serial_number": testMFASerialNumber,
+						"token_command": "echo 654321",
+					},
+				},
+			},
+			ExpectedCredentialsValue:       mockdata.MockStsAssumeRoleCredentials,
+			ExpectedCredentialsRefreshable: aws.Bool(true),
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"SerialNumber": testMFASerialNumber,
+					"TokenCode":    "654321",
+				}),
+			},
+		},
+
+		"config multiple MFA last": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+					map[string]any{
+						"role_arn":      servicemocks.MockStsAssumeRoleArn2,
+						"session_name":  servicemocks.MockStsAssumeRoleSessionName2,
+						"serial_number": testMFASerialNumber,
+						"token_code":    "123456",
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         servicemocks.MockStsAssumeRoleArn2,
+					"RoleSessionName": servicemocks.MockStsAssumeRoleSessionName2,
+					"SerialNumber":    testMFASerialNumber,
+					"TokenCode":       "123456",
+				}),
+			},
+		},
+
+		"config MFA no token": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":      servicemocks.MockStsAssumeRoleArn,
+						"serial_number": testMFASerialNumber,
+					},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("assume_role").IndexInt(0).GetAttr("serial_number"),
+					"Invalid Attribute Combination",
+					`One of "assume_role[0].token_code" or "assume_role[0].token_command" must be specified when "assume_role[0].serial_number" is specified.`,
+				),
+			},
+		},
+
+		"config credential process": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":           servicemocks.MockStsAssumeRoleArn,
+						"session_name":       servicemocks.MockStsAssumeRoleSessionName,
+						"credential_process": `echo '{"Version": 1, "AccessKeyId": "ProcessAccessKey", "SecretAccessKey": "ProcessSecretKey"}'`,
+					},
+				},
+			},
+			ExpectedCredentialsValue:       mockdata.MockStsAssumeRoleCredentials,
+			ExpectedCredentialsRefreshable: aws.Bool(true),
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+		},
+
+		"config credential process not first": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+					map[string]any{
+						"role_arn":           servicemocks.MockStsAssumeRoleArn2,
+						"session_name":       servicemocks.MockStsAssumeRoleSessionName2,
+						"credential_process": "custom-process",
+					},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				errs.NewErrorDiagnostic("assume_role 2 of 2: credential_process can only be configured for the first IAM Role", ""),
+			},
+		},
 	}
 
+	// Commands for credential_process and token_command are run using the shell.
+	path := os.Getenv("PATH")
+
 	for name, tc := range testCases { //nolint:paralleltest
 		t.Run(name, func(t *testing.T) {
 			ctx := context.TODO()
 
 			servicemocks.InitSessionTestEnv(t)
+			t.Setenv("PATH", path)
 
 			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", tc.MockStsEndpoints)
 			defer closeSts()
@@ -612,6 +743,167 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 			if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
 				t.Errorf("unexpected diagnostics difference: %s", diff)
 			}
+
+			if diags.HasError() || tc.ExpectedCredentialsRefreshable == nil {
+				return
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+			_, refreshable := meta.AwsConfig(ctx).Credentials.(*aws.CredentialsCache)
+
+			if got, want := refreshable, aws.ToBool(tc.ExpectedCredentialsRefreshable); got != want {
+				t.Errorf("credentials refreshable = %t, want %t", got, want)
+			}
 		})
 	}
 }
+
+func TestProviderConfig_AssumeRoleWithSAML(t *testing.T) { //nolint:paralleltest
+	const (
+		principalARN  = "arn:aws:iam::555555555555:saml-provider/SAMLProvider"
+		roleARN       = "arn:aws:iam::555555555555:role/SAMLRole"
+		samlAssertion = "U0FNTEFzc2VydGlvbg=="
+	)
+
+	testCases := map[string]struct {
+		Config           map[string]any
+		ExpectedDiags    diag.Diagnostics
+		MockStsEndpoints []*servicemocks.MockEndpoint
+	}{
+		"config": {
+			Config: map[string]any{
+				"assume_role_with_saml": []any{
+					map[string]any{
+						"principal_arn":  principalARN,
+						"role_arn":       roleARN,
+						"saml_assertion": samlAssertion,
+					},
+				},
+			},
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				mockStsAssumeRoleWithSAMLValidEndpoint(principalARN, roleARN, samlAssertion),
+			},
+		},
+
+		"config chained": {
+			Config: map[string]any{
+				"assume_role_with_saml": []any{
+					map[string]any{
+						"principal_arn":  principalARN,
+						"role_arn":       roleARN,
+						"saml_assertion": samlAssertion,
+					},
+				},
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+			},
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				mockStsAssumeRoleWithSAMLValidEndpoint(principalARN, roleARN, samlAssertion),
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+		},
+
+		"config web identity": {
+			Config: map[string]any{
+				"assume_role_with_saml": []any{
+					map[string]any{
+						"principal_arn":  principalARN,
+						"role_arn":       roleARN,
+						"saml_assertion": samlAssertion,
+					},
+				},
+				"assume_role_with_web_identity": []any{
+					map[string]any{
+						"role_arn":           servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+						"web_identity_token": servicemocks.MockWebIdentityToken,
+					},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				errs.NewErrorDiagnostic("only one of assume_role_with_saml and assume_role_with_web_identity can be configured", ""),
+			},
+		},
+	}
+
+	for name, tc := range testCases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", tc.MockStsEndpoints)
+			defer closeSts()
+
+			config := map[string]any{
+				"region":                      "us-west-2", //lintignore:AWSAT003
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"endpoints": []any{
+					map[string]any{
+						"sts": stsEndpoint,
+					},
+				},
+			}
+
+			maps.Copy(config, tc.Config)
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var diags diag.Diagnostics
+			diags = append(diags, p.Validate(rc)...)
+			if diags.HasError() {
+				t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			diags = append(diags, p.Configure(ctx, rc)...)
+
+			if diff := cmp.Diff(diags, tc.ExpectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+const testMFASerialNumber = "arn:aws:iam::555555555555:mfa/MFADevice"
+
+func mockStsAssumeRoleWithSAMLValidEndpoint(principalARN, roleARN, samlAssertion string) *servicemocks.MockEndpoint {
+	return &servicemocks.MockEndpoint{
+		Request: &servicemocks.MockRequest{
+			Body: url.Values{
+				"Action":        []string{"AssumeRoleWithSAML"},
+				"PrincipalArn":  []string{principalARN},
+				"RoleArn":       []string{roleARN},
+				"SAMLAssertion": []string{samlAssertion},
+				"Version":       []string{"2011-06-15"},
+			}.Encode(),
+			Method: http.MethodPost,
+			Uri:    "/",
+		},
+		Response: &servicemocks.MockResponse{
+			Body: `<AssumeRoleWithSAMLResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<AssumeRoleWithSAMLResult>
+  <Credentials>
+    <AccessKeyId>AssumeRoleWithSAMLAccessKey</AccessKeyId>
+    <SecretAccessKey>AssumeRoleWithSAMLSecretKey</SecretAccessKey>
+    <SessionToken>AssumeRoleWithSAMLSessionToken</SessionToken>
+    <Expiration>2099-12-31T23:59:59Z</Expiration>
+  </Credentials>
+</AssumeRoleWithSAMLResult>
+<ResponseMetadata>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ResponseMetadata>
+</AssumeRoleWithSAMLResponse>`,
+			ContentType: "text/xml",
+			StatusCode:  http.StatusOK,
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -422,6 +423,108 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []interface{}
+		expected      map[string]conns.ServiceRateLimits
+		expectedError bool
+	}{
+		"service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"burst":               40,
+					"operation":           []interface{}{},
+					"requests_per_second": 20.0,
+					"service":             "ec2",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				"ec2": {
+					RateLimit:  conns.RateLimit{Burst: 40, RequestsPerSecond: 20},
+					Operations: map[string]conns.RateLimit{},
+				},
+			},
+		},
+		"operation": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"burst": 0,
+					"operation": []interface{}{
+						map[string]interface{}{
+							"burst":               2,
+							names.AttrName:        "DescribeInstances",
+							"requests_per_second": 1.0,
+						},
+					},
+					"requests_per_second": 0.0,
+					"service":             "ec2",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				"ec2": {
+					Operations: map[string]conns.RateLimit{
+						"DescribeInstances": {Burst: 2, RequestsPerSecond: 1},
+					},
+				},
+			},
+		},
+		"burst without requests_per_second": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"burst":               40,
+					"operation":           []interface{}{},
+					"requests_per_second": 0.0,
+					"service":             "ec2",
+				},
+			},
+			expected:      map[string]conns.ServiceRateLimits{},
+			expectedError: true,
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"burst":               0,
+					"operation":           []interface{}{},
+					"requests_per_second": 20.0,
+					"service":             "ec2",
+				},
+				map[string]interface{}{
+					"burst":               0,
+					"operation":           []interface{}{},
+					"requests_per_second": 10.0,
+					"service":             "ec2",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				"ec2": {
+					RateLimit:  conns.RateLimit{RequestsPerSecond: 20},
+					Operations: map[string]conns.RateLimit{},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandRateLimits(ctx, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Errorf("expected error %t, got diagnostics %v", want, diags)
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected rate_limits diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
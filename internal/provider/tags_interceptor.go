@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -170,3 +173,151 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// adoptExistingAttribute is the name of the injected attribute that overrides the provider's resource adoption setting.
+const adoptExistingAttribute = "adopt_existing"
+
+// adoptedResource records the adoption of an existing resource on create.
+type adoptedResource struct {
+	id     string
+	tagKey string
+}
+
+type adoptedResourceKey struct{}
+
+// adoptionResourceInterceptor implements adoption of existing resources on create.
+// If an existing resource matching the planned resource is found its ID is set and the
+// resource's Create handler is replaced by its Read handler (see adoptOrCreate).
+type adoptionResourceInterceptor struct {
+	adoption *types.ServicePackageResourceAdoption
+}
+
+func (r adoptionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.adoption == nil || r.adoption.Finder == nil || when != Before || why != Create {
+		return ctx, diags
+	}
+
+	rd, ok := d.(*schema.ResourceData)
+	if !ok {
+		return ctx, diags
+	}
+
+	c := meta.(*conns.AWSClient)
+	config := c.ResourceAdoptionConfig(ctx)
+
+	// The resource's configuration overrides the provider's configuration.
+	enabled := config != nil && config.Enabled
+	if v := d.GetRawConfig(); !v.IsNull() && v.IsKnown() {
+		if v := v.GetAttr(adoptExistingAttribute); !v.IsNull() && v.IsKnown() {
+			enabled = v.True()
+		}
+	}
+
+	if !enabled {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	// Look up by adoption tag if the resource is to be tagged with one.
+	var tagKey, tagValue string
+	if config != nil && config.TagKey != "" {
+		if tagsInContext, ok := tftags.FromContext(ctx); ok {
+			if v, ok := tagsInContext.TagsIn.UnwrapOrDefault().Map()[config.TagKey]; ok {
+				tagKey, tagValue = config.TagKey, v
+			}
+		}
+	}
+
+	id, err := r.adoption.Finder(ctx, rd, meta, tagKey, tagValue)
+
+	if errors.Is(err, tfresource.ErrTooManyResults) {
+		return ctx, sdkdiag.AppendErrorf(diags, "adopting existing %s %s: multiple matching resources found", serviceName, resourceName)
+	}
+
+	if tfresource.NotFound(err) {
+		// Nothing to adopt.
+		return ctx, diags
+	}
+
+	if err != nil {
+		return ctx, sdkdiag.AppendErrorf(diags, "finding existing %s %s for adoption: %s", serviceName, resourceName, err)
+	}
+
+	if id == "" {
+		return ctx, diags
+	}
+
+	rd.SetId(id)
+	ctx = context.WithValue(ctx, adoptedResourceKey{}, adoptedResource{
+		id:     id,
+		tagKey: tagKey,
+	})
+
+	return ctx, diags
+}
+
+// adoptOrCreate returns a Create handler that reads a resource adopted by adoptionResourceInterceptor
+// instead of creating a new resource.
+func adoptOrCreate(create schema.CreateContextFunc, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		v, ok := ctx.Value(adoptedResourceKey{}).(adoptedResource)
+		if !ok || read == nil {
+			return create(ctx, d, meta)
+		}
+
+		var diags diag.Diagnostics
+
+		inContext, _ := conns.FromContext(ctx)
+		detail := fmt.Sprintf("The existing resource (%s) was adopted into Terraform state instead of creating a new %s.", v.id, inContext.ResourceName)
+		if v.tagKey != "" {
+			detail += fmt.Sprintf(" The resource was identified by its %q tag.", v.tagKey)
+		}
+		detail += " Differences between the existing resource and its configuration are reconciled by the next apply."
+		diags = append(diags, errs.NewWarningDiagnostic("Existing Resource Adopted", detail))
+
+		return append(diags, read(ctx, d, meta)...)
+	}
+}
+
+// injectAdoptExistingAttribute adds the adoption override attribute to the resource's schema.
+// It returns false if the schema already defines an `adopt_existing` attribute.
+func injectAdoptExistingAttribute(r *schema.Resource) bool {
+	if _, ok := r.SchemaMap()[adoptExistingAttribute]; ok {
+		return false
+	}
+
+	s := &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether an existing resource is adopted instead of creating a new resource. Defaults to the provider's resource adoption setting.",
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[adoptExistingAttribute] = s
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[adoptExistingAttribute] = s
+	}
+
+	return true
+}
@@ -10,8 +10,10 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 )
@@ -150,3 +152,162 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func TestAdoptionResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config           *conns.ResourceAdoptionConfig
+		tags             map[string]string
+		findErr          error
+		expectFind       bool
+		expectTagKey     string
+		expectTagValue   string
+		expectAdopted    bool
+		expectDiagsError bool
+	}{
+		"not configured": {},
+		"disabled": {
+			config: &conns.ResourceAdoptionConfig{TagKey: "adopt"},
+			tags:   map[string]string{"adopt": "yes"},
+		},
+		"by name": {
+			config:        &conns.ResourceAdoptionConfig{Enabled: true, TagKey: "adopt"},
+			tags:          map[string]string{"other": "yes"},
+			expectFind:    true,
+			expectAdopted: true,
+		},
+		"by tag": {
+			config:         &conns.ResourceAdoptionConfig{Enabled: true, TagKey: "adopt"},
+			tags:           map[string]string{"adopt": "yes"},
+			expectFind:     true,
+			expectTagKey:   "adopt",
+			expectTagValue: "yes",
+			expectAdopted:  true,
+		},
+		"not found": {
+			config:     &conns.ResourceAdoptionConfig{Enabled: true},
+			findErr:    tfresource.NewEmptyResultError(nil),
+			expectFind: true,
+		},
+		"too many results": {
+			config:           &conns.ResourceAdoptionConfig{Enabled: true},
+			findErr:          tfresource.NewTooManyResultsError(2, nil),
+			expectFind:       true,
+			expectDiagsError: true,
+		},
+		"find error": {
+			config:           &conns.ResourceAdoptionConfig{Enabled: true},
+			findErr:          errors.New("test error"),
+			expectFind:       true,
+			expectDiagsError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn := &conns.AWSClient{}
+			conns.SetResourceAdoptionConfig(conn, testCase.config)
+
+			ctx = conns.NewResourceContext(ctx, "Test", "Thing")
+			ctx = tftags.NewContext(ctx, nil, nil)
+			if inContext, ok := tftags.FromContext(ctx); ok {
+				inContext.TagsIn = option.Some(tftags.New(ctx, testCase.tags))
+			}
+
+			var found bool
+			interceptor := adoptionResourceInterceptor{
+				adoption: &types.ServicePackageResourceAdoption{
+					Finder: func(_ context.Context, d *schema.ResourceData, _ any, tagKey, tagValue string) (string, error) {
+						found = true
+
+						if got, want := tagKey, testCase.expectTagKey; got != want {
+							t.Errorf("tagKey = %q, want %q", got, want)
+						}
+						if got, want := tagValue, testCase.expectTagValue; got != want {
+							t.Errorf("tagValue = %q, want %q", got, want)
+						}
+
+						if testCase.findErr != nil {
+							return "", testCase.findErr
+						}
+
+						return d.Get("name").(string), nil
+					},
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}, map[string]any{
+				"name": "existing",
+			})
+
+			ctx, diags := interceptor.run(ctx, d, conn, Before, Create, nil)
+
+			if got, want := found, testCase.expectFind; got != want {
+				t.Errorf("finder called = %t, want %t", got, want)
+			}
+			if got, want := diags.HasError(), testCase.expectDiagsError; got != want {
+				t.Errorf("diags.HasError() = %t, want %t", got, want)
+			}
+
+			_, adopted := ctx.Value(adoptedResourceKey{}).(adoptedResource)
+			if got, want := adopted, testCase.expectAdopted; got != want {
+				t.Errorf("adopted = %t, want %t", got, want)
+			}
+
+			wantID := ""
+			if testCase.expectAdopted {
+				wantID = "existing"
+			}
+			if got, want := d.Id(), wantID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAdoptOrCreate(t *testing.T) {
+	t.Parallel()
+
+	var created, read bool
+	f := adoptOrCreate(
+		func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+			created = true
+			return nil
+		},
+		func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+			read = true
+			return nil
+		},
+	)
+
+	ctx := conns.NewResourceContext(context.Background(), "Test", "Thing")
+
+	if diags := f(ctx, nil, nil); len(diags) != 0 {
+		t.Errorf("create: unexpected diags: %v", diags)
+	}
+	if !created || read {
+		t.Errorf("create: created = %t, read = %t", created, read)
+	}
+
+	created = false
+	ctx = context.WithValue(ctx, adoptedResourceKey{}, adoptedResource{id: "existing"})
+
+	diags := f(ctx, nil, nil)
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("adopt: length of diags = %d, want %d", got, want)
+	} else if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("adopt: severity = %v, want %v", got, want)
+	}
+	if created || !read {
+		t.Errorf("adopt: created = %t, read = %t", created, read)
+	}
+}
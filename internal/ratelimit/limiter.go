@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit implements client-side request rate limiting.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter.
+// The bucket holds at most burst tokens and is refilled at a rate of rps tokens per second.
+type Limiter struct {
+	mu     sync.Mutex
+	rps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewLimiter returns a new Limiter that allows requests at a rate of rps per second with bursts of up to burst requests.
+// If burst is less than 1 it defaults to rps rounded up.
+func NewLimiter(rps float64, burst int) *Limiter {
+	b := float64(burst)
+	if b < 1 {
+		b = max(math.Ceil(rps), 1)
+	}
+
+	return &Limiter{
+		rps:    rps,
+		burst:  b,
+		tokens: b,
+		now:    time.Now,
+	}
+}
+
+// Reserve takes a token from the bucket and returns how long the caller must wait before the token is available.
+func (l *Limiter) Reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rps)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rps * float64(time.Second))
+}
+
+// Wait blocks until a token is available or the context is done.
+// The time spent waiting is returned.
+func (l *Limiter) Wait(ctx context.Context) (time.Duration, error) {
+	delay := l.Reserve()
+	if delay == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return 0, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
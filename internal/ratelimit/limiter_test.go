@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	// Burst.
+	for i := range 2 {
+		if got := l.Reserve(); got != 0 {
+			t.Errorf("request %d: delay = %s, want 0", i, got)
+		}
+	}
+
+	// Bucket empty.
+	if got, want := l.Reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+	if got, want := l.Reserve(), time.Second; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+
+	// Refill.
+	now = now.Add(2 * time.Second)
+	for i := range 2 {
+		if got := l.Reserve(); got != 0 {
+			t.Errorf("after refill: request %d: delay = %s, want 0", i, got)
+		}
+	}
+	if got, want := l.Reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("after refill: delay = %s, want %s", got, want)
+	}
+}
+
+func TestLimiterDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rps      float64
+		burst    int
+		expected float64
+	}{
+		"explicit":        {rps: 10, burst: 3, expected: 3},
+		"default":         {rps: 10, expected: 10},
+		"default round":   {rps: 2.5, expected: 3},
+		"default minimum": {rps: 0.1, expected: 1},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := NewLimiter(testCase.rps, testCase.burst).burst, testCase.expected; got != want {
+				t.Errorf("burst = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewLimiter(0.001, 1)
+	l.now = func() time.Time { return now }
+	l.Reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := l.Wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// The canceled reservation is returned to the bucket.
+	if got := l.tokens; got != 0 {
+		t.Errorf("tokens = %v, want 0", got)
+	}
+}
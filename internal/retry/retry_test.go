@@ -37,6 +37,28 @@ func TestRetry(t *testing.T) {
 	}
 }
 
+func TestBackoffMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	r := BeginWithOptions(Options{
+		BackoffMinDuration: time.Second,
+		BackoffMaxDuration: 10 * time.Second,
+		BackoffMultiplier:  2,
+	})
+	for _, want := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		if got := r.backoffDelay(); got != want {
+			t.Errorf("attempt %d: backoff delay = %v, want %v", r.attempt, got, want)
+		}
+		r.attempt++
+	}
+
+	// Overflow is also capped.
+	r.attempt = 1000
+	if got, want := r.backoffDelay(), 10*time.Second; got != want {
+		t.Errorf("attempt %d: backoff delay = %v, want %v", r.attempt, got, want)
+	}
+}
+
 /*
 ** Comment out for now due to flakiness.
 func TestSleepFor(t *testing.T) {
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// WriteOnlyVersionAttributeName returns the name of the version attribute that triggers
+// an update of the specified write-only attribute, e.g. "password_wo_version" for "password_wo".
+func WriteOnlyVersionAttributeName(writeOnlyAttributeName string) string {
+	return writeOnlyAttributeName + "_version"
+}
+
+// WriteOnlyStringSchema returns the schema for an optional, sensitive write-only string attribute.
+// Write-only values are never persisted to plan or state, so the attribute requires
+// its version attribute, the value of which is changed to trigger an update.
+func WriteOnlyStringSchema(writeOnlyAttributeName string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: conflictsWith,
+		RequiredWith:  []string{WriteOnlyVersionAttributeName(writeOnlyAttributeName)},
+	}
+}
+
+// WriteOnlyVersionSchema returns the schema for the version attribute of the specified write-only attribute.
+func WriteOnlyVersionSchema(writeOnlyAttributeName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{writeOnlyAttributeName},
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
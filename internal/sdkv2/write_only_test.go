@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriteOnlyVersionAttributeName(t *testing.T) {
+	t.Parallel()
+
+	if got, want := WriteOnlyVersionAttributeName("password_wo"), "password_wo_version"; got != want {
+		t.Errorf("WriteOnlyVersionAttributeName = %q, want %q", got, want)
+	}
+}
+
+func TestWriteOnlySchemas(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo":         WriteOnlyStringSchema("password_wo", "password"),
+			"password_wo_version": WriteOnlyVersionSchema("password_wo"),
+		},
+		CreateWithoutTimeout: schema.NoopContext,
+		ReadWithoutTimeout:   schema.NoopContext,
+		UpdateWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: schema.NoopContext,
+	}
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("InternalValidate: %s", err)
+	}
+
+	if s := r.Schema["password_wo"]; !s.WriteOnly || !s.Sensitive || s.Computed {
+		t.Errorf("unexpected write-only attribute schema: %#v", s)
+	}
+}
@@ -54,6 +54,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*accessanalyzer.Options){
 		accessanalyzer.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *accessanalyzer.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
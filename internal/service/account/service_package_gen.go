@@ -32,16 +32,28 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlternateContact,
 			TypeName: "aws_account_alternate_contact",
 			Name:     "Alternate Contact",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  resourcePrimaryContact,
 			TypeName: "aws_account_primary_contact",
 			Name:     "Primary Contact",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  resourceRegion,
 			TypeName: "aws_account_region",
 			Name:     "Region",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 	}
 }
@@ -56,6 +68,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*account.Options){
 		account.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *account.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
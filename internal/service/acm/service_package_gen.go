@@ -63,6 +63,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*acm.Options){
 		acm.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *acm.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
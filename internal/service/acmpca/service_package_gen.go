@@ -83,6 +83,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*acmpca.Options){
 		acmpca.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *acmpca.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
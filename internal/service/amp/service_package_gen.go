@@ -86,6 +86,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*amp.Options){
 		amp.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *amp.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
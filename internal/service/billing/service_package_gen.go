@@ -22,6 +22,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newServiceAccountDataSource,
 			TypeName: "aws_billing_service_account",
 			Name:     "Service Account",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 	}
 }
@@ -60,6 +64,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 				}
 			}
 		},
+		func(o *billing.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -26,11 +26,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceEnrollmentStatus,
 			TypeName: "aws_costoptimizationhub_enrollment_status",
 			Name:     "Enrollment Status",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 		{
 			Factory:  newResourcePreferences,
 			TypeName: "aws_costoptimizationhub_preferences",
 			Name:     "Preferences",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal:          true,
+				IsOverrideEnabled: false,
+			},
 		},
 	}
 }
@@ -65,6 +73,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 				}
 			}
 		},
+		func(o *costoptimizationhub.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -17,6 +17,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfdocdb "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
@@ -102,6 +103,43 @@ func TestAccDocDBCluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_masterPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster1, dbCluster2 awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
+					testAccCheckClusterNotRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBCluster
@@ -1021,6 +1059,28 @@ func testAccCheckClusterRecreated(i, j *awstypes.DBCluster) resource.TestCheckFu
 	}
 }
 
+func testAccCheckClusterNotRecreated(i, j *awstypes.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.ToTime(i.ClusterCreateTime).Equal(aws.ToTime(j.ClusterCreateTime)) {
+			return errors.New("DocumentDB Cluster was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccClusterConfig_masterPasswordWriteOnly(rName, masterPassword string, masterPasswordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier         = %[1]q
+  master_password_wo         = %[2]q
+  master_password_wo_version = %[3]d
+  master_username            = "tfacctest"
+  skip_final_snapshot        = true
+}
+`, rName, masterPassword, masterPasswordVersion)
+}
+
 func testAccClusterConfig_basic(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
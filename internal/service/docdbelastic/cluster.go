@@ -399,70 +399,30 @@ type resourceClusterData struct {
 	VpcSecurityGroupIds        fwtypes.SetValueOf[types.String]  `tfsdk:"vpc_security_group_ids"`
 }
 
-func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(awstypes.StatusCreating),
-		Target:                    enum.Slice(awstypes.StatusActive),
-		Refresh:                   statusCluster(ctx, conn, id),
-		Timeout:                   timeout,
-		NotFoundChecks:            20,
-		ContinuousTargetOccurence: 2,
-	}
+var clusterWaiter = tfresource.NewStateWaiter("DocDB Elastic Cluster", func(v *awstypes.Cluster) string {
+	return string(v.Status)
+})
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*awstypes.Cluster); ok {
-		return out, err
-	}
-
-	return nil, err
+func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	return clusterWaiter.WaitFor(ctx, findClusterFunc(conn, id),
+		enum.Slice(awstypes.StatusCreating), enum.Slice(awstypes.StatusActive), timeout,
+		tfresource.WithNotFoundChecks(20), tfresource.WithContinuousTargetOccurence(2))
 }
 
 func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(awstypes.StatusUpdating),
-		Target:                    enum.Slice(awstypes.StatusActive),
-		Refresh:                   statusCluster(ctx, conn, id),
-		Timeout:                   timeout,
-		NotFoundChecks:            20,
-		ContinuousTargetOccurence: 2,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*awstypes.Cluster); ok {
-		return out, err
-	}
-
-	return nil, err
+	return clusterWaiter.WaitFor(ctx, findClusterFunc(conn, id),
+		enum.Slice(awstypes.StatusUpdating), enum.Slice(awstypes.StatusActive), timeout,
+		tfresource.WithNotFoundChecks(20), tfresource.WithContinuousTargetOccurence(2))
 }
 
 func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
-		Target:  []string{},
-		Refresh: statusCluster(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*awstypes.Cluster); ok {
-		return out, err
-	}
-
-	return nil, err
+	return clusterWaiter.WaitFor(ctx, findClusterFunc(conn, id),
+		enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting), []string{}, timeout)
 }
 
-func statusCluster(ctx context.Context, conn *docdbelastic.Client, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		out, err := findClusterByID(ctx, conn, id)
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return out, string(out.Status), nil
+func findClusterFunc(conn *docdbelastic.Client, id string) func(context.Context) (*awstypes.Cluster, error) {
+	return func(ctx context.Context) (*awstypes.Cluster, error) {
+		return findClusterByID(ctx, conn, id)
 	}
 }
 
@@ -51,6 +51,7 @@ var (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @Adoption(finder="findVPCForAdoption")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc")
 // @Testing(generator=false)
 func resourceVPC() *schema.Resource {
@@ -750,3 +751,33 @@ func findIPAMPoolAllocationsForVPC(ctx context.Context, conn *ec2.Client, poolID
 
 	return output, nil
 }
+
+// findVPCForAdoption returns the ID of the existing VPC with the adoption tag and any configured IPv4 CIDR block.
+// VPCs have no name and so can only be adopted by tag.
+func findVPCForAdoption(ctx context.Context, d *schema.ResourceData, meta any, tagKey, tagValue string) (string, error) {
+	if tagKey == "" {
+		return "", tfresource.NewEmptyResultError(nil)
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.DescribeVpcsInput{
+		Filters: newTagFilterList(Tags(tftags.New(ctx, map[string]string{
+			tagKey: tagValue,
+		}))),
+	}
+
+	if v, ok := d.GetOk(names.AttrCIDRBlock); ok {
+		input.Filters = append(input.Filters, newAttributeFilterList(map[string]string{
+			"cidr-block": v.(string),
+		})...)
+	}
+
+	vpc, err := findVPC(ctx, conn, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(vpc.VpcId), nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*securityGroupRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	securityGroupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	if err := syncSecurityGroupRules(ctx, conn, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	securityGroupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	ingress, egress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, egress)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, ingress)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.EgressRuleIDs.Equal(old.EgressRuleIDs) || !new.IngressRuleIDs.Equal(old.IngressRuleIDs) {
+		conn := r.Meta().EC2Client(ctx)
+
+		securityGroupID := fwflex.StringValueFromFramework(ctx, new.SecurityGroupID)
+		if err := syncSecurityGroupRules(ctx, conn, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, new.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.EgressRuleIDs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncSecurityGroupRules handles keeping the configured security group rules
+// in sync with the security group.
+//
+// Rules present in the security group but not configured on this resource will be revoked.
+// Rules are not created by this resource, so configured rules must already exist in the security group.
+func syncSecurityGroupRules(ctx context.Context, conn *ec2.Client, securityGroupID string, wantIngress, wantEgress []string) error {
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return err
+	}
+
+	missingIngress, revokeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 })
+	missingEgress, revokeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 })
+
+	if ids := append(missingIngress, missingEgress...); len(ids) > 0 {
+		return fmt.Errorf("VPC Security Group (%s) rules %v not found", securityGroupID, ids)
+	}
+
+	if len(revokeIngress) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: revokeIngress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, input); err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) ingress rules: %w", securityGroupID, err)
+		}
+	}
+
+	if len(revokeEgress) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: revokeEgress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, input); err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) egress rules: %w", securityGroupID, err)
+		}
+	}
+
+	return nil
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of the security group's ingress and egress rules.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, securityGroupID string) ([]string, []string, error) {
+	// Ensure that the security group exists, as describing its rules succeeds regardless.
+	if _, err := findSecurityGroupByID(ctx, conn, securityGroupID); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := func(v awstypes.SecurityGroupRule) string {
+		return aws.ToString(v.SecurityGroupRuleId)
+	}
+	ingress := tfslices.ApplyToAll(tfslices.Filter(rules, func(v awstypes.SecurityGroupRule) bool {
+		return !aws.ToBool(v.IsEgress)
+	}), id)
+	egress := tfslices.ApplyToAll(tfslices.Filter(rules, func(v awstypes.SecurityGroupRule) bool {
+		return aws.ToBool(v.IsEgress)
+	}), id)
+
+	return ingress, egress, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, "aws_security_group.test", &v),
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, 1, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress_rule_ids.*", "aws_vpc_security_group_ingress_rule.test", "security_group_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "egress_rule_ids.*", "aws_vpc_security_group_egress_rule.test", "security_group_rule_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, "aws_security_group.test", &v),
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, 1, 1),
+					testAccCheckSecurityGroupRulesExclusiveAddIngressRule(ctx, &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, 1, 1),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveCount(ctx context.Context, n string, wantIngress, wantEgress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.Attributes["security_group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		var ingress, egress int
+		for _, v := range output {
+			if aws.ToBool(v.IsEgress) {
+				egress++
+			} else {
+				ingress++
+			}
+		}
+
+		if ingress != wantIngress || egress != wantEgress {
+			return fmt.Errorf("VPC Security Group (%s) has %d ingress and %d egress rules, want %d and %d", rs.Primary.Attributes["security_group_id"], ingress, egress, wantIngress, wantEgress)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAddIngressRule(ctx context.Context, v *awstypes.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: v.GroupId,
+			IpPermissions: []awstypes.IpPermission{{
+				FromPort:   aws.Int32(443),
+				IpProtocol: aws.String("tcp"),
+				IpRanges: []awstypes.IpRange{{
+					CidrIp: aws.String("10.1.0.0/16"),
+				}},
+				ToPort: aws.Int32(443),
+			}},
+		}
+
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, input)
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "0.0.0.0/0"
+  ip_protocol = "-1"
+}
+
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+  ingress_rule_ids  = [aws_vpc_security_group_ingress_rule.test.security_group_rule_id]
+  egress_rule_ids   = [aws_vpc_security_group_egress_rule.test.security_group_rule_id]
+}
+`)
+}
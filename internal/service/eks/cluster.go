@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -1003,22 +1004,6 @@ func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name,
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findClusterByName(ctx, conn, name)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
-	}
-}
-
 func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findClusterUpdateByTwoPartKey(ctx, conn, name, id)
@@ -1035,42 +1020,35 @@ func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string)
 	}
 }
 
-func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
-		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
+var clusterWaiter = tfresource.NewStateWaiter("EKS Cluster", func(v *types.Cluster) string {
+	return string(v.Status)
+}, tfresource.WithFailureReason(func(v *types.Cluster) error {
+	if v.Health == nil {
+		return nil
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
-	}
+	return clusterIssuesError(v.Health.Issues)
+}))
 
-	return nil, err
+func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
+	return clusterWaiter.WaitFor(ctx, findClusterFunc(conn, name),
+		enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating), enum.Slice(types.ClusterStatusActive), timeout)
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
-		Target:     []string{},
-		Refresh:    statusCluster(ctx, conn, name),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
+	return clusterWaiter.WaitFor(ctx, findClusterFunc(conn, name),
+		enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting), []string{}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second),
 		// An attempt to avoid "ResourceInUseException: Cluster already exists with name: ..." errors
 		// in acceptance tests when recreating a cluster with the same randomly generated name.
-		ContinuousTargetOccurence: 3,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+		tfresource.WithContinuousTargetOccurence(3),
+	)
+}
 
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
+func findClusterFunc(conn *eks.Client, name string) func(context.Context) (*types.Cluster, error) {
+	return func(ctx context.Context) (*types.Cluster, error) {
+		return findClusterByName(ctx, conn, name)
 	}
-
-	return nil, err
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
@@ -1094,6 +1072,24 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	return nil, err
 }
 
+func clusterIssueError(apiObject types.ClusterIssue) error {
+	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
+}
+
+func clusterIssuesError(apiObjects []types.ClusterIssue) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		err := clusterIssueError(apiObject)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", strings.Join(apiObject.ResourceIds, ", "), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
 	if len(tfList) == 0 {
 		return nil
@@ -41,6 +41,7 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="name", resourceType="Role")
+// @Adoption(finder="findRoleForAdoption")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
@@ -638,6 +639,32 @@ func findRoleByName(ctx context.Context, conn *iam.Client, name string) (*awstyp
 	return findRole(ctx, conn, input)
 }
 
+// findRoleForAdoption returns the name of the existing role with the configured name.
+// If an adoption tag is specified the existing role must have the tag.
+func findRoleForAdoption(ctx context.Context, d *schema.ResourceData, meta any, tagKey, tagValue string) (string, error) {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	// Generated names never match an existing role.
+	name := d.Get(names.AttrName).(string)
+	if name == "" {
+		return "", tfresource.NewEmptyResultError(nil)
+	}
+
+	role, err := findRoleByName(ctx, conn, name)
+
+	if err != nil {
+		return "", err
+	}
+
+	if tagKey != "" {
+		if v, ok := KeyValueTags(ctx, role.Tags).Map()[tagKey]; !ok || v != tagValue {
+			return "", tfresource.NewEmptyResultError(nil)
+		}
+	}
+
+	return aws.ToString(role.RoleName), nil
+}
+
 func findRole(ctx context.Context, conn *iam.Client, input *iam.GetRoleInput) (*awstypes.Role, error) {
 	output, err := conn.GetRole(ctx, input)
 
@@ -35,6 +35,7 @@ var (
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	WriteSourcePackage                           = writeSourcePackage
 
 	ValidFunctionName               = validFunctionName
 	ValidPermissionAction           = validPermissionAction
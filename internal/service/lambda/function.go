@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
 	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -174,7 +175,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_package"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +214,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_package"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +328,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_package"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +339,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_package"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -381,6 +382,44 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: glob.ValidatePattern,
+							},
+						},
+						"include": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: glob.ValidatePattern,
+							},
+						},
+						names.AttrS3Bucket: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_dir": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -459,6 +498,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			setSourcePackageCodeHash,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -498,6 +538,18 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if sp := expandSourcePackage(d.Get("source_package").([]interface{})); sp != nil {
+		// Grab an exclusive lock so that we're only building one package in memory at a time.
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := buildSourcePackage(ctx, meta.(*conns.AWSClient), sp)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source_dir (%s): %s", sp.sourceDir, err)
+		}
+
+		input.Code = code
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -964,6 +1016,25 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if sp := expandSourcePackage(d.Get("source_package").([]interface{})); sp != nil {
+			// Grab an exclusive lock so that we're only building one package in memory at a time.
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := buildSourcePackage(ctx, meta.(*conns.AWSClient), sp)
+
+			if err != nil {
+				// As source_code_hash isn't set in resourceFunctionRead(), don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+
+				return sdkdiag.AppendErrorf(diags, "packaging source_dir (%s): %s", sp.sourceDir, err)
+			}
+
+			input.ZipFile = code.ZipFile
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.S3ObjectVersion = code.S3ObjectVersion
 		} else {
 			input.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -975,6 +1046,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		_, err := conn.UpdateFunctionCode(ctx, input)
 
 		if err != nil {
+			if _, ok := d.GetOk("source_package"); ok {
+				// As source_code_hash isn't set in resourceFunctionRead(), don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+			}
+
 			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "Error occurred while GetObject.") {
 				// As s3_bucket, s3_key and s3_object_version aren't set in resourceFunctionRead(), don't ovewrite the last known good values.
 				for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version"} {
@@ -1358,6 +1435,7 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("source_package") ||
 		d.HasChange("architectures")
 }
 
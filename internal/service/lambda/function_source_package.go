@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly, rather than from Amazon S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	functionZipFileMaxSize = 50 * 1024 * 1024
+)
+
+var (
+	// Modification time of every entry in a source package.
+	// The earliest time that can be represented in a ZIP file's MS-DOS date and time fields.
+	sourcePackageModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type sourcePackage struct {
+	excludes    []string
+	includes    []string
+	s3Bucket    string
+	s3KeyPrefix string
+	sourceDir   string
+}
+
+func expandSourcePackage(tfList []interface{}) *sourcePackage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &sourcePackage{
+		excludes:    flex.ExpandStringValueList(tfMap["exclude"].([]interface{})),
+		includes:    flex.ExpandStringValueList(tfMap["include"].([]interface{})),
+		s3Bucket:    tfMap[names.AttrS3Bucket].(string),
+		s3KeyPrefix: tfMap["s3_key_prefix"].(string),
+		sourceDir:   tfMap["source_dir"].(string),
+	}
+}
+
+// writeSourcePackage writes a ZIP archive of the files in the source directory that are selected by the include and exclude patterns.
+// The archive's contents depend only on the selected files' paths, contents and executable bits
+// so that the same source directory always produces the same archive.
+func writeSourcePackage(w io.Writer, sourceDir string, includes, excludes []string) error {
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	zw := zip.NewWriter(w)
+	n := 0
+
+	// Entries are visited in lexical order.
+	err = filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if !glob.Selected(rel, includes, excludes) {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     rel,
+			Method:   zip.Deflate,
+			Modified: sourcePackageModified,
+		}
+		if info.Mode().Perm()&0o111 != 0 {
+			header.SetMode(0o755)
+		} else {
+			header.SetMode(0o644)
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(fw, file); err != nil {
+			return err
+		}
+
+		n++
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("source_dir (%s) contains no selected files", sourceDir)
+	}
+
+	return zw.Close()
+}
+
+// sourcePackageHash returns the base64-encoded SHA256 hash of the source package's ZIP archive.
+func sourcePackageHash(sp *sourcePackage) (string, error) {
+	h := sha256.New()
+
+	if err := writeSourcePackage(h, sp.sourceDir, sp.includes, sp.excludes); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildSourcePackage returns the function code for the source package's ZIP archive.
+// Archives too large to upload directly are uploaded to Amazon S3.
+func buildSourcePackage(ctx context.Context, c *conns.AWSClient, sp *sourcePackage) (*awstypes.FunctionCode, error) {
+	var buf bytes.Buffer
+
+	if err := writeSourcePackage(&buf, sp.sourceDir, sp.includes, sp.excludes); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() <= functionZipFileMaxSize {
+		return &awstypes.FunctionCode{
+			ZipFile: buf.Bytes(),
+		}, nil
+	}
+
+	if sp.s3Bucket == "" {
+		return nil, fmt.Errorf("ZIP archive of source_dir (%s) is %d bytes, which exceeds the %d byte limit for direct upload; set source_package.s3_bucket", sp.sourceDir, buf.Len(), functionZipFileMaxSize)
+	}
+
+	// Name the object after the archive's contents so that an unchanged archive isn't uploaded again under a different key.
+	hash := sha256.Sum256(buf.Bytes())
+	key := sp.s3KeyPrefix + hex.EncodeToString(hash[:]) + ".zip"
+
+	output, err := c.S3Client(ctx).PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(buf.Bytes()),
+		Bucket: aws.String(sp.s3Bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading ZIP archive of source_dir (%s) to S3 Bucket (%s) object (%s): %w", sp.sourceDir, sp.s3Bucket, key, err)
+	}
+
+	return &awstypes.FunctionCode{
+		S3Bucket:        aws.String(sp.s3Bucket),
+		S3Key:           aws.String(key),
+		S3ObjectVersion: output.VersionId,
+	}, nil
+}
+
+// setSourcePackageCodeHash sets source_code_hash to the hash of the source package's ZIP archive
+// so that changes to the source directory's files are planned as code updates.
+func setSourcePackageCodeHash(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sp := expandSourcePackage(d.Get("source_package").([]interface{}))
+
+	if sp == nil {
+		return nil
+	}
+
+	// The package's arguments may be unknown until apply, e.g. when source_dir is the output of another resource.
+	for _, key := range []string{"source_package.0.exclude", "source_package.0.include", "source_package.0.source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	hash, err := sourcePackageHash(sp)
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
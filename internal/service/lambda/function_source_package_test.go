@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestWriteSourcePackage(t *testing.T) {
+	t.Parallel()
+
+	sourceDir := t.TempDir()
+	for name, mode := range map[string]os.FileMode{
+		"bootstrap":          0o700,
+		"index.js":           0o600,
+		"lib/util.js":        0o664,
+		"test/index_test.js": 0o644,
+	} {
+		p := filepath.Join(sourceDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chmod(p, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	excludes := []string{"test/**"}
+
+	var want bytes.Buffer
+	if err := tflambda.WriteSourcePackage(&want, sourceDir, nil, excludes); err != nil {
+		t.Fatal(err)
+	}
+
+	// Modification times don't affect the archive.
+	modified := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(sourceDir, "index.js"), modified, modified); err != nil {
+		t.Fatal(err)
+	}
+
+	var got bytes.Buffer
+	if err := tflambda.WriteSourcePackage(&got, sourceDir, nil, excludes); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatal("archives differ")
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(got.Bytes()), int64(got.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantModes := map[string]os.FileMode{
+		"bootstrap":   0o755,
+		"index.js":    0o644,
+		"lib/util.js": 0o644,
+	}
+	if got, want := len(r.File), len(wantModes); got != want {
+		t.Fatalf("got %d files, want %d", got, want)
+	}
+	for _, f := range r.File {
+		wantMode, ok := wantModes[f.Name]
+		if !ok {
+			t.Errorf("unexpected file %q", f.Name)
+			continue
+		}
+		if got := f.Mode().Perm(); got != wantMode {
+			t.Errorf("file %q mode = %o, want %o", f.Name, got, wantMode)
+		}
+		if got, want := f.Modified.UTC(), time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("file %q modified = %s, want %s", f.Name, got, want)
+		}
+	}
+}
+
+func TestWriteSourcePackage_noFiles(t *testing.T) {
+	t.Parallel()
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "README.md"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tflambda.WriteSourcePackage(&buf, sourceDir, []string{"**/*.js"}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
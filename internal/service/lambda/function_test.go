@@ -176,6 +176,78 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourcePackage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	sourceDir := t.TempDir()
+
+	writeFile := func(name, fixture string) {
+		content, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := filepath.Join(sourceDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("lambda.js", "test-fixtures/lambda_func.js")
+	writeFile("test/lambda_test.js", "test-fixtures/lambda_func_modified.js")
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourcePackage(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "source_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_package.0.source_dir", sourceDir),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("lambda.js", "test-fixtures/lambda_func_modified.js")
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourcePackage(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+			{
+				// Changes to excluded files aren't planned.
+				PreConfig: func() {
+					writeFile("test/lambda_test.js", "test-fixtures/lambda_func.js")
+				},
+				Config:   testAccFunctionConfig_sourcePackage(sourceDir, rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_package"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_codeSigning(t *testing.T) {
 	ctx := acctest.Context(t)
 	if curr := acctest.Region(); !tflambda.SignerServiceIsAvailable(curr) {
@@ -2599,6 +2671,24 @@ resource "aws_lambda_function" "test" {
 `, fileName, rName))
 }
 
+func testAccFunctionConfig_sourcePackage(sourceDir, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda.handler"
+  runtime       = "nodejs20.x"
+
+  source_package {
+    source_dir = %[1]q
+    exclude    = ["test/**"]
+  }
+}
+`, sourceDir, rName))
+}
+
 func testAccFunctionConfig_cscBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_iam_policy_document" "policy" {
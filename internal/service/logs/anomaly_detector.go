@@ -32,6 +32,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_anomaly_detector", name="Anomaly Detector")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
 // @Testing(importStateIdFunc="testAccAnomalyDetectorImportStateIDFunc")
 // @Testing(importStateIdAttribute="arn")
 // @Testing(importIgnore="enabled")
@@ -33,6 +33,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
 func newDeliveryDestinationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliveryDestinationResource{}
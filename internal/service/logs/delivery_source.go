@@ -31,6 +31,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
 func newDeliverySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliverySourceResource{}
@@ -18,6 +18,26 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newAnomalyDetectorListResource,
+			TypeName: "aws_cloudwatch_log_anomaly_detector",
+			Name:     "Anomaly Detector",
+		},
+		{
+			Factory:  newDeliveryDestinationListResource,
+			TypeName: "aws_cloudwatch_log_delivery_destination",
+			Name:     "Delivery Destination",
+		},
+		{
+			Factory:  newDeliverySourceListResource,
+			TypeName: "aws_cloudwatch_log_delivery_source",
+			Name:     "Delivery Source",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
@@ -27,6 +47,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IsARN: true,
+				Attributes: []types.ServicePackageResourceIdentityAttribute{
+					{
+						Name: names.AttrARN,
+					},
+				},
+			},
 		},
 		{
 			Factory:  newDeliveryResource,
@@ -43,6 +71,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.ServicePackageResourceIdentityAttribute{
+					{
+						Name: names.AttrName,
+					},
+				},
+			},
 		},
 		{
 			Factory:  newDeliveryDestinationPolicyResource,
@@ -56,6 +91,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.ServicePackageResourceIdentityAttribute{
+					{
+						Name: names.AttrName,
+					},
+				},
+			},
 		},
 		{
 			Factory:  newIndexPolicyResource,
@@ -159,6 +201,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*cloudwatchlogs.Options){
 		cloudwatchlogs.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *cloudwatchlogs.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
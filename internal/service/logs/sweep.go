@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -27,7 +28,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_cloudwatch_log_destination", sweepDestinations)
 
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -55,17 +56,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweepQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
 }
 
+// @FrameworkListResource("aws_cloudwatch_log_anomaly_detector", name="Anomaly Detector")
+func newAnomalyDetectorListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return framework.NewListResource(newAnomalyDetectorResource, sweepAnomalyDetectors), nil
+}
+
 func sweepAnomalyDetectors(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
 	conn := client.LogsClient(ctx)
@@ -110,6 +116,11 @@ func sweepDeliveries(ctx context.Context, client *conns.AWSClient) ([]sweep.Swee
 	return sweepResources, nil
 }
 
+// @FrameworkListResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
+func newDeliveryDestinationListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return framework.NewListResource(newDeliveryDestinationResource, sweepDeliveryDestinations), nil
+}
+
 func sweepDeliveryDestinations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
 	conn := client.LogsClient(ctx)
@@ -132,6 +143,11 @@ func sweepDeliveryDestinations(ctx context.Context, client *conns.AWSClient) ([]
 	return sweepResources, nil
 }
 
+// @FrameworkListResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
+func newDeliverySourceListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return framework.NewListResource(newDeliverySourceResource, sweepDeliverySources), nil
+}
+
 func sweepDeliverySources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
 	conn := client.LogsClient(ctx)
@@ -40,35 +40,36 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name str
 	return nil, err
 }
 
-func waitForDomainCreation(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
-	var out *awstypes.DomainStatus
-	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		out, err = findDomainByName(ctx, conn, domainName)
-		if tfresource.NotFound(err) {
-			return retry.RetryableError(err)
-		}
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		if !aws.ToBool(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
-			return nil
-		}
+const (
+	domainStatusActive     = "Active"
+	domainStatusNoEndpoint = "NoEndpoint"
+	domainStatusProcessing = "Processing"
+)
 
-		return retry.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for OpenSearch Domain to be created", domainName))
-	}, tfresource.WithDelay(10*time.Minute), tfresource.WithPollInterval(10*time.Second))
+// domainWaiter derives a status from a domain's Processing flag and endpoints,
+// as DomainStatus has no status field.
+var domainWaiter = tfresource.NewStateWaiter("OpenSearch Domain", func(v *awstypes.DomainStatus) string {
+	switch {
+	case aws.ToBool(v.Processing):
+		return domainStatusProcessing
+	case v.Endpoint == nil && v.Endpoints == nil:
+		return domainStatusNoEndpoint
+	default:
+		return domainStatusActive
+	}
+})
 
-	if tfresource.TimedOut(err) {
-		out, err = findDomainByName(ctx, conn, domainName)
-		if err != nil {
-			return fmt.Errorf("describing OpenSearch Domain: %w", err)
-		}
-		if !aws.ToBool(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
-			return nil
-		}
+func findDomainFunc(conn *opensearch.Client, domainName string) func(context.Context) (*awstypes.DomainStatus, error) {
+	return func(ctx context.Context) (*awstypes.DomainStatus, error) {
+		return findDomainByName(ctx, conn, domainName)
 	}
+}
+
+func waitForDomainCreation(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
+	_, err := domainWaiter.WaitFor(ctx, findDomainFunc(conn, domainName),
+		[]string{domainStatusProcessing, domainStatusNoEndpoint}, []string{domainStatusActive}, timeout,
+		tfresource.WithDelay(10*time.Minute), tfresource.WithPollInterval(10*time.Second))
+
 	if err != nil {
 		return fmt.Errorf("waiting for OpenSearch Domain to be created: %w", err)
 	}
@@ -76,31 +77,10 @@ func waitForDomainCreation(ctx context.Context, conn *opensearch.Client, domainN
 }
 
 func waitForDomainUpdate(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
-	var out *awstypes.DomainStatus
-	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		out, err = findDomainByName(ctx, conn, domainName)
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	_, err := domainWaiter.WaitFor(ctx, findDomainFunc(conn, domainName),
+		[]string{domainStatusProcessing}, []string{domainStatusActive, domainStatusNoEndpoint}, timeout,
+		tfresource.WithDelay(1*time.Minute), tfresource.WithPollInterval(10*time.Second))
 
-		if !aws.ToBool(out.Processing) {
-			return nil
-		}
-
-		return retry.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for changes to be processed", domainName))
-	}, tfresource.WithDelay(1*time.Minute), tfresource.WithPollInterval(10*time.Second))
-
-	if tfresource.TimedOut(err) {
-		out, err = findDomainByName(ctx, conn, domainName)
-		if err != nil {
-			return fmt.Errorf("describing OpenSearch Domain: %w", err)
-		}
-		if !aws.ToBool(out.Processing) {
-			return nil
-		}
-	}
 	if err != nil {
 		return fmt.Errorf("waiting for OpenSearch Domain changes to be processed: %w", err)
 	}
@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -2024,6 +2025,35 @@ func statusDBCluster(ctx context.Context, conn *rds.Client, id string, waitNoPen
 	}
 }
 
+// dbClusterWaiter waits for a DB cluster's status.
+var dbClusterWaiter = newDBClusterWaiter(false)
+
+// dbClusterNoPendingModifiedValuesWaiter waits for a DB cluster's status and for its pending modified values to be applied.
+var dbClusterNoPendingModifiedValuesWaiter = newDBClusterWaiter(true)
+
+// newDBClusterWaiter returns a waiter for a DB cluster's status.
+// If waitNoPendingModifiedValues is true, an available cluster with pending modified values
+// has the status clusterStatusAvailableWithPendingModifiedValues.
+func newDBClusterWaiter(waitNoPendingModifiedValues bool) *tfresource.StateWaiter[*types.DBCluster] {
+	return tfresource.NewStateWaiter("RDS Cluster", func(v *types.DBCluster) string {
+		status := aws.ToString(v.Status)
+
+		if status == clusterStatusAvailable && waitNoPendingModifiedValues && !itypes.IsZero(v.PendingModifiedValues) {
+			status = clusterStatusAvailableWithPendingModifiedValues
+		}
+
+		return status
+	}, tfresource.WithFailureReason(func(v *types.DBCluster) error {
+		return dbClusterStatusInfosError(v.StatusInfos)
+	}))
+}
+
+func findDBClusterFunc(conn *rds.Client, id string) func(context.Context) (*types.DBCluster, error) {
+	return func(ctx context.Context) (*types.DBCluster, error) {
+		return findDBClusterByID(ctx, conn, id)
+	}
+}
+
 func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, waitNoPendingModifiedValues bool, timeout time.Duration) (*types.DBCluster, error) { //nolint:unparam
 	pendingStatuses := []string{
 		clusterStatusBackingUp,
@@ -2041,49 +2071,28 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 		clusterStatusUpgrading,
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    pendingStatuses,
-		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, waitNoPendingModifiedValues),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBCluster); ok {
-		return output, err
+	waiter := dbClusterWaiter
+	if waitNoPendingModifiedValues {
+		waiter = dbClusterNoPendingModifiedValuesWaiter
 	}
 
-	return nil, err
+	return waiter.WaitFor(ctx, findDBClusterFunc(conn, id), pendingStatuses, []string{clusterStatusAvailable}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
 }
 
 func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			clusterStatusBackingUp,
-			clusterStatusCreating,
-			clusterStatusMigrating,
-			clusterStatusModifying,
-			clusterStatusPreparingDataMigration,
-			clusterStatusRebooting,
-			clusterStatusResettingMasterCredentials,
-		},
-		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, false),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBCluster); ok {
-		return output, err
+	pendingStatuses := []string{
+		clusterStatusBackingUp,
+		clusterStatusCreating,
+		clusterStatusMigrating,
+		clusterStatusModifying,
+		clusterStatusPreparingDataMigration,
+		clusterStatusRebooting,
+		clusterStatusResettingMasterCredentials,
 	}
 
-	return nil, err
+	return dbClusterWaiter.WaitFor(ctx, findDBClusterFunc(conn, id), pendingStatuses, []string{clusterStatusAvailable}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
 }
 
 func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, waitNoPendingModifiedValues bool, timeout time.Duration) (*types.DBCluster, error) { //nolint:unparam
@@ -2098,52 +2107,43 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 		clusterStatusScalingStorage,
 		clusterStatusUpgrading,
 	}
+	waiter := dbClusterWaiter
 	if waitNoPendingModifiedValues {
 		pendingStatuses = append(pendingStatuses, clusterStatusAvailableWithPendingModifiedValues)
+		waiter = dbClusterNoPendingModifiedValuesWaiter
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    pendingStatuses,
-		Target:     []string{clusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id, waitNoPendingModifiedValues),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	return waiter.WaitFor(ctx, findDBClusterFunc(conn, id), pendingStatuses, []string{clusterStatusAvailable}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
+}
 
-	if output, ok := outputRaw.(*types.DBCluster); ok {
-		return output, err
+func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
+	pendingStatuses := []string{
+		clusterStatusAvailable,
+		clusterStatusBackingUp,
+		clusterStatusDeleting,
+		clusterStatusModifying,
+		clusterStatusPromoting,
+		clusterStatusScalingCompute,
 	}
 
-	return nil, err
+	return dbClusterWaiter.WaitFor(ctx, findDBClusterFunc(conn, id), pendingStatuses, []string{}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
 }
 
-func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			clusterStatusAvailable,
-			clusterStatusBackingUp,
-			clusterStatusDeleting,
-			clusterStatusModifying,
-			clusterStatusPromoting,
-			clusterStatusScalingCompute,
-		},
-		Target:     []string{},
-		Refresh:    statusDBCluster(ctx, conn, id, false),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
+// dbClusterStatusInfosError returns an error for each of a DB cluster's abnormal status infos.
+func dbClusterStatusInfosError(apiObjects []types.DBClusterStatusInfo) error {
+	var errs []error
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	for _, apiObject := range apiObjects {
+		if aws.ToBool(apiObject.Normal) {
+			continue
+		}
 
-	if output, ok := outputRaw.(*types.DBCluster); ok {
-		return output, err
+		errs = append(errs, fmt.Errorf("%s %s: %s", aws.ToString(apiObject.StatusType), aws.ToString(apiObject.Status), aws.ToString(apiObject.Message)))
 	}
 
-	return nil, err
+	return errors.Join(errs...)
 }
 
 func expandScalingConfiguration(tfMap map[string]interface{}) *types.ScalingConfiguration {
@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -579,59 +578,35 @@ func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func waitDBClusterInstanceAvailable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusBackingUp,
-			instanceStatusConfiguringEnhancedMonitoring,
-			instanceStatusConfiguringIAMDatabaseAuth,
-			instanceStatusConfiguringLogExports,
-			instanceStatusCreating,
-			instanceStatusMaintenance,
-			instanceStatusModifying,
-			instanceStatusRebooting,
-			instanceStatusRenaming,
-			instanceStatusResettingMasterCredentials,
-			instanceStatusStarting,
-			instanceStatusUpgrading,
-		},
-		Target:     []string{instanceStatusAvailable, instanceStatusStorageOptimization},
-		Refresh:    statusDBInstance(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	pendingStatuses := []string{
+		instanceStatusBackingUp,
+		instanceStatusConfiguringEnhancedMonitoring,
+		instanceStatusConfiguringIAMDatabaseAuth,
+		instanceStatusConfiguringLogExports,
+		instanceStatusCreating,
+		instanceStatusMaintenance,
+		instanceStatusModifying,
+		instanceStatusRebooting,
+		instanceStatusRenaming,
+		instanceStatusResettingMasterCredentials,
+		instanceStatusStarting,
+		instanceStatusUpgrading,
+	}
+
+	return dbInstanceWaiter.WaitFor(ctx, findDBInstanceFunc(conn, id), pendingStatuses, []string{instanceStatusAvailable, instanceStatusStorageOptimization}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
 }
 
 func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusConfiguringLogExports,
-			instanceStatusDeletePreCheck,
-			instanceStatusDeleting,
-			instanceStatusModifying,
-		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBInstance); ok {
-		return output, err
+	pendingStatuses := []string{
+		instanceStatusConfiguringLogExports,
+		instanceStatusDeletePreCheck,
+		instanceStatusDeleting,
+		instanceStatusModifying,
 	}
 
-	return nil, err
+	return dbInstanceWaiter.WaitFor(ctx, findDBInstanceFunc(conn, id), pendingStatuses, []string{}, timeout,
+		tfresource.WithMinPollInterval(10*time.Second), tfresource.WithDelay(30*time.Second))
 }
 
 func clusterSetResourceDataEngineVersionFromClusterInstance(d *schema.ResourceData, c *types.DBInstance) {
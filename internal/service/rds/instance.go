@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -416,7 +417,7 @@ func resourceInstance() *schema.Resource {
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrPassword},
+				ConflictsWith: []string{names.AttrPassword, "password_wo"},
 			},
 			"master_user_secret": {
 				Type:     schema.TypeList,
@@ -497,8 +498,10 @@ func resourceInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"manage_master_user_password"},
+				ConflictsWith: []string{"manage_master_user_password", "password_wo"},
 			},
+			"password_wo":         sdkv2.WriteOnlyStringSchema("password_wo", "manage_master_user_password", names.AttrPassword),
+			"password_wo_version": sdkv2.WriteOnlyVersionSchema("password_wo"),
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -725,6 +728,10 @@ func resourceInstance() *schema.Resource {
 				return nil
 			},
 		),
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrPassword), cty.GetAttrPath("password_wo")),
+		},
 	}
 }
 
@@ -748,6 +755,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	// we expect everything to be in sync before returning completion.
 	var requiresRebootDbInstance bool
 
+	password := d.Get(names.AttrPassword).(string)
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if passwordWO != "" {
+		password = passwordWO
+	}
+
 	// See discussion of IDs at the top of file - this is NOT d.Id()
 	identifier := create.Name(d.Get(names.AttrIdentifier).(string), d.Get("identifier_prefix").(string))
 
@@ -997,8 +1014,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		if v, ok := d.GetOk(names.AttrPassword); ok {
-			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
+		if password != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(password)
 			requiresModifyDbInstance = true
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
@@ -1110,8 +1127,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrPassword); ok {
-			input.MasterUserPassword = aws.String(v.(string))
+		if password != "" {
+			input.MasterUserPassword = aws.String(password)
 		}
 
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
@@ -1352,8 +1369,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrPassword); ok {
-			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
+		if password != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(password)
 			requiresModifyDbInstance = true
 		}
 
@@ -1578,8 +1595,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrPassword); ok {
-			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
+		if password != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(password)
 			requiresModifyDbInstance = true
 		}
 
@@ -1775,8 +1792,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.OptionGroupName = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrPassword); ok {
-			input.MasterUserPassword = aws.String(v.(string))
+		if password != "" {
+			input.MasterUserPassword = aws.String(password)
 		}
 
 		if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
@@ -2115,6 +2132,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			names.AttrTags, names.AttrTagsAll,
 			names.AttrDeletionProtection,
 			names.AttrPassword,
+			"password_wo_version",
 		) {
 			orchestrator := newBlueGreenOrchestrator(conn)
 			defer orchestrator.CleanUp(ctx)
@@ -2277,7 +2295,11 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 			}
 
-			dbInstancePopulateModify(input, d)
+			_, di := dbInstancePopulateModify(input, d)
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
 
 			if d.HasChange(names.AttrEngineVersion) {
 				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
@@ -2407,7 +2429,8 @@ func dbInstanceCreateReadReplica(ctx context.Context, conn *rds.Client, input *r
 	return outputRaw.(*rds.CreateDBInstanceReadReplicaOutput), nil
 }
 
-func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.ResourceData) bool {
+func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.ResourceData) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	needsModify := false
 
 	if d.HasChanges(names.AttrAllocatedStorage, names.AttrIOPS) {
@@ -2582,6 +2605,18 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("password_wo_version") {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if passwordWO != "" {
+			needsModify = true
+			input.MasterUserPassword = aws.String(passwordWO)
+		}
+	}
+
 	if d.HasChanges("performance_insights_enabled", "performance_insights_kms_key_id", "performance_insights_retention_period") {
 		needsModify = true
 		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
@@ -2646,7 +2681,7 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		}
 	}
 
-	return needsModify
+	return needsModify, diags
 }
 
 func dbInstanceModify(ctx context.Context, conn *rds.Client, resourceID string, input *rds.ModifyDBInstanceInput, timeout time.Duration) error {
@@ -2814,141 +2849,108 @@ func findDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeD
 	return output, nil
 }
 
-func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findDBInstanceByID(ctx, conn, id, optFns...)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
+// dbInstanceWaiter waits for a DB instance's status.
+var dbInstanceWaiter = tfresource.NewStateWaiter("RDS DB Instance", func(v *types.DBInstance) string {
+	return aws.ToString(v.DBInstanceStatus)
+}, tfresource.WithFailureReason(func(v *types.DBInstance) error {
+	return dbInstanceStatusInfosError(v.StatusInfos)
+}))
 
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.ToString(output.DBInstanceStatus), nil
+func findDBInstanceFunc(conn *rds.Client, id string) func(context.Context) (*types.DBInstance, error) {
+	return func(ctx context.Context) (*types.DBInstance, error) {
+		return findDBInstanceByID(ctx, conn, id)
 	}
 }
 
 func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
-	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
-		Delay:                     1 * time.Minute,
-		ContinuousTargetOccurence: 3,
-	}
-	for _, fn := range optFns {
-		fn(&options)
-	}
-
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusBackingUp,
-			instanceStatusConfiguringEnhancedMonitoring,
-			instanceStatusConfiguringIAMDatabaseAuth,
-			instanceStatusConfiguringLogExports,
-			instanceStatusCreating,
-			instanceStatusMaintenance,
-			instanceStatusModifying,
-			instanceStatusMovingToVPC,
-			instanceStatusRebooting,
-			instanceStatusRenaming,
-			instanceStatusResettingMasterCredentials,
-			instanceStatusStarting,
-			instanceStatusStopping,
-			instanceStatusStorageFull,
-			instanceStatusUpgrading,
-		},
-		Target:  []string{instanceStatusAvailable, instanceStatusStorageOptimization},
-		Refresh: statusDBInstance(ctx, conn, id),
-		Timeout: timeout,
-	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	pendingStatuses := []string{
+		instanceStatusBackingUp,
+		instanceStatusConfiguringEnhancedMonitoring,
+		instanceStatusConfiguringIAMDatabaseAuth,
+		instanceStatusConfiguringLogExports,
+		instanceStatusCreating,
+		instanceStatusMaintenance,
+		instanceStatusModifying,
+		instanceStatusMovingToVPC,
+		instanceStatusRebooting,
+		instanceStatusRenaming,
+		instanceStatusResettingMasterCredentials,
+		instanceStatusStarting,
+		instanceStatusStopping,
+		instanceStatusStorageFull,
+		instanceStatusUpgrading,
+	}
+	optFns = append([]tfresource.OptionsFunc{
+		tfresource.WithPollInterval(10 * time.Second),
+		tfresource.WithDelay(1 * time.Minute),
+		tfresource.WithContinuousTargetOccurence(3),
+	}, optFns...)
+
+	return dbInstanceWaiter.WaitFor(ctx, findDBInstanceFunc(conn, id), pendingStatuses, []string{instanceStatusAvailable, instanceStatusStorageOptimization}, timeout, optFns...)
 }
 
 func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusBackingUp,
-			instanceStatusConfiguringEnhancedMonitoring,
-			instanceStatusConfiguringIAMDatabaseAuth,
-			instanceStatusConfiguringLogExports,
-			instanceStatusCreating,
-			instanceStatusMaintenance,
-			instanceStatusModifying,
-			instanceStatusMovingToVPC,
-			instanceStatusRebooting,
-			instanceStatusRenaming,
-			instanceStatusResettingMasterCredentials,
-			instanceStatusStarting,
-			instanceStatusStopping,
-			instanceStatusStorageFull,
-			instanceStatusUpgrading,
-		},
-		Target:                    []string{instanceStatusStopped},
-		Refresh:                   statusDBInstance(ctx, conn, id),
-		Timeout:                   timeout,
-		ContinuousTargetOccurence: 2,
-		Delay:                     10 * time.Second,
-		MinTimeout:                3 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	pendingStatuses := []string{
+		instanceStatusBackingUp,
+		instanceStatusConfiguringEnhancedMonitoring,
+		instanceStatusConfiguringIAMDatabaseAuth,
+		instanceStatusConfiguringLogExports,
+		instanceStatusCreating,
+		instanceStatusMaintenance,
+		instanceStatusModifying,
+		instanceStatusMovingToVPC,
+		instanceStatusRebooting,
+		instanceStatusRenaming,
+		instanceStatusResettingMasterCredentials,
+		instanceStatusStarting,
+		instanceStatusStopping,
+		instanceStatusStorageFull,
+		instanceStatusUpgrading,
+	}
+
+	return dbInstanceWaiter.WaitFor(ctx, findDBInstanceFunc(conn, id), pendingStatuses, []string{instanceStatusStopped}, timeout,
+		tfresource.WithContinuousTargetOccurence(2), tfresource.WithDelay(10*time.Second), tfresource.WithMinPollInterval(3*time.Second))
 }
 
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
-	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
-		Delay:                     1 * time.Minute,
-		ContinuousTargetOccurence: 3,
-	}
-	for _, fn := range optFns {
-		fn(&options)
-	}
+	pendingStatuses := []string{
+		instanceStatusAvailable,
+		instanceStatusBackingUp,
+		instanceStatusConfiguringEnhancedMonitoring,
+		instanceStatusConfiguringLogExports,
+		instanceStatusCreating,
+		instanceStatusDeletePreCheck,
+		instanceStatusDeleting,
+		instanceStatusIncompatibleParameters,
+		instanceStatusIncompatibleRestore,
+		instanceStatusModifying,
+		instanceStatusStarting,
+		instanceStatusStopping,
+		instanceStatusStorageFull,
+		instanceStatusStorageOptimization,
+	}
+	optFns = append([]tfresource.OptionsFunc{
+		tfresource.WithPollInterval(10 * time.Second),
+		tfresource.WithDelay(1 * time.Minute),
+		tfresource.WithContinuousTargetOccurence(3),
+	}, optFns...)
+
+	return dbInstanceWaiter.WaitFor(ctx, findDBInstanceFunc(conn, id), pendingStatuses, []string{}, timeout, optFns...)
+}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			instanceStatusAvailable,
-			instanceStatusBackingUp,
-			instanceStatusConfiguringEnhancedMonitoring,
-			instanceStatusConfiguringLogExports,
-			instanceStatusCreating,
-			instanceStatusDeletePreCheck,
-			instanceStatusDeleting,
-			instanceStatusIncompatibleParameters,
-			instanceStatusIncompatibleRestore,
-			instanceStatusModifying,
-			instanceStatusStarting,
-			instanceStatusStopping,
-			instanceStatusStorageFull,
-			instanceStatusStorageOptimization,
-		},
-		Target:  []string{},
-		Refresh: statusDBInstance(ctx, conn, id),
-		Timeout: timeout,
-	}
-	options.Apply(stateConf)
+// dbInstanceStatusInfosError returns an error for each of a DB instance's abnormal status infos.
+func dbInstanceStatusInfosError(apiObjects []types.DBInstanceStatusInfo) error {
+	var errs []error
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	for _, apiObject := range apiObjects {
+		if aws.ToBool(apiObject.Normal) {
+			continue
+		}
 
-	if output, ok := outputRaw.(*types.DBInstance); ok {
-		return output, err
+		errs = append(errs, fmt.Errorf("%s %s: %s", aws.ToString(apiObject.StatusType), aws.ToString(apiObject.Status), aws.ToString(apiObject.Message)))
 	}
 
-	return nil, err
+	return errors.Join(errs...)
 }
 
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds.Client, id string) (*types.BlueGreenDeployment, error) {
@@ -16,6 +16,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
@@ -875,6 +876,43 @@ func TestAccRedshiftCluster_manageMasterPassword(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftCluster_masterPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "Mustbe8characters", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "Mustbe8charactersUpdated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterNotRecreated(&v1, &v2),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Cluster
@@ -1815,6 +1853,24 @@ resource "aws_redshift_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_masterPasswordWriteOnly(rName, masterPassword string, masterPasswordVersion int) string {
+	// "InvalidVPCNetworkStateFault: The requested AZ us-west-2a is not a valid AZ."
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password_wo                  = %[2]q
+  master_password_wo_version          = %[3]d
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName, masterPassword, masterPasswordVersion))
+}
+
 func testAccClusterConfig_multiAZ(rName string, enabled bool) string {
 	return acctest.ConfigCompose(
 		fmt.Sprintf(`
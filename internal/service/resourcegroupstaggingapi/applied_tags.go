@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"slices"
+	"strings"
+
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// appliedTags maps the ARN of each resource to the keys of the tags that were applied to it.
+type appliedTags map[string][]string
+
+// without returns the applied tags excluding those in the specified applied tags.
+func (a appliedTags) without(remove appliedTags) appliedTags {
+	result := appliedTags{}
+
+	for arn, keys := range a {
+		if keys := tfslices.Filter(keys, func(v string) bool {
+			return !slices.Contains(remove[arn], v)
+		}); len(keys) > 0 {
+			result[arn] = keys
+		}
+	}
+
+	return result
+}
+
+// unowned returns the applied tags that are no longer owned: all of those applied to resources that are
+// no longer selected, and those with removed keys applied to resources that remain selected.
+func (a appliedTags) unowned(resourceARNs, removedKeys []string) appliedTags {
+	result := appliedTags{}
+
+	for arn, keys := range a {
+		if !slices.Contains(resourceARNs, arn) {
+			result[arn] = keys
+		} else if keys := tfslices.Filter(keys, func(v string) bool {
+			return slices.Contains(removedKeys, v)
+		}); len(keys) > 0 {
+			result[arn] = keys
+		}
+	}
+
+	return result
+}
+
+// groupByKeys returns the sorted ARNs of the resources with the same set of applied tag keys,
+// indexed by the sorted keys joined with NUL.
+func (a appliedTags) groupByKeys() map[string][]string {
+	byKeys := make(map[string][]string)
+
+	for arn, keys := range a {
+		if len(keys) == 0 {
+			continue
+		}
+
+		keys = slices.Sorted(slices.Values(keys))
+		k := strings.Join(keys, "\x00")
+		byKeys[k] = append(byKeys[k], arn)
+	}
+
+	for _, resourceARNs := range byKeys {
+		slices.Sort(resourceARNs)
+	}
+
+	return byKeys
+}
+
+func (a appliedTags) flatten() []interface{} {
+	var tfList []interface{}
+
+	for arn, keys := range a {
+		for _, key := range keys {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrKey:         key,
+				names.AttrResourceARN: arn,
+			})
+		}
+	}
+
+	return tfList
+}
+
+func expandAppliedTags(tfList []interface{}) appliedTags {
+	a := appliedTags{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		arn, key := tfMap[names.AttrResourceARN].(string), tfMap[names.AttrKey].(string)
+		a[arn] = append(a[arn], key)
+	}
+
+	return a
+}
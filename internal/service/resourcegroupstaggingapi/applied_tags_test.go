@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestPartitionResourcesTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		resourceTags     map[string]map[string]string
+		tags             map[string]string
+		applied          appliedTags
+		expectedTagged   []string
+		expectedUntagged []string
+		expectedApplied  appliedTags
+		expectedPending  appliedTags
+	}{
+		"no resources": {
+			tags:            map[string]string{"key1": "value1"},
+			expectedApplied: appliedTags{},
+			expectedPending: appliedTags{},
+		},
+		"already tagged": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1", "key2": "value2"},
+			},
+			tags:            map[string]string{"key1": "value1"},
+			expectedTagged:  []string{"arn1"},
+			expectedApplied: appliedTags{},
+			expectedPending: appliedTags{},
+		},
+		"missing": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1"},
+			},
+			tags:             map[string]string{"key1": "value1", "key2": "value2"},
+			expectedUntagged: []string{"arn1"},
+			expectedApplied:  appliedTags{},
+			expectedPending:  appliedTags{"arn1": {"key2"}},
+		},
+		"changed": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value2"},
+			},
+			tags:             map[string]string{"key1": "value1"},
+			expectedUntagged: []string{"arn1"},
+			expectedApplied:  appliedTags{},
+			expectedPending:  appliedTags{"arn1": {"key1"}},
+		},
+		"previously applied": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1"},
+			},
+			tags:            map[string]string{"key1": "value1"},
+			applied:         appliedTags{"arn1": {"key1"}},
+			expectedTagged:  []string{"arn1"},
+			expectedApplied: appliedTags{"arn1": {"key1"}},
+			expectedPending: appliedTags{},
+		},
+		"previously applied and missing": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1"},
+			},
+			tags:             map[string]string{"key1": "value1", "key2": "value2"},
+			applied:          appliedTags{"arn1": {"key1"}},
+			expectedUntagged: []string{"arn1"},
+			expectedApplied:  appliedTags{"arn1": {"key1"}},
+			expectedPending:  appliedTags{"arn1": {"key2"}},
+		},
+		"previously applied no longer specified": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1", "key2": "value2"},
+			},
+			tags:            map[string]string{"key2": "value2"},
+			applied:         appliedTags{"arn1": {"key1", "key2"}},
+			expectedTagged:  []string{"arn1"},
+			expectedApplied: appliedTags{"arn1": {"key2"}},
+			expectedPending: appliedTags{},
+		},
+		"multiple resources": {
+			resourceTags: map[string]map[string]string{
+				"arn1": {"key1": "value1"},
+				"arn2": {},
+			},
+			tags:             map[string]string{"key1": "value1"},
+			expectedTagged:   []string{"arn1"},
+			expectedUntagged: []string{"arn2"},
+			expectedApplied:  appliedTags{},
+			expectedPending:  appliedTags{"arn2": {"key1"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var taggings []types.ResourceTagMapping
+			for arn, tags := range testCase.resourceTags {
+				tagging := types.ResourceTagMapping{ResourceARN: aws.String(arn)}
+				for k, v := range tags {
+					tagging.Tags = append(tagging.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+				}
+				taggings = append(taggings, tagging)
+			}
+
+			tagged, untagged, applied, pending := partitionResourcesTags(ctx, taggings, tftags.New(ctx, testCase.tags), testCase.applied)
+
+			sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+			if diff := cmp.Diff(testCase.expectedTagged, tagged, sortStrings); diff != "" {
+				t.Errorf("unexpected tagged resources (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedUntagged, untagged, sortStrings); diff != "" {
+				t.Errorf("unexpected untagged resources (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedApplied, applied, sortStrings); diff != "" {
+				t.Errorf("unexpected applied tags (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedPending, pending, sortStrings); diff != "" {
+				t.Errorf("unexpected pending tags (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppliedTagsWithout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		applied  appliedTags
+		remove   appliedTags
+		expected appliedTags
+	}{
+		"empty": {
+			expected: appliedTags{},
+		},
+		"none removed": {
+			applied:  appliedTags{"arn1": {"key1", "key2"}},
+			expected: appliedTags{"arn1": {"key1", "key2"}},
+		},
+		"some keys removed": {
+			applied:  appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1"}},
+			remove:   appliedTags{"arn1": {"key2"}},
+			expected: appliedTags{"arn1": {"key1"}, "arn2": {"key1"}},
+		},
+		"all keys removed": {
+			applied:  appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1"}},
+			remove:   appliedTags{"arn1": {"key1", "key2"}},
+			expected: appliedTags{"arn2": {"key1"}},
+		},
+		"other resource": {
+			applied:  appliedTags{"arn1": {"key1"}},
+			remove:   appliedTags{"arn2": {"key1"}},
+			expected: appliedTags{"arn1": {"key1"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.applied.without(testCase.remove)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected applied tags (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppliedTagsUnowned(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		applied      appliedTags
+		resourceARNs []string
+		removedKeys  []string
+		expected     appliedTags
+	}{
+		"empty": {
+			expected: appliedTags{},
+		},
+		"all owned": {
+			applied:      appliedTags{"arn1": {"key1", "key2"}},
+			resourceARNs: []string{"arn1"},
+			expected:     appliedTags{},
+		},
+		"resource no longer selected": {
+			applied:      appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1"}},
+			resourceARNs: []string{"arn2"},
+			expected:     appliedTags{"arn1": {"key1", "key2"}},
+		},
+		"key removed": {
+			applied:      appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1"}},
+			resourceARNs: []string{"arn1", "arn2"},
+			removedKeys:  []string{"key2"},
+			expected:     appliedTags{"arn1": {"key2"}},
+		},
+		"resource no longer selected and key removed": {
+			applied:      appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1", "key2"}},
+			resourceARNs: []string{"arn2"},
+			removedKeys:  []string{"key1"},
+			expected:     appliedTags{"arn1": {"key1", "key2"}, "arn2": {"key1"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.applied.unowned(testCase.resourceARNs, testCase.removedKeys)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected unowned tags (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppliedTagsGroupByKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		applied  appliedTags
+		expected map[string][]string
+	}{
+		"empty": {
+			expected: map[string][]string{},
+		},
+		"no keys": {
+			applied:  appliedTags{"arn1": {}},
+			expected: map[string][]string{},
+		},
+		"same keys": {
+			applied: appliedTags{"arn2": {"key2", "key1"}, "arn1": {"key1", "key2"}},
+			expected: map[string][]string{
+				"key1\x00key2": {"arn1", "arn2"},
+			},
+		},
+		"different keys": {
+			applied: appliedTags{"arn1": {"key1"}, "arn2": {"key1", "key2"}, "arn3": {"key1"}},
+			expected: map[string][]string{
+				"key1":         {"arn1", "arn3"},
+				"key1\x00key2": {"arn2"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.applied.groupByKeys()
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected groups (-want +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of resource ARNs in a single TagResources or UntagResources request.
+	tagResourcesMaxResourceARNs = 20
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_resources_tags", name="Resources Tags")
+func resourceResourcesTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcesTagsCreate,
+		ReadWithoutTimeout:   resourceResourcesTagsRead,
+		UpdateWithoutTimeout: resourceResourcesTagsUpdate,
+		DeleteWithoutTimeout: resourceResourcesTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"applied_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_arn_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"tag_filter"},
+				AtLeastOneOf:  []string{"resource_arn_list", "resource_type_filters", "tag_filter"},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arn_list"},
+				AtLeastOneOf:  []string{"resource_arn_list", "resource_type_filters", "tag_filter"},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				AtLeastOneOf: []string{"resource_arn_list", "resource_type_filters", "tag_filter"},
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: resourceResourcesTagsCustomizeDiff,
+	}
+}
+
+func resourceResourcesTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	taggings, err := findResourcesTagsResourceTagMappings(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resources Tags: %s", err)
+	}
+
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	resourceARNs, applied, diags := applyResourcesTags(ctx, conn, diags, taggings, tags, appliedTags{})
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.UniqueId())
+	// Don't call Read as the Resource Groups Tagging API is eventually consistent.
+	d.Set("applied_tags", applied.flatten())
+	d.Set("resource_arns", resourceARNs)
+
+	return diags
+}
+
+func resourceResourcesTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	taggings, err := findResourcesTagsResourceTagMappings(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources Tags (%s): %s", d.Id(), err)
+	}
+
+	// Only the selected resources that have all of the owned tags are recorded.
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	resourceARNs := tfslices.ApplyToAll(tfslices.Filter(taggings, func(v types.ResourceTagMapping) bool {
+		return KeyValueTags(ctx, v.Tags).ContainsAll(tags)
+	}), func(v types.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	})
+	d.Set("resource_arns", resourceARNs)
+
+	return diags
+}
+
+func resourceResourcesTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	taggings, err := findResourcesTagsResourceTagMappings(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resources Tags (%s): %s", d.Id(), err)
+	}
+
+	o, n := d.GetChange(names.AttrTags)
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+	o, _ = d.GetChange("applied_tags")
+	applied := expandAppliedTags(o.(*schema.Set).List())
+	newResourceARNs := tfslices.ApplyToAll(taggings, func(v types.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	})
+
+	remove := applied.unowned(newResourceARNs, oldTags.Removed(newTags).Keys())
+
+	diags = untagAppliedTags(ctx, conn, diags, remove)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	applied = applied.without(remove)
+
+	resourceARNs, applied, diags := applyResourcesTags(ctx, conn, diags, taggings, newTags, applied)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("applied_tags", applied.flatten())
+	d.Set("resource_arns", resourceARNs)
+
+	return diags
+}
+
+func resourceResourcesTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	// Only the tags that this resource applied are removed.
+	// Tags that the selected resources already had are left in place.
+	applied := expandAppliedTags(d.Get("applied_tags").(*schema.Set).List())
+
+	return untagAppliedTags(ctx, conn, diags, applied)
+}
+
+// resourceResourcesTagsCustomizeDiff plans an update when the set of selected resources
+// no longer matches the set of resources that have all of the owned tags.
+func resourceResourcesTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"resource_arn_list", "resource_type_filters", "tag_filter", names.AttrTags} {
+		if !d.NewValueKnown(key) || d.HasChange(key) {
+			if err := d.SetNewComputed("applied_tags"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("resource_arns")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	taggings, err := findResourcesTagsResourceTagMappings(ctx, conn, d)
+
+	if err != nil {
+		return err
+	}
+
+	resourceARNs := tfslices.ApplyToAll(taggings, func(v types.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	})
+	oldResourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+
+	slices.Sort(resourceARNs)
+	slices.Sort(oldResourceARNs)
+
+	if !slices.Equal(resourceARNs, oldResourceARNs) {
+		if err := d.SetNewComputed("applied_tags"); err != nil {
+			return err
+		}
+		return d.SetNew("resource_arns", resourceARNs)
+	}
+
+	return nil
+}
+
+// findResourcesTagsResourceTagMappings returns the tag mappings of the resources selected by the configuration.
+func findResourcesTagsResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, d sdkv2.ResourceDiffer) ([]types.ResourceTagMapping, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
+		return findResourceTagMappingsByResourceARNs(ctx, conn, input, flex.ExpandStringValueSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.TagFilters = expandTagFilters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	return findResourceTagMappings(ctx, conn, input)
+}
+
+// applyResourcesTags tags each resource that does not already have all of the specified tags.
+// The ARNs of the resources that have all of the tags are returned, together with the tags applied by this resource:
+// those previously applied and those that a resource was missing, or had with a different value, and were tagged.
+// Resources that could not be tagged are reported as warnings.
+func applyResourcesTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, diags diag.Diagnostics, taggings []types.ResourceTagMapping, tags tftags.KeyValueTags, applied appliedTags) ([]string, appliedTags, diag.Diagnostics) {
+	resourceARNs, untagged, newApplied, pending := partitionResourcesTags(ctx, taggings, tags, applied)
+
+	for chunk := range slices.Chunk(untagged, tagResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: chunk,
+			Tags:            tags.Map(),
+		}
+
+		output, err := conn.TagResources(ctx, input)
+
+		if err != nil {
+			return nil, nil, sdkdiag.AppendErrorf(diags, "tagging Resource Groups Tagging API Resources: %s", err)
+		}
+
+		for _, arn := range chunk {
+			if v, ok := output.FailedResourcesMap[arn]; ok {
+				diags = sdkdiag.AppendWarningf(diags, "tagging resource (%s): %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage))
+			} else {
+				resourceARNs = append(resourceARNs, arn)
+				if keys := append(newApplied[arn], pending[arn]...); len(keys) > 0 {
+					newApplied[arn] = keys
+				}
+			}
+		}
+	}
+
+	return resourceARNs, newApplied, diags
+}
+
+// partitionResourcesTags compares the tags of each selected resource with the specified tags.
+// It returns the ARNs of the resources that already have all of the tags and of those that must be tagged,
+// the previously applied tags that are still specified, and for each resource to be tagged, the keys of the
+// tags that it is missing, or has with a different value, and that tagging it would apply.
+func partitionResourcesTags(ctx context.Context, taggings []types.ResourceTagMapping, tags tftags.KeyValueTags, applied appliedTags) ([]string, []string, appliedTags, appliedTags) {
+	var resourceARNs, untagged []string
+	newApplied, pending := appliedTags{}, appliedTags{}
+
+	for _, v := range taggings {
+		arn := aws.ToString(v.ResourceARN)
+		resourceTags := KeyValueTags(ctx, v.Tags)
+
+		var previous, missing []string
+		for _, key := range tags.Keys() {
+			if slices.Contains(applied[arn], key) {
+				previous = append(previous, key)
+			} else if value := resourceTags.KeyValue(key); value == nil || aws.ToString(value) != aws.ToString(tags.KeyValue(key)) {
+				missing = append(missing, key)
+			}
+		}
+
+		if len(previous) > 0 {
+			newApplied[arn] = previous
+		}
+
+		if resourceTags.ContainsAll(tags) {
+			resourceARNs = append(resourceARNs, arn)
+		} else {
+			untagged = append(untagged, arn)
+			pending[arn] = missing
+		}
+	}
+
+	return resourceARNs, untagged, newApplied, pending
+}
+
+// untagAppliedTags removes the applied tags from their resources.
+// Resources with the same tag keys are untagged together.
+func untagAppliedTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, diags diag.Diagnostics, applied appliedTags) diag.Diagnostics {
+	for k, resourceARNs := range applied.groupByKeys() {
+		diags = untagResources(ctx, conn, diags, resourceARNs, strings.Split(k, "\x00"))
+	}
+
+	return diags
+}
+
+// untagResources removes the specified tag keys from the resources.
+// Resources that could not be untagged are reported as warnings.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, diags diag.Diagnostics, resourceARNs, keys []string) diag.Diagnostics {
+	for chunk := range slices.Chunk(resourceARNs, tagResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: chunk,
+			TagKeys:         keys,
+		}
+
+		output, err := conn.UntagResources(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "untagging Resource Groups Tagging API Resources: %s", err)
+		}
+
+		for _, arn := range chunk {
+			if v, ok := output.FailedResourcesMap[arn]; ok {
+				diags = sdkdiag.AppendWarningf(diags, "untagging resource (%s): %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage))
+			}
+		}
+	}
+
+	return diags
+}
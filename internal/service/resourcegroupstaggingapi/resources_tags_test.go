@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIResourcesTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_resources_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesTagsConfig_basic(rName, "CostCenter", "12345"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostCenter", "12345"),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.0", "CostCenter", "12345"),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.1", "CostCenter", "12345"),
+				),
+			},
+			{
+				Config: testAccResourcesTagsConfig_basic(rName, "CostOwner", "platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostOwner", "platform"),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.0", "CostOwner", "platform"),
+					testAccCheckResourcesTagsResourceNoTag(ctx, "aws_vpc.test.0", "CostCenter"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourcesTags_resourceARNList(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_resources_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesTagsConfig_resourceARNList(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.0", names.AttrARN),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.0", "CostCenter", "12345"),
+					testAccCheckResourcesTagsResourceNoTag(ctx, "aws_vpc.test.1", "CostCenter"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourcesTags_existingTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_resources_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesTagsConfig_existingTags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "applied_tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "applied_tags.*", map[string]string{
+						names.AttrKey: "CostCenter",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "applied_tags.*.resource_arn", "aws_vpc.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "applied_tags.*.resource_arn", "aws_vpc.test.1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccResourcesTagsConfig_base(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourcesTagsResourceNoTag(ctx, "aws_vpc.test.0", "CostCenter"),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.0", "TestGroup", rName),
+					testAccCheckResourcesTagsResourceTag(ctx, "aws_vpc.test.1", "TestGroup", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcesTagsResourceTag(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags, err := testAccResourcesTagsResourceTags(ctx, s, n)
+
+		if err != nil {
+			return err
+		}
+
+		if v, ok := tags[key]; !ok || v != value {
+			return fmt.Errorf("%s tag %q = %q, want %q", n, key, v, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourcesTagsResourceNoTag(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags, err := testAccResourcesTagsResourceTags(ctx, s, n)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := tags[key]; ok {
+			return fmt.Errorf("%s has unexpected tag %q", n, key)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourcesTagsResourceTags(ctx context.Context, s *terraform.State, n string) (map[string]string, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("Not found: %s", n)
+	}
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	output, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByResourceARNs(ctx, conn, &resourcegroupstaggingapi.GetResourcesInput{}, []string{rs.Primary.Attributes[names.AttrARN]})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresourcegroupstaggingapi.KeyValueTags(ctx, output[0].Tags).Map(), nil
+}
+
+func testAccResourcesTagsConfig_base(rName string) string {
+	// The VPCs must ignore the tags managed by aws_resourcegroupstaggingapi_resources_tags.
+	return acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeyPrefixes1("Cost"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name      = %[1]q
+    TestGroup = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccResourcesTagsConfig_basic(rName, tagKey, tagValue string) string {
+	return acctest.ConfigCompose(testAccResourcesTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_resources_tags" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "TestGroup"
+    values = [%[1]q]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName, tagKey, tagValue))
+}
+
+func testAccResourcesTagsConfig_resourceARNList(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesTagsConfig_base(rName), `
+resource "aws_resourcegroupstaggingapi_resources_tags" "test" {
+  resource_arn_list = [aws_vpc.test[0].arn]
+
+  tags = {
+    CostCenter = "12345"
+  }
+}
+`)
+}
+
+func testAccResourcesTagsConfig_existingTags(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_resources_tags" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "TestGroup"
+    values = [%[1]q]
+  }
+
+  tags = {
+    CostCenter = "12345"
+    TestGroup  = %[1]q
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName))
+}
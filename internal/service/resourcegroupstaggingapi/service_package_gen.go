@@ -29,11 +29,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_resourcegroupstaggingapi_resources",
 			Name:     "Resources",
 		},
+		{
+			Factory:  dataSourceTagDrift,
+			TypeName: "aws_resourcegroupstaggingapi_tag_drift",
+			Name:     "Tag Drift",
+		},
 	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceResourcesTags,
+			TypeName: "aws_resourcegroupstaggingapi_resources_tags",
+			Name:     "Resources Tags",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -46,6 +57,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*resourcegroupstaggingapi.Options){
 		resourcegroupstaggingapi.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *resourcegroupstaggingapi.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
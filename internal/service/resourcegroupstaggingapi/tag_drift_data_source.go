@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of resource ARNs in a single GetResources request.
+	getResourcesMaxResourceARNs = 100
+)
+
+// @SDKDataSource("aws_resourcegroupstaggingapi_tag_drift", name="Tag Drift")
+func dataSourceTagDrift() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTagDriftRead,
+
+		Schema: map[string]*schema.Schema{
+			"desired_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"drift": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"changed_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actual_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"desired_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrKey: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_tags": tftags.TagsSchemaComputed(),
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unexpected_tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"has_drift": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"report_unexpected_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_arn_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"tag_filter"},
+			},
+			"resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arn_list"},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTagDriftRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := c.IgnoreTagsConfig(ctx)
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.TagFilters = expandTagFilters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	var resourceARNs []string
+	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
+		resourceARNs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	var taggings []types.ResourceTagMapping
+	var err error
+
+	if len(resourceARNs) > 0 {
+		taggings, err = findResourceTagMappingsByResourceARNs(ctx, conn, input, resourceARNs)
+	} else {
+		taggings, err = findResourceTagMappings(ctx, conn, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
+	}
+
+	// The desired tags are the configured tags merged with any provider configured default_tags.
+	desiredTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get("desired_tags").(map[string]interface{})))
+	// Remove any provider configured ignore_tags and system tags.
+	desiredTags = desiredTags.IgnoreSystem(names.ResourceGroupsTaggingAPI).IgnoreConfig(ignoreTagsConfig)
+
+	reportUnexpected := d.Get("report_unexpected_tags").(bool)
+
+	var drift []tagDrift
+	for _, v := range taggings {
+		actualTags := KeyValueTags(ctx, v.Tags).IgnoreSystem(names.ResourceGroupsTaggingAPI).IgnoreConfig(ignoreTagsConfig)
+
+		if v := newTagDrift(aws.ToString(v.ResourceARN), desiredTags, actualTags, reportUnexpected); v.hasDrift() {
+			drift = append(drift, v)
+		}
+	}
+
+	slices.SortFunc(drift, func(a, b tagDrift) int {
+		return strings.Compare(a.resourceARN, b.resourceARN)
+	})
+
+	d.SetId(c.Region(ctx))
+	if err := d.Set("drift", flattenTagDrifts(drift)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting drift: %s", err)
+	}
+	d.Set("has_drift", len(drift) > 0)
+
+	return diags
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]types.ResourceTagMapping, error) {
+	var output []types.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
+
+// findResourceTagMappingsByResourceARNs returns the tag mappings of the specified resources.
+// Resources that have never been tagged are not returned by the API and are included without tags.
+func findResourceTagMappingsByResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput, resourceARNs []string) ([]types.ResourceTagMapping, error) {
+	var output []types.ResourceTagMapping
+
+	for chunk := range slices.Chunk(resourceARNs, getResourcesMaxResourceARNs) {
+		input.ResourceARNList = chunk
+
+		page, err := findResourceTagMappings(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page...)
+	}
+
+	for _, arn := range resourceARNs {
+		if !slices.ContainsFunc(output, func(v types.ResourceTagMapping) bool {
+			return aws.ToString(v.ResourceARN) == arn
+		}) {
+			output = append(output, types.ResourceTagMapping{
+				ResourceARN: aws.String(arn),
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// tagDrift represents the differences between a resource's desired and actual tags.
+type tagDrift struct {
+	resourceARN string
+	// Desired tags that the resource does not have.
+	missing tftags.KeyValueTags
+	// Desired tags whose values differ from the resource's.
+	changedDesired tftags.KeyValueTags
+	changedActual  tftags.KeyValueTags
+	// Resource tags that are not desired, if reported.
+	unexpected tftags.KeyValueTags
+}
+
+// newTagDrift returns the differences between a resource's desired and actual tags.
+// Resource tags that are not desired are only drift if reportUnexpected is set,
+// as resources commonly have tags that aren't managed centrally.
+func newTagDrift(resourceARN string, desiredTags, actualTags tftags.KeyValueTags, reportUnexpected bool) tagDrift {
+	changedDesired := actualTags.Updated(desiredTags).Only(actualTags)
+
+	drift := tagDrift{
+		resourceARN:    resourceARN,
+		missing:        desiredTags.Removed(actualTags),
+		changedDesired: changedDesired,
+		changedActual:  actualTags.Only(changedDesired),
+	}
+
+	if reportUnexpected {
+		drift.unexpected = actualTags.Removed(desiredTags)
+	}
+
+	return drift
+}
+
+func (d tagDrift) hasDrift() bool {
+	return len(d.missing) > 0 || len(d.changedDesired) > 0 || len(d.unexpected) > 0
+}
+
+func flattenTagDrifts(apiObjects []tagDrift) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		changedTags := make([]interface{}, 0, len(apiObject.changedDesired))
+		actual := apiObject.changedActual.Map()
+		desired := apiObject.changedDesired.Map()
+
+		keys := apiObject.changedDesired.Keys()
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			changedTags = append(changedTags, map[string]interface{}{
+				"actual_value":  actual[k],
+				"desired_value": desired[k],
+				names.AttrKey:   k,
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"changed_tags":        changedTags,
+			"missing_tags":        apiObject.missing.Map(),
+			names.AttrResourceARN: apiObject.resourceARN,
+			"unexpected_tags":     apiObject.unexpected.Map(),
+		})
+	}
+
+	return tfList
+}
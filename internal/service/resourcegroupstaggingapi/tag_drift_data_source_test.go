@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITagDriftDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_drift.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagDriftDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_drift", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "drift.0.resource_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.changed_tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.changed_tags.0.key", "Environment"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.changed_tags.0.actual_value", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.changed_tags.0.desired_value", "production"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.missing_tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.missing_tags.Owner", "platform"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.unexpected_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagDriftDataSource_reportUnexpectedTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_drift.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagDriftDataSourceConfig_reportUnexpectedTags(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_drift", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.#", "0"),
+				),
+			},
+			{
+				Config: testAccTagDriftDataSourceConfig_reportUnexpectedTags(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_drift", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "drift.0.resource_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.changed_tags.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.missing_tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.unexpected_tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.0.unexpected_tags.Extra", "value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagDriftDataSource_noDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_drift.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagDriftDataSourceConfig_noDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_drift", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "drift.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagDriftDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name        = %[1]q
+    Environment = "test"
+    Extra       = "value"
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_drift" "test" {
+  resource_arn_list = [aws_vpc.test.arn]
+
+  desired_tags = {
+    Name        = %[1]q
+    Environment = "production"
+    Owner       = "platform"
+  }
+}
+`, rName)
+}
+
+func testAccTagDriftDataSourceConfig_noDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_drift" "test" {
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+
+  desired_tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccTagDriftDataSourceConfig_reportUnexpectedTags(rName string, reportUnexpectedTags bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name  = %[1]q
+    Extra = "value"
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_drift" "test" {
+  resource_arn_list = [aws_vpc.test.arn]
+
+  desired_tags = {
+    Name = %[1]q
+  }
+
+  report_unexpected_tags = %[2]t
+}
+`, rName, reportUnexpectedTags)
+}
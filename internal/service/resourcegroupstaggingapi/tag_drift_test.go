@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestNewTagDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		desired            map[string]string
+		actual             map[string]string
+		reportUnexpected   bool
+		expectedHasDrift   bool
+		expectedMissing    map[string]string
+		expectedChanged    map[string]string
+		expectedActual     map[string]string
+		expectedUnexpected map[string]string
+	}{
+		"no tags": {},
+		"equal": {
+			desired: map[string]string{"key1": "value1"},
+			actual:  map[string]string{"key1": "value1"},
+		},
+		"missing": {
+			desired:          map[string]string{"key1": "value1", "key2": "value2"},
+			actual:           map[string]string{"key1": "value1"},
+			expectedHasDrift: true,
+			expectedMissing:  map[string]string{"key2": "value2"},
+		},
+		"changed": {
+			desired:          map[string]string{"key1": "value1"},
+			actual:           map[string]string{"key1": "value2"},
+			expectedHasDrift: true,
+			expectedChanged:  map[string]string{"key1": "value1"},
+			expectedActual:   map[string]string{"key1": "value2"},
+		},
+		"unexpected": {
+			actual: map[string]string{"key1": "value1"},
+		},
+		"unexpected reported": {
+			actual:             map[string]string{"key1": "value1"},
+			reportUnexpected:   true,
+			expectedHasDrift:   true,
+			expectedUnexpected: map[string]string{"key1": "value1"},
+		},
+		"all": {
+			desired:          map[string]string{"key1": "value1", "key2": "value2"},
+			actual:           map[string]string{"key2": "value3", "key3": "value3"},
+			expectedHasDrift: true,
+			expectedMissing:  map[string]string{"key1": "value1"},
+			expectedChanged:  map[string]string{"key2": "value2"},
+			expectedActual:   map[string]string{"key2": "value3"},
+		},
+		"all reported": {
+			desired:            map[string]string{"key1": "value1", "key2": "value2"},
+			actual:             map[string]string{"key2": "value3", "key3": "value3"},
+			reportUnexpected:   true,
+			expectedHasDrift:   true,
+			expectedMissing:    map[string]string{"key1": "value1"},
+			expectedChanged:    map[string]string{"key2": "value2"},
+			expectedActual:     map[string]string{"key2": "value3"},
+			expectedUnexpected: map[string]string{"key3": "value3"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newTagDrift("arn", tftags.New(ctx, testCase.desired), tftags.New(ctx, testCase.actual), testCase.reportUnexpected)
+
+			if got, want := got.hasDrift(), testCase.expectedHasDrift; got != want {
+				t.Errorf("hasDrift() = %t, want %t", got, want)
+			}
+
+			for _, v := range []struct {
+				name     string
+				got      tftags.KeyValueTags
+				expected map[string]string
+			}{
+				{"missing", got.missing, testCase.expectedMissing},
+				{"changedDesired", got.changedDesired, testCase.expectedChanged},
+				{"changedActual", got.changedActual, testCase.expectedActual},
+				{"unexpected", got.unexpected, testCase.expectedUnexpected},
+			} {
+				want := v.expected
+				if want == nil {
+					want = map[string]string{}
+				}
+
+				if diff := cmp.Diff(v.got.Map(), want); diff != "" {
+					t.Errorf("unexpected %s diff (+want, -got): %s", v.name, diff)
+				}
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ var (
 	ResourceKeySigningKey               = resourceKeySigningKey
 	ResourceQueryLog                    = resourceQueryLog
 	ResourceRecord                      = resourceRecord
+	ResourceRecordsExclusive            = newRecordsExclusiveResource
 	ResourceTrafficPolicy               = resourceTrafficPolicy
 	ResourceTrafficPolicyInstance       = resourceTrafficPolicyInstance
 	ResourceVPCAssociationAuthorization = resourceVPCAssociationAuthorization
@@ -24,6 +25,8 @@ var (
 	FindCIDRCollectionByID                      = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey                = findCIDRLocationByTwoPartKey
 	FindDelegationSetByID                       = findDelegationSetByID
+	BatchResourceRecordSetChanges               = batchResourceRecordSetChanges
+	FindExclusiveResourceRecordSetsByZoneID     = findExclusiveResourceRecordSetsByZoneID
 	FindHealthCheckByID                         = findHealthCheckByID
 	FindHostedZoneByID                          = findHostedZoneByID
 	FindHostedZoneDNSSECByZoneID                = findHostedZoneDNSSECByZoneID
@@ -37,6 +40,7 @@ var (
 	KeySigningKeyStatusActive                   = keySigningKeyStatusActive
 	KeySigningKeyStatusInactive                 = keySigningKeyStatusInactive
 	RecordParseResourceID                       = recordParseResourceID
+	ResourceRecordSetsEqual                     = resourceRecordSetsEqual
 	ServeSignatureNotSigning                    = serveSignatureNotSigning
 	ServeSignatureSigning                       = serveSignatureSigning
 	WaitChangeInsync                            = waitChangeInsync
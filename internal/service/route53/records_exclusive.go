@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
+func newRecordsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &recordsExclusiveResource{}, nil
+}
+
+type recordsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*recordsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53_records_exclusive"
+}
+
+func (r *recordsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_record_set": schema.SetNestedAttribute{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[resourceRecordSetModel](ctx),
+				Required:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alias_target": schema.SingleNestedAttribute{
+							CustomType: fwtypes.NewObjectTypeOf[aliasTargetModel](ctx),
+							Optional:   true,
+							Attributes: map[string]schema.Attribute{
+								names.AttrDNSName: schema.StringAttribute{
+									Required: true,
+								},
+								"evaluate_target_health": schema.BoolAttribute{
+									Required: true,
+								},
+								names.AttrHostedZoneID: schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						"cidr_routing_config": schema.SingleNestedAttribute{
+							CustomType: fwtypes.NewObjectTypeOf[cidrRoutingConfigModel](ctx),
+							Optional:   true,
+							Attributes: map[string]schema.Attribute{
+								"collection_id": schema.StringAttribute{
+									Required: true,
+								},
+								"location_name": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						"failover": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
+							Optional:   true,
+						},
+						"geolocation": schema.SingleNestedAttribute{
+							CustomType: fwtypes.NewObjectTypeOf[geoLocationModel](ctx),
+							Optional:   true,
+							Attributes: map[string]schema.Attribute{
+								"continent_code": schema.StringAttribute{
+									Optional: true,
+								},
+								"country_code": schema.StringAttribute{
+									Optional: true,
+								},
+								"subdivision_code": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						"geoproximity_location": schema.SingleNestedAttribute{
+							CustomType: fwtypes.NewObjectTypeOf[geoProximityLocationModel](ctx),
+							Optional:   true,
+							Attributes: map[string]schema.Attribute{
+								"aws_region": schema.StringAttribute{
+									Optional: true,
+								},
+								"bias": schema.Int64Attribute{
+									Optional: true,
+								},
+								"coordinates": schema.SingleNestedAttribute{
+									CustomType: fwtypes.NewObjectTypeOf[coordinatesModel](ctx),
+									Optional:   true,
+									Attributes: map[string]schema.Attribute{
+										"latitude": schema.StringAttribute{
+											Required: true,
+										},
+										"longitude": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+								"local_zone_group": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						"health_check_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"multi_value_answer": schema.BoolAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrRegion: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
+							Optional:   true,
+						},
+						"resource_records": schema.ListNestedAttribute{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[resourceRecordModel](ctx),
+							Optional:   true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"set_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"traffic_policy_instance_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+							Required:   true,
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *recordsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var want []awstypes.ResourceRecordSet
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.ResourceRecordSets, &want)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	hostedZoneID := fwflex.StringValueFromFramework(ctx, data.ZoneID)
+	if err := syncResourceRecordSets(ctx, conn, hostedZoneID, want); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Records Exclusive (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var old []awstypes.ResourceRecordSet
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.ResourceRecordSets, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	hostedZoneID := fwflex.StringValueFromFramework(ctx, data.ZoneID)
+	output, err := findExclusiveResourceRecordSetsByZoneID(ctx, conn, hostedZoneID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records Exclusive (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	// Retain configured domain names where they differ from the API's representation only in formatting.
+	for i, v := range output {
+		if j := slices.IndexFunc(old, func(o awstypes.ResourceRecordSet) bool {
+			return resourceRecordSetKey(o) == resourceRecordSetKey(v)
+		}); j != -1 {
+			output[i].Name = old[j].Name
+			if v.AliasTarget != nil && old[j].AliasTarget != nil && normalizeAliasDomainName(v.AliasTarget.DNSName) == normalizeAliasDomainName(old[j].AliasTarget.DNSName) {
+				output[i].AliasTarget.DNSName = old[j].AliasTarget.DNSName
+			}
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ResourceRecordSets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.ResourceRecordSets.Equal(old.ResourceRecordSets) {
+		var want []awstypes.ResourceRecordSet
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.ResourceRecordSets, &want)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		conn := r.Meta().Route53Client(ctx)
+
+		hostedZoneID := fwflex.StringValueFromFramework(ctx, new.ZoneID)
+		if err := syncResourceRecordSets(ctx, conn, hostedZoneID, want); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Route 53 Records Exclusive (%s)", hostedZoneID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recordsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), request, response)
+}
+
+// syncResourceRecordSets handles keeping the configured resource record sets
+// in sync with the hosted zone.
+//
+// Resource record sets defined on this resource but not present in the hosted zone,
+// or which differ from those present, will be upserted. Resource record sets present in the
+// hosted zone but not configured on this resource will be deleted.
+// The NS and SOA records at the zone apex are not managed.
+func syncResourceRecordSets(ctx context.Context, conn *route53.Client, hostedZoneID string, want []awstypes.ResourceRecordSet) error {
+	zone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", hostedZoneID, err)
+	}
+
+	hostedZoneName := aws.ToString(zone.HostedZone.Name)
+	for _, v := range want {
+		if isZoneApexNSOrSOARecord(&v, hostedZoneName) {
+			return fmt.Errorf("%s record at the zone apex (%s) cannot be managed", v.Type, aws.ToString(v.Name))
+		}
+	}
+
+	have, err := findExclusiveResourceRecordSets(ctx, conn, hostedZoneID, hostedZoneName)
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone (%s) resource record sets: %w", hostedZoneID, err)
+	}
+
+	var deletes, upserts []awstypes.Change
+	for _, v := range have {
+		if !slices.ContainsFunc(want, func(w awstypes.ResourceRecordSet) bool {
+			return resourceRecordSetKey(w) == resourceRecordSetKey(v)
+		}) {
+			deletes = append(deletes, awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: &v,
+			})
+		}
+	}
+	for _, v := range want {
+		if !slices.ContainsFunc(have, func(h awstypes.ResourceRecordSet) bool {
+			return resourceRecordSetsEqual(h, v)
+		}) {
+			upserts = append(upserts, awstypes.Change{
+				Action:            awstypes.ChangeActionUpsert,
+				ResourceRecordSet: &v,
+			})
+		}
+	}
+
+	for _, batch := range batchResourceRecordSetChanges(append(deletes, upserts...), resourceRecordSetChangesMaxBatchSize) {
+		if err := changeResourceRecordSetsInBatches(ctx, conn, hostedZoneID, batch, "Managed by Terraform"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Maximum number of changes in a single ChangeResourceRecordSets request.
+	resourceRecordSetChangesMaxBatchSize = 100
+)
+
+// batchResourceRecordSetChanges groups the changes to resource record sets by record name, with deletions first,
+// and packs the groups into batches of at most maxBatchSize changes.
+// A group is only split if it is larger than a batch, so that each record name is changed atomically:
+// a record is never deleted in one batch and recreated, e.g. with a different routing policy, in a later one.
+func batchResourceRecordSetChanges(changes []awstypes.Change, maxBatchSize int) [][]awstypes.Change {
+	var recordNames []string
+	groups := make(map[string][]awstypes.Change)
+	for _, v := range changes {
+		name := normalizeDomainName(v.ResourceRecordSet.Name)
+		if _, ok := groups[name]; !ok {
+			recordNames = append(recordNames, name)
+		}
+		groups[name] = append(groups[name], v)
+	}
+
+	var batches [][]awstypes.Change
+	var batch []awstypes.Change
+	for _, name := range recordNames {
+		group := groups[name]
+		slices.SortStableFunc(group, func(a, b awstypes.Change) int {
+			return cmp.Compare(changeActionOrder(a.Action), changeActionOrder(b.Action))
+		})
+
+		if len(batch)+len(group) > maxBatchSize && len(batch) > 0 {
+			batches = append(batches, batch)
+			batch = nil
+		}
+
+		for chunk := range slices.Chunk(group, maxBatchSize) {
+			if len(batch)+len(chunk) > maxBatchSize {
+				batches = append(batches, batch)
+				batch = nil
+			}
+			batch = append(batch, chunk...)
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func changeActionOrder(action awstypes.ChangeAction) int {
+	if action == awstypes.ChangeActionDelete {
+		return 0
+	}
+	return 1
+}
+
+func findExclusiveResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Client, hostedZoneID string) ([]awstypes.ResourceRecordSet, error) {
+	zone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findExclusiveResourceRecordSets(ctx, conn, hostedZoneID, aws.ToString(zone.HostedZone.Name))
+}
+
+// findExclusiveResourceRecordSets returns the hosted zone's resource record sets,
+// excluding the NS and SOA records at the zone apex.
+func findExclusiveResourceRecordSets(ctx context.Context, conn *route53.Client, hostedZoneID, hostedZoneName string) ([]awstypes.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	return findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		return !isZoneApexNSOrSOARecord(v, hostedZoneName)
+	})
+}
+
+// resourceRecordSetKey returns the values that uniquely identify a resource record set in a hosted zone.
+func resourceRecordSetKey(v awstypes.ResourceRecordSet) string {
+	return strings.Join([]string{normalizeDomainName(v.Name), string(v.Type), aws.ToString(v.SetIdentifier)}, "_")
+}
+
+// resourceRecordSetsEqual returns whether the resource record sets are equivalent,
+// ignoring domain name formatting and the order of resource records.
+func resourceRecordSetsEqual(v1, v2 awstypes.ResourceRecordSet) bool {
+	normalize := func(v awstypes.ResourceRecordSet) awstypes.ResourceRecordSet {
+		v.Name = aws.String(normalizeDomainName(v.Name))
+		if v.AliasTarget != nil {
+			aliasTarget := *v.AliasTarget
+			aliasTarget.DNSName = aws.String(normalizeAliasDomainName(aliasTarget.DNSName))
+			aliasTarget.HostedZoneId = aws.String(cleanZoneID(aws.ToString(aliasTarget.HostedZoneId)))
+			v.AliasTarget = &aliasTarget
+		}
+		if len(v.ResourceRecords) == 0 {
+			v.ResourceRecords = nil
+		} else {
+			v.ResourceRecords = slices.SortedFunc(slices.Values(v.ResourceRecords), func(a, b awstypes.ResourceRecord) int {
+				return strings.Compare(aws.ToString(a.Value), aws.ToString(b.Value))
+			})
+		}
+		return v
+	}
+
+	return reflect.DeepEqual(normalize(v1), normalize(v2))
+}
+
+type recordsExclusiveResourceModel struct {
+	ResourceRecordSets fwtypes.SetNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_set"`
+	ZoneID             types.String                                           `tfsdk:"zone_id"`
+}
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceRecordSetsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		v1, v2 awstypes.ResourceRecordSet
+		want   bool
+	}{
+		"equal": {
+			v1:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(300), ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.1")}}},
+			v2:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(300), ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.1")}}},
+			want: true,
+		},
+		"name formatting": {
+			v1:   awstypes.ResourceRecordSet{Name: aws.String("\\052.example.com."), Type: awstypes.RRTypeA},
+			v2:   awstypes.ResourceRecordSet{Name: aws.String("*.Example.com"), Type: awstypes.RRTypeA},
+			want: true,
+		},
+		"resource record order": {
+			v1:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.1")}, {Value: aws.String("127.0.0.2")}}},
+			v2:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.2")}, {Value: aws.String("127.0.0.1")}}},
+			want: true,
+		},
+		"alias formatting": {
+			v1:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, AliasTarget: &awstypes.AliasTarget{DNSName: aws.String("lb.example.net."), HostedZoneId: aws.String("Z123")}},
+			v2:   awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, AliasTarget: &awstypes.AliasTarget{DNSName: aws.String("LB.example.net"), HostedZoneId: aws.String("/hostedzone/Z123")}},
+			want: true,
+		},
+		"different TTL": {
+			v1: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, TTL: aws.Int64(300)},
+			v2: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, TTL: aws.Int64(60)},
+		},
+		"different values": {
+			v1: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.1")}}},
+			v2: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.2")}}},
+		},
+		"different set identifier": {
+			v1: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, SetIdentifier: aws.String("one"), Weight: aws.Int64(1)},
+			v2: awstypes.ResourceRecordSet{Name: aws.String("www.example.com"), Type: awstypes.RRTypeA, SetIdentifier: aws.String("two"), Weight: aws.Int64(1)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfroute53.ResourceRecordSetsEqual(testCase.v1, testCase.v2), testCase.want; got != want {
+				t.Errorf("ResourceRecordSetsEqual() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestBatchResourceRecordSetChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action awstypes.ChangeAction, name, setIdentifier string) awstypes.Change {
+		v := awstypes.Change{
+			Action:            action,
+			ResourceRecordSet: &awstypes.ResourceRecordSet{Name: aws.String(name), Type: awstypes.RRTypeA},
+		}
+		if setIdentifier != "" {
+			v.ResourceRecordSet.SetIdentifier = aws.String(setIdentifier)
+		}
+		return v
+	}
+	key := func(v awstypes.Change) string {
+		return fmt.Sprintf("%s %s %s", v.Action, aws.ToString(v.ResourceRecordSet.Name), aws.ToString(v.ResourceRecordSet.SetIdentifier))
+	}
+
+	testCases := map[string]struct {
+		changes      []awstypes.Change
+		maxBatchSize int
+		want         [][]string
+	}{
+		"empty": {
+			maxBatchSize: 2,
+		},
+		"deletions first": {
+			changes: []awstypes.Change{
+				change(awstypes.ChangeActionDelete, "a.example.com", "one"),
+				change(awstypes.ChangeActionDelete, "a.example.com", "two"),
+				change(awstypes.ChangeActionDelete, "b.example.com", ""),
+				change(awstypes.ChangeActionUpsert, "a.example.com", ""),
+			},
+			maxBatchSize: 100,
+			want: [][]string{
+				{"DELETE a.example.com one", "DELETE a.example.com two", "UPSERT a.example.com ", "DELETE b.example.com "},
+			},
+		},
+		"record name not split": {
+			changes: []awstypes.Change{
+				change(awstypes.ChangeActionDelete, "a.example.com", ""),
+				change(awstypes.ChangeActionDelete, "b.example.com", "one"),
+				change(awstypes.ChangeActionDelete, "b.example.com", "two"),
+				change(awstypes.ChangeActionUpsert, "B.example.com.", ""),
+			},
+			maxBatchSize: 3,
+			want: [][]string{
+				{"DELETE a.example.com "},
+				{"DELETE b.example.com one", "DELETE b.example.com two", "UPSERT B.example.com. "},
+			},
+		},
+		"record name larger than batch": {
+			changes: []awstypes.Change{
+				change(awstypes.ChangeActionUpsert, "a.example.com", "three"),
+				change(awstypes.ChangeActionDelete, "a.example.com", "one"),
+				change(awstypes.ChangeActionDelete, "a.example.com", "two"),
+			},
+			maxBatchSize: 2,
+			want: [][]string{
+				{"DELETE a.example.com one", "DELETE a.example.com two"},
+				{"UPSERT a.example.com three"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got [][]string
+			for _, batch := range tfroute53.BatchResourceRecordSetChanges(testCase.changes, testCase.maxBatchSize) {
+				got = append(got, tfslices.ApplyToAll(batch, key))
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("BatchResourceRecordSetChanges() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName:       "www." + zoneName.String(),
+						names.AttrType:       "A",
+						"ttl":                "300",
+						"resource_records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName:       "mail." + zoneName.String(),
+						names.AttrType:       "TXT",
+						"ttl":                "60",
+						"resource_records.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "zone_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_weighted(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName:   "www." + zoneName.String(),
+						"set_identifier": "blue",
+						names.AttrWeight: "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName:   "www." + zoneName.String(),
+						"set_identifier": "green",
+						names.AttrWeight: "10",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_empty(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					testAccCheckRecordsExclusiveAddRecord(ctx, resourceName, "extra."+zoneName.String()),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRecordsExclusiveCount checks the number of resource record sets in the hosted zone,
+// excluding the NS and SOA records at the zone apex.
+func testAccCheckRecordsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		output, err := tfroute53.FindExclusiveResourceRecordSetsByZoneID(ctx, conn, rs.Primary.Attributes["zone_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Route 53 Hosted Zone (%s) has %d resource record sets, want %d", rs.Primary.Attributes["zone_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveAddRecord(ctx context.Context, n, recordName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: []awstypes.Change{{
+					Action: awstypes.ChangeActionCreate,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name:            aws.String(recordName),
+						Type:            awstypes.RRTypeA,
+						TTL:             aws.Int64(30),
+						ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("127.0.0.99")}},
+					},
+				}},
+			},
+			HostedZoneId: aws.String(rs.Primary.Attributes["zone_id"]),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfroute53.WaitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id))
+
+		return err
+	}
+}
+
+func testAccRecordsExclusiveConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), `
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set = [
+    {
+      name = "www.${aws_route53_zone.test.name}"
+      type = "A"
+      ttl  = 300
+      resource_records = [
+        { value = "127.0.0.1" },
+        { value = "127.0.0.2" },
+      ]
+    },
+    {
+      name = "mail.${aws_route53_zone.test.name}"
+      type = "TXT"
+      ttl  = 60
+      resource_records = [
+        { value = "\"v=spf1 -all\"" },
+      ]
+    },
+  ]
+}
+`)
+}
+
+func testAccRecordsExclusiveConfig_weighted(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), `
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set = [
+    {
+      name           = "www.${aws_route53_zone.test.name}"
+      type           = "A"
+      ttl            = 300
+      set_identifier = "blue"
+      weight         = 90
+      resource_records = [
+        { value = "127.0.0.1" },
+      ]
+    },
+    {
+      name           = "www.${aws_route53_zone.test.name}"
+      type           = "A"
+      ttl            = 300
+      set_identifier = "green"
+      weight         = 10
+      resource_records = [
+        { value = "127.0.0.2" },
+      ]
+    },
+  ]
+}
+`)
+}
+
+func testAccRecordsExclusiveConfig_empty(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), `
+resource "aws_route53_records_exclusive" "test" {
+  zone_id             = aws_route53_zone.test.zone_id
+  resource_record_set = []
+}
+`)
+}
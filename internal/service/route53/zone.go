@@ -373,10 +373,7 @@ func deleteAllResourceRecordsFromHostedZone(ctx context.Context, conn *route53.C
 
 	resourceRecordSets, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
 		// Zone NS & SOA records cannot be deleted.
-		if normalizeDomainName(v.Name) == normalizeDomainName(hostedZoneName) && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa) {
-			return false
-		}
-		return true
+		return !isZoneApexNSOrSOARecord(v, hostedZoneName)
 	})
 
 	if tfresource.NotFound(err) {
@@ -387,20 +384,38 @@ func deleteAllResourceRecordsFromHostedZone(ctx context.Context, conn *route53.C
 		return fmt.Errorf("reading Route53 Hosted Zone (%s) resource record sets: %w", hostedZoneID, err)
 	}
 
+	changes := tfslices.ApplyToAll(resourceRecordSets, func(v awstypes.ResourceRecordSet) awstypes.Change {
+		return awstypes.Change{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: &v,
+		}
+	})
+
+	if err := changeResourceRecordSetsInBatches(ctx, conn, hostedZoneID, changes, "Deleted by Terraform"); err != nil {
+		return fmt.Errorf("deleting Route53 Hosted Zone (%s) resource record sets: %w", hostedZoneID, err)
+	}
+
+	return nil
+}
+
+// isZoneApexNSOrSOARecord returns whether the resource record set is one of the NS or SOA records
+// created by Route 53 at the apex of the hosted zone.
+func isZoneApexNSOrSOARecord(v *awstypes.ResourceRecordSet, hostedZoneName string) bool {
+	return normalizeDomainName(v.Name) == normalizeDomainName(hostedZoneName) && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa)
+}
+
+// changeResourceRecordSetsInBatches applies the changes to the hosted zone's resource record sets in batches,
+// waiting for each batch to synchronize before applying the next.
+// Changes are applied in the order given.
+func changeResourceRecordSetsInBatches(ctx context.Context, conn *route53.Client, hostedZoneID string, changes []awstypes.Change, comment string) error {
 	const (
 		chunkSize = 100
 	)
-	for chunk := range slices.Chunk(resourceRecordSets, chunkSize) {
-		changes := tfslices.ApplyToAll(chunk, func(v awstypes.ResourceRecordSet) awstypes.Change {
-			return awstypes.Change{
-				Action:            awstypes.ChangeActionDelete,
-				ResourceRecordSet: &v,
-			}
-		})
+	for chunk := range slices.Chunk(changes, chunkSize) {
 		input := &route53.ChangeResourceRecordSetsInput{
 			ChangeBatch: &awstypes.ChangeBatch{
-				Changes: changes,
-				Comment: aws.String("Deleted by Terraform"),
+				Changes: chunk,
+				Comment: aws.String(comment),
 			},
 			HostedZoneId: aws.String(hostedZoneID),
 		}
@@ -412,7 +427,7 @@ func deleteAllResourceRecordsFromHostedZone(ctx context.Context, conn *route53.C
 		}
 
 		if err != nil {
-			return fmt.Errorf("deleting Route53 Hosted Zone (%s) resource record sets: %w", hostedZoneID, err)
+			return err
 		}
 
 		if output.ChangeInfo != nil {
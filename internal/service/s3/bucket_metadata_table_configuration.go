@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_metadata_table_configuration", name="Bucket Metadata Table Configuration")
+func newBucketMetadataTableConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketMetadataTableConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type bucketMetadataTableConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[bucketMetadataTableConfigurationResourceModel] // There is no API to update the configuration.
+	framework.WithTimeouts
+}
+
+func (r *bucketMetadataTableConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket_metadata_table_configuration"
+}
+
+func (r *bucketMetadataTableConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata_table_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metadataTableConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_tables_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TablesDestinationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"table_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"table_bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"table_namespace": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *bucketMetadataTableConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	var input s3.CreateBucketMetadataTableConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (any, error) {
+		return conn.CreateBucketMetadataTableConfiguration(ctx, &input)
+	}, errCodeNoSuchBucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Metadata Table Configuration", bucket), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(createResourceID(bucket, expectedBucketOwner))
+
+	output, err := waitBucketMetadataTableConfigurationCreated(ctx, conn, bucket, expectedBucketOwner, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		// Set 'id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root(names.AttrBucket), data.Bucket)
+		response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), data.ExpectedBucketOwner)
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Metadata Table Configuration create", bucket), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataTableConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	output, err := findBucketMetadataTableConfiguration(ctx, conn, data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Metadata Table Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataTableConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	input := s3.DeleteBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketMetadataTableConfiguration(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) || tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket Metadata Table Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (any, error) {
+		return findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket Metadata Table Configuration (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketMetadataTableConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, err := parseResourceID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+}
+
+func findBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	input := s3.GetBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketMetadataTableConfiguration(ctx, &input)
+
+	// A bucket with no metadata table configuration returns an HTTP 404 error.
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) || tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GetBucketMetadataTableConfigurationResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GetBucketMetadataTableConfigurationResult, nil
+}
+
+const (
+	bucketMetadataTableConfigurationStatusActive   = "ACTIVE"
+	bucketMetadataTableConfigurationStatusCreating = "CREATING"
+)
+
+var bucketMetadataTableConfigurationWaiter = tfresource.NewStateWaiter("S3 Bucket Metadata Table Configuration", func(v *awstypes.GetBucketMetadataTableConfigurationResult) string {
+	return aws.ToString(v.Status)
+}, tfresource.WithFailureReason(func(v *awstypes.GetBucketMetadataTableConfigurationResult) error {
+	if v.Error == nil {
+		return nil
+	}
+
+	return errors.New(aws.ToString(v.Error.ErrorCode) + ": " + aws.ToString(v.Error.ErrorMessage))
+}))
+
+func waitBucketMetadataTableConfigurationCreated(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, timeout time.Duration) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	return bucketMetadataTableConfigurationWaiter.WaitFor(ctx, func(ctx context.Context) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+		return findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	}, []string{bucketMetadataTableConfigurationStatusCreating}, []string{bucketMetadataTableConfigurationStatusActive}, timeout)
+}
+
+type bucketMetadataTableConfigurationResourceModel struct {
+	Bucket                     types.String                                                     `tfsdk:"bucket"`
+	ExpectedBucketOwner        types.String                                                     `tfsdk:"expected_bucket_owner"`
+	ID                         types.String                                                     `tfsdk:"id"`
+	MetadataTableConfiguration fwtypes.ListNestedObjectValueOf[metadataTableConfigurationModel] `tfsdk:"metadata_table_configuration"`
+	Status                     types.String                                                     `tfsdk:"status"`
+	Timeouts                   timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+// flatten sets the model's computed values from the API's metadata table configuration result,
+// whose structure doesn't match the configuration's.
+func (m *bucketMetadataTableConfigurationResourceModel) flatten(ctx context.Context, apiObject *awstypes.GetBucketMetadataTableConfigurationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.MetadataTableConfiguration, diags = flattenMetadataTableConfigurationResult(ctx, apiObject.MetadataTableConfigurationResult)
+	m.Status = fwflex.StringToFramework(ctx, apiObject.Status)
+
+	return diags
+}
+
+func flattenMetadataTableConfigurationResult(ctx context.Context, apiObject *awstypes.MetadataTableConfigurationResult) (fwtypes.ListNestedObjectValueOf[metadataTableConfigurationModel], diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	var diags diag.Diagnostics
+
+	if apiObject == nil || apiObject.S3TablesDestinationResult == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[metadataTableConfigurationModel](ctx), diags
+	}
+
+	v := apiObject.S3TablesDestinationResult
+	destination := &s3TablesDestinationModel{
+		TableARN:       fwflex.StringToFramework(ctx, v.TableArn),
+		TableBucketARN: fwtypes.ARNValue(aws.ToString(v.TableBucketArn)),
+		TableName:      fwflex.StringToFramework(ctx, v.TableName),
+		TableNamespace: fwflex.StringToFramework(ctx, v.TableNamespace),
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &metadataTableConfigurationModel{
+		S3TablesDestination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, destination),
+	}), diags
+}
+
+type metadataTableConfigurationModel struct {
+	S3TablesDestination fwtypes.ListNestedObjectValueOf[s3TablesDestinationModel] `tfsdk:"s3_tables_destination"`
+}
+
+type s3TablesDestinationModel struct {
+	TableARN       types.String `tfsdk:"table_arn"`
+	TableBucketARN fwtypes.ARN  `tfsdk:"table_bucket_arn"`
+	TableName      types.String `tfsdk:"table_name"`
+	TableNamespace types.String `tfsdk:"table_namespace"`
+}
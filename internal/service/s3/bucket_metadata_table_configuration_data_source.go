@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3_bucket_metadata_table_configuration", name="Bucket Metadata Table Configuration")
+func newBucketMetadataTableConfigurationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &bucketMetadataTableConfigurationDataSource{}
+
+	return d, nil
+}
+
+type bucketMetadataTableConfigurationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *bucketMetadataTableConfigurationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket_metadata_table_configuration"
+}
+
+func (d *bucketMetadataTableConfigurationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID:                   framework.IDAttribute(),
+			"metadata_table_configuration": framework.DataSourceComputedListOfObjectAttribute[metadataTableConfigurationModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *bucketMetadataTableConfigurationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data bucketMetadataTableConfigurationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	output, err := findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Metadata Table Configuration", bucket), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(createResourceID(bucket, expectedBucketOwner))
+	metadataTableConfiguration, diags := flattenMetadataTableConfigurationResult(ctx, output.MetadataTableConfigurationResult)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.MetadataTableConfiguration = metadataTableConfiguration
+	data.Status = fwflex.StringToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type bucketMetadataTableConfigurationDataSourceModel struct {
+	Bucket                     types.String                                                     `tfsdk:"bucket"`
+	ExpectedBucketOwner        types.String                                                     `tfsdk:"expected_bucket_owner"`
+	ID                         types.String                                                     `tfsdk:"id"`
+	MetadataTableConfiguration fwtypes.ListNestedObjectValueOf[metadataTableConfigurationModel] `tfsdk:"metadata_table_configuration"`
+	Status                     types.String                                                     `tfsdk:"status"`
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetadataTableConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.GetBucketMetadataTableConfigurationResult
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_metadata_table_configuration.test"
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrBucket, resourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_table_configuration.0.s3_tables_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_arn", resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_bucket_arn", resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_bucket_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_name", resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_namespace", resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_namespace"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+				),
+			},
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationDeleteTable(ctx, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketMetadataTableConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetadataTableConfigurationConfig_basic(rName), `
+data "aws_s3_bucket_metadata_table_configuration" "test" {
+  bucket = aws_s3_bucket_metadata_table_configuration.test.bucket
+}
+`)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetadataTableConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.GetBucketMetadataTableConfigurationResult
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3_tables_destination.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_name", "test_table"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_namespace"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				// The metadata table outlives the configuration and must be removed before the table bucket can be destroyed.
+				Config: testAccBucketMetadataTableConfigurationConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationDeleteTable(ctx, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetadataTableConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.GetBucketMetadataTableConfigurationResult
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketMetadataTableConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationDeleteTable(ctx, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketMetadataTableConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_metadata_table_configuration" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketMetadataTableConfiguration(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Metadata Table Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketMetadataTableConfigurationExists(ctx context.Context, n string, v *types.GetBucketMetadataTableConfigurationResult) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketMetadataTableConfiguration(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBucketMetadataTableConfigurationDeleteTable(ctx context.Context, v *types.GetBucketMetadataTableConfigurationResult) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.MetadataTableConfigurationResult == nil || v.MetadataTableConfigurationResult.S3TablesDestinationResult == nil {
+			return nil
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+		destination := v.MetadataTableConfigurationResult.S3TablesDestinationResult
+
+		_, err := conn.DeleteTable(ctx, &s3tables.DeleteTableInput{
+			Name:           destination.TableName,
+			Namespace:      destination.TableNamespace,
+			TableBucketARN: destination.TableBucketArn,
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Tables Table (%s): %w", aws.ToString(destination.TableArn), err)
+		}
+
+		_, err = conn.DeleteNamespace(ctx, &s3tables.DeleteNamespaceInput{
+			Namespace:      destination.TableNamespace,
+			TableBucketARN: destination.TableBucketArn,
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Tables Namespace (%s): %w", aws.ToString(destination.TableNamespace), err)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketMetadataTableConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketMetadataTableConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetadataTableConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_metadata_table_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  metadata_table_configuration {
+    s3_tables_destination {
+      table_bucket_arn = aws_s3tables_table_bucket.test.arn
+      table_name       = "test_table"
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,616 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum number of objects in a single DeleteObjects request.
+	deleteObjectsMaxObjects = 1000
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func resourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checksum_algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ChecksumAlgorithm](),
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: glob.ValidatePattern,
+				},
+			},
+			"file_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrContentType: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: glob.ValidatePattern,
+						},
+					},
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: glob.ValidatePattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+
+	if err := syncDirectory(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", bucket, err)
+	}
+
+	d.SetId(strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := directorySyncClient(ctx, d, meta)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	etags, err := findObjectETagsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects whose ETag no longer matches the one recorded when the object was uploaded
+	// have been modified outside of Terraform and are recorded with an empty hash.
+	// This includes objects that were not uploaded by this resource, which are then deleted or replaced.
+	oldFiles := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{}))
+	oldETags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+	files := make(map[string]string, len(etags))
+	for key, etag := range etags {
+		if hash, ok := oldFiles[key]; ok && oldETags[key] == etag {
+			files[key] = hash
+		} else {
+			files[key] = ""
+		}
+	}
+
+	d.Set("etags", etags)
+	d.Set("files", files)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncDirectory(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, _ := directorySyncClient(ctx, d, meta)
+
+	keys := slices.Collect(maps.Keys(d.Get("files").(map[string]interface{})))
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	if err := deleteObjectsByKey(ctx, conn, d.Get(names.AttrBucket).(string), keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceDirectorySyncCustomizeDiff plans the per-object changes from the contents of the local directory.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cache_control", "checksum_algorithm", "exclude", "file_rule", "include", names.AttrKMSKeyID, "source_dir", names.AttrStorageClass} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("etags"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("files")
+		}
+	}
+
+	localFiles, err := expandDirectorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(localFiles))
+	for _, v := range localFiles {
+		files[v.key] = v.hash
+	}
+
+	if oldFiles := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{})); d.Id() == "" || !maps.Equal(files, oldFiles) {
+		if err := d.SetNew("files", files); err != nil {
+			return err
+		}
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+func directorySyncClient(ctx context.Context, d sdkv2.ResourceDiffer, meta interface{}) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	var optFns []func(*s3.Options)
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == endpoints.AwsGlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+// syncDirectory uploads new and changed local files and deletes objects under the key prefix that have no local file.
+func syncDirectory(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn, optFns := directorySyncClient(ctx, d, meta)
+
+	localFiles, err := expandDirectorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	etags, err := findObjectETagsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	// The state's hashes and ETags are those recorded by the last apply.
+	o, _ := d.GetChange("files")
+	oldFiles := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	o, _ = d.GetChange("etags")
+	oldETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	var toUpload []directorySyncFile
+	for _, v := range localFiles {
+		if etag, ok := etags[v.key]; ok && oldFiles[v.key] == v.hash && oldETags[v.key] == etag {
+			continue
+		}
+		toUpload = append(toUpload, v)
+	}
+
+	var toDelete []string
+	for key := range etags {
+		if !slices.ContainsFunc(localFiles, func(v directorySyncFile) bool {
+			return v.key == key
+		}) {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Syncing S3 Directory (%s) to Bucket (%s): %d objects to upload, %d objects to delete", d.Get("source_dir").(string), bucket, len(toUpload), len(toDelete))
+
+	input := expandDirectorySyncPutObjectInput(d)
+	uploaded, err := uploadDirectorySyncFiles(ctx, conn, input, toUpload, d.Get("upload_concurrency").(int), optFns...)
+
+	// Record the objects that were uploaded, even if others failed.
+	maps.Copy(etags, uploaded)
+	files := make(map[string]string, len(localFiles))
+	for _, v := range localFiles {
+		if _, ok := etags[v.key]; ok {
+			files[v.key] = v.hash
+		}
+	}
+	for _, v := range toUpload {
+		if _, ok := uploaded[v.key]; !ok {
+			delete(files, v.key)
+		}
+	}
+	d.Set("etags", etags)
+	d.Set("files", files)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteObjectsByKey(ctx, conn, bucket, toDelete)
+}
+
+// directorySyncFile represents a local file to be synced.
+type directorySyncFile struct {
+	key          string
+	path         string
+	hash         string
+	cacheControl string
+	contentType  string
+}
+
+// expandDirectorySyncLocalFiles returns the files in the source directory that are selected by the include and exclude patterns.
+func expandDirectorySyncLocalFiles(d sdkv2.ResourceDiffer) ([]directorySyncFile, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	includes := flex.ExpandStringValueList(d.Get("include").([]interface{}))
+	excludes := flex.ExpandStringValueList(d.Get("exclude").([]interface{}))
+	rules := expandDirectorySyncFileRules(d.Get("file_rule").([]interface{}))
+	keyPrefix := d.Get("key_prefix").(string)
+
+	// Settings that apply to every object are part of each file's hash so that changes to them are planned per object.
+	objectSettings := strings.Join([]string{
+		d.Get("checksum_algorithm").(string),
+		d.Get(names.AttrKMSKeyID).(string),
+		d.Get(names.AttrStorageClass).(string),
+	}, "\n")
+	defaultCacheControl := d.Get("cache_control").(string)
+
+	var files []directorySyncFile
+
+	err = filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if !glob.Selected(rel, includes, excludes) {
+			return nil
+		}
+
+		file := directorySyncFile{
+			key:          keyPrefix + rel,
+			path:         p,
+			cacheControl: defaultCacheControl,
+			contentType:  mime.TypeByExtension(path.Ext(rel)),
+		}
+
+		// The first matching rule wins.
+		for _, rule := range rules {
+			if glob.Match(rule.pattern, rel) {
+				if rule.cacheControl != "" {
+					file.cacheControl = rule.cacheControl
+				}
+				if rule.contentType != "" {
+					file.contentType = rule.contentType
+				}
+				break
+			}
+		}
+
+		hash, err := hashFile(p, file.cacheControl, file.contentType, objectSettings)
+		if err != nil {
+			return err
+		}
+		file.hash = hash
+
+		files = append(files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+type directorySyncFileRule struct {
+	cacheControl string
+	contentType  string
+	pattern      string
+}
+
+func expandDirectorySyncFileRules(tfList []interface{}) []directorySyncFileRule {
+	var apiObjects []directorySyncFileRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, directorySyncFileRule{
+			cacheControl: tfMap["cache_control"].(string),
+			contentType:  tfMap[names.AttrContentType].(string),
+			pattern:      tfMap["pattern"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+// hashFile returns the hex encoded SHA-256 hash of the file's contents and the specified attributes.
+func hashFile(name string, attrs ...string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	for _, v := range attrs {
+		h.Write([]byte{0})
+		h.Write([]byte(v))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func expandDirectorySyncPutObjectInput(d *schema.ResourceData) s3.PutObjectInput {
+	input := s3.PutObjectInput{
+		Bucket: aws.String(d.Get(names.AttrBucket).(string)),
+	}
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = types.ChecksumAlgorithm(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk(names.AttrStorageClass); ok {
+		input.StorageClass = types.StorageClass(v.(string))
+	}
+
+	return input
+}
+
+// uploadDirectorySyncFiles uploads the files using the specified number of concurrent uploads.
+// Each upload is itself a parallel multipart upload for large files.
+// Returns the ETags of the uploaded objects, keyed by object key.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, template s3.PutObjectInput, files []directorySyncFile, concurrency int, optFns ...func(*s3.Options)) (map[string]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := make(chan directorySyncFile)
+	var mu sync.Mutex
+	var errs []error
+	etags := make(map[string]string, len(files))
+	var wg sync.WaitGroup
+
+	for range min(concurrency, len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for file := range ch {
+				etag, err := uploadDirectorySyncFile(ctx, conn, template, file, optFns...)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err)
+					cancel()
+				} else {
+					etags[file.key] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, file := range files {
+		if ctx.Err() != nil {
+			break
+		}
+		ch <- file
+	}
+	close(ch)
+	wg.Wait()
+
+	return etags, errors.Join(errs...)
+}
+
+func uploadDirectorySyncFile(ctx context.Context, conn *s3.Client, template s3.PutObjectInput, file directorySyncFile, optFns ...func(*s3.Options)) (string, error) {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return "", fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer func() {
+		err := body.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", file.path, err)
+		}
+	}()
+
+	input := template
+	input.Body = body
+	input.Key = aws.String(file.key)
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+	if file.contentType != "" {
+		input.ContentType = aws.String(file.contentType)
+	}
+
+	output, err := uploadObject(ctx, conn, &input, optFns...)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, aws.ToString(input.Bucket), err)
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// deleteObjectsByKey deletes the specified objects in batches.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	for chunk := range slices.Chunk(keys, deleteObjectsMaxObjects) {
+		toDelete := make([]types.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			toDelete = append(toDelete, types.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findObjectETagsByBucketAndPrefix returns the ETags of the objects under the specified prefix, keyed by object key.
+func findObjectETagsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
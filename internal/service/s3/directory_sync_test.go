@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"assets/app.js":  "console.log('app');",
+		"assets/app.map": "{}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/assets/app.js", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					testAccCheckDirectorySyncObjectHeaders(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectorySyncObjectHeaders(ctx, resourceName, "site/assets/app.js", "text/javascript; charset=utf-8", "max-age=3600"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+						"index.html":    "<html><body></body></html>",
+						"about.html":    "<html></html>",
+						"assets/app.js": "",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/about.html", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_outOfBandObject(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/index.html"),
+					testAccCheckDirectorySyncPutObject(ctx, rName, "site/index.html"),
+					testAccCheckDirectorySyncPutObject(ctx, rName, "site/extra.txt"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			output, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+			if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("S3 Directory Sync %s still has %d objects", rs.Primary.ID, len(output))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjects(ctx context.Context, n string, wantKeys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(wantKeys); got != want {
+			return fmt.Errorf("S3 Directory Sync %s has %d objects, want %d", rs.Primary.ID, got, want)
+		}
+
+		for _, key := range wantKeys {
+			if _, ok := output[key]; !ok {
+				return fmt.Errorf("S3 Directory Sync %s object %s not found", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectHeaders(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object %s Content-Type = %q, want %q", key, got, contentType)
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object %s Cache-Control = %q, want %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncPutObject(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.PutObjectInput{
+			Body:   strings.NewReader("out-of-band"),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.PutObject(ctx, input)
+
+		return err
+	}
+}
+
+func testAccDirectorySyncCreateTempDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+// testAccDirectorySyncWriteFiles writes the specified files to the directory.
+// A file with empty contents is removed.
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if contents == "" {
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  include = ["**/*.html", "**/*.js"]
+  exclude = ["**/*.map"]
+
+  cache_control = "max-age=3600"
+
+  file_rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+}
+`, rName, sourceDir)
+}
@@ -13,6 +13,7 @@ var (
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = newResourceBucketLifecycleConfiguration
 	ResourceBucketLogging                           = resourceBucketLogging
+	ResourceBucketMetadataTableConfiguration        = newBucketMetadataTableConfigurationResource
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
@@ -26,6 +27,7 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectorySync                           = resourceDirectorySync
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                      = bucketUpdateTags
@@ -33,11 +35,13 @@ var (
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
 	EmptyBucket                           = emptyBucket
+	ExpandIncompleteMultipartUpload       = expandIncompleteMultipartUpload
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
 	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
 	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
+	FindBucketMetadataTableConfiguration  = findBucketMetadataTableConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
 	FindBucketRequestPayment              = findBucketRequestPayment
@@ -49,15 +53,20 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectETagsByBucketAndPrefix      = findObjectETagsByBucketAndPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
 	FindReplicationConfiguration          = findReplicationConfiguration
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
+	CreateMultipartUploadInputHash        = createMultipartUploadInputHash
+	FlattenIncompleteMultipartUpload      = flattenIncompleteMultipartUpload
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	MultipartUploadPartSize               = multipartUploadPartSize
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
+	PartChecksum                          = partChecksum
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
 
@@ -65,6 +74,7 @@ var (
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
 	ErrCodeBucketAlreadyExists     = errCodeBucketAlreadyExists
 	ErrCodeBucketAlreadyOwnedByYou = errCodeBucketAlreadyOwnedByYou
+	ErrCodeNoSuchBucket            = errCodeNoSuchBucket
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
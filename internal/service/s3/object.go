@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -154,6 +155,22 @@ func resourceObject() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"incomplete_multipart_upload": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"settings_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"upload_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrKey: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -172,6 +189,38 @@ func resourceObject() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				ValidateFunc: validateMetadataIsLowerCase,
 			},
+			"multipart_upload": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultMultipartUploadConcurrency,
+							ValidateFunc: validation.IntBetween(1, 64),
+						},
+						"leave_parts_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"part_size_mib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultMultipartUploadPartSize / mebibyte,
+							ValidateFunc: validation.IntBetween(multipartUploadMinPartSize/mebibyte, multipartUploadMaxPartSize/mebibyte),
+						},
+						"threshold_mib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultMultipartUploadThreshold / mebibyte,
+							ValidateFunc: validation.IntAtLeast(multipartUploadMinPartSize / mebibyte),
+						},
+					},
+				},
+			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -426,6 +475,12 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
 	}
 
+	if v := expandIncompleteMultipartUpload(d.Get("incomplete_multipart_upload").([]interface{})); v != nil {
+		if err := abortMultipartUpload(ctx, conn, bucket, key, v.uploadID, optFns...); err != nil && !errs.IsA[*types.NoSuchUpload](err) {
+			return sdkdiag.AppendErrorf(diags, "aborting S3 Object (%s) multipart upload (%s): %s", key, v.uploadID, err)
+		}
+	}
+
 	return diags
 }
 
@@ -464,6 +519,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	var body io.ReadSeeker
+	var sourceFile *os.File
 
 	if v, ok := d.GetOk(names.AttrSource); ok {
 		source := v.(string)
@@ -477,6 +533,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		body = file
+		sourceFile = file
 		defer func() {
 			err := file.Close()
 			if err != nil {
@@ -581,23 +638,142 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
+	// Large sources are uploaded using a resumable multipart upload.
+	var size int64
+	if sourceFile != nil {
+		fi, err := sourceFile.Stat()
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 object source (%s): %s", sourceFile.Name(), err)
+		}
+		size = fi.Size()
+	}
+
+	// The multipart upload left incomplete by a failed update is recorded in state so that it can be resumed.
+	incomplete := expandIncompleteMultipartUpload(d.Get("incomplete_multipart_upload").([]interface{}))
+
+	if opts := expandMultipartUploadOptions(d.Get("multipart_upload").([]interface{})); sourceFile != nil && size >= opts.threshold {
+		_, pending, err := uploadObjectMultipart(ctx, conn, input, sourceFile, size, opts, incomplete, optFns...)
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+
+			// A failed create isn't saved in state, so only the upload of an existing object can be resumed.
+			if pending != nil && !d.IsNewResource() {
+				// Keep the previous content arguments so that the upload is retried.
+				for _, key := range objectContentAttributes {
+					o, _ := d.GetChange(key)
+					if err := d.Set(key, o); err != nil {
+						return sdkdiag.AppendErrorf(diags, "setting %s: %s", key, err)
+					}
+				}
+
+				if err := d.Set("incomplete_multipart_upload", flattenIncompleteMultipartUpload(pending)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting incomplete_multipart_upload: %s", err)
+				}
+			}
+
+			return diags
+		}
+	} else {
+		if _, err := uploadObject(ctx, conn, input, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+		}
+
+		if incomplete != nil {
+			if err := abortMultipartUpload(ctx, conn, aws.ToString(input.Bucket), aws.ToString(input.Key), incomplete.uploadID, optFns...); err != nil {
+				log.Printf("[WARN] Aborting S3 Object (%s) multipart upload (%s): %s", aws.ToString(input.Key), incomplete.uploadID, err)
+			}
+		}
+	}
+
+	d.Set("incomplete_multipart_upload", nil)
+
+	if d.IsNewResource() {
+		d.SetId(d.Get(names.AttrKey).(string))
+	}
+
+	return append(diags, resourceObjectRead(ctx, d, meta)...)
+}
+
+// uploadObject uploads an object using the S3 upload manager, which uses a multipart upload for large objects.
+func uploadObject(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, optFns ...func(*s3.Options)) (*manager.UploadOutput, error) {
+	setObjectLockChecksumAlgorithm(input)
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+
+	return uploader.Upload(ctx, input)
+}
+
+func setObjectLockChecksumAlgorithm(input *s3.PutObjectInput) {
 	if (input.ObjectLockLegalHoldStatus != "" || input.ObjectLockMode != "" || input.ObjectLockRetainUntilDate != nil) && input.ChecksumAlgorithm == "" {
 		// "Content-MD5 OR x-amz-checksum- HTTP header is required for Put Object requests with Object Lock parameters".
 		// AWS SDK for Go v1 transparently added a Content-MD4 header.
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
+}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+func expandMultipartUploadOptions(tfList []interface{}) multipartUploadOptions {
+	apiObject := defaultMultipartUploadOptions()
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
 	}
 
-	if d.IsNewResource() {
-		d.SetId(d.Get(names.AttrKey).(string))
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["concurrency"].(int); ok && v > 0 {
+		apiObject.concurrency = v
 	}
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	if v, ok := tfMap["leave_parts_on_error"].(bool); ok {
+		apiObject.leavePartsOnError = v
+	}
+
+	if v, ok := tfMap["part_size_mib"].(int); ok && v > 0 {
+		apiObject.partSize = int64(v) * mebibyte
+	}
+
+	if v, ok := tfMap["threshold_mib"].(int); ok && v > 0 {
+		apiObject.threshold = int64(v) * mebibyte
+	}
+
+	return apiObject
+}
+
+func expandIncompleteMultipartUpload(tfList []interface{}) *incompleteMultipartUpload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &incompleteMultipartUpload{}
+
+	if v, ok := tfMap["settings_hash"].(string); ok {
+		apiObject.inputHash = v
+	}
+
+	if v, ok := tfMap["upload_id"].(string); ok {
+		apiObject.uploadID = v
+	}
+
+	if apiObject.inputHash == "" || apiObject.uploadID == "" {
+		return nil
+	}
+
+	return apiObject
+}
+
+func flattenIncompleteMultipartUpload(apiObject *incompleteMultipartUpload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"settings_hash": apiObject.inputHash,
+		"upload_id":     apiObject.uploadID,
+	}
+
+	return []interface{}{tfMap}
 }
 
 func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
@@ -625,26 +801,29 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// objectContentAttributes are the arguments whose change uploads the object again.
+var objectContentAttributes = []string{
+	"bucket_key_enabled",
+	"cache_control",
+	"checksum_algorithm",
+	"content_base64",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	names.AttrContentType,
+	names.AttrContent,
+	"etag",
+	names.AttrKMSKeyID,
+	"metadata",
+	"server_side_encryption",
+	names.AttrSource,
+	"source_hash",
+	names.AttrStorageClass,
+	"website_redirect",
+}
+
 func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
-	for _, key := range []string{
-		"bucket_key_enabled",
-		"cache_control",
-		"checksum_algorithm",
-		"content_base64",
-		"content_disposition",
-		"content_encoding",
-		"content_language",
-		names.AttrContentType,
-		names.AttrContent,
-		"etag",
-		names.AttrKMSKeyID,
-		"metadata",
-		"server_side_encryption",
-		names.AttrSource,
-		"source_hash",
-		names.AttrStorageClass,
-		"website_redirect",
-	} {
+	for _, key := range objectContentAttributes {
 		if d.HasChange(key) {
 			return true
 		}
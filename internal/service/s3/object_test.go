@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -1623,6 +1624,44 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexache.MustCompile(`-3$`)),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size_mib", "5"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.threshold_mib", "5"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, "CRC32C"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_crc32c", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -3075,6 +3114,28 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filemd5(%[2]q)
+
+  checksum_algorithm = %[3]q
+
+  multipart_upload {
+    part_size_mib = 5
+    threshold_mib = 5
+  }
+}
+`, rName, source, checksumAlgorithm)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newBucketMetadataTableConfigurationDataSource,
+			TypeName: "aws_s3_bucket_metadata_table_configuration",
+			Name:     "Bucket Metadata Table Configuration",
+		},
 		{
 			Factory:  newDirectoryBucketsDataSource,
 			TypeName: "aws_s3_directory_buckets",
@@ -31,6 +36,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
 		},
+		{
+			Factory:  newBucketMetadataTableConfigurationResource,
+			TypeName: "aws_s3_bucket_metadata_table_configuration",
+			Name:     "Bucket Metadata Table Configuration",
+		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
@@ -197,6 +207,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
 		},
+		{
+			Factory:  resourceDirectorySync,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
@@ -228,6 +243,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*s3.Options){
 		s3.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *s3.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	mebibyte = 1024 * 1024
+
+	multipartUploadMinPartSize = 5 * mebibyte
+	multipartUploadMaxPartSize = 5 * 1024 * mebibyte
+	multipartUploadMaxParts    = 10000
+
+	defaultMultipartUploadConcurrency = 8
+	defaultMultipartUploadPartSize    = 16 * mebibyte
+	defaultMultipartUploadThreshold   = 100 * mebibyte
+)
+
+// CRC-64/NVME, as used by S3.
+var crc64NVMETable = crc64.MakeTable(0x9a6c9329ac4bc9b5)
+
+type multipartUploadOptions struct {
+	concurrency       int
+	leavePartsOnError bool
+	partSize          int64
+	threshold         int64
+}
+
+func defaultMultipartUploadOptions() multipartUploadOptions {
+	return multipartUploadOptions{
+		concurrency: defaultMultipartUploadConcurrency,
+		partSize:    defaultMultipartUploadPartSize,
+		threshold:   defaultMultipartUploadThreshold,
+	}
+}
+
+// incompleteMultipartUpload identifies a multipart upload left incomplete by a failed upload, which can be resumed.
+type incompleteMultipartUpload struct {
+	inputHash string // Hash of the upload's CreateMultipartUpload input
+	uploadID  string
+}
+
+// uploadObjectMultipart uploads the specified source using a multipart upload, uploading parts in parallel.
+// Each part's checksum is calculated locally, sent with the part for verification by S3 and compared with the checksum S3 returns.
+// Any other incomplete multipart uploads of the object are aborted.
+// If `leavePartsOnError` is `true` the specified incomplete multipart upload, from a failed earlier attempt, is resumed
+// if it was created with the same CreateMultipartUpload input:
+// parts that have already been uploaded and whose size and checksum match the source are not uploaded again.
+// On failure the multipart upload is then left incomplete and returned, so that it can be resumed.
+// Otherwise the multipart upload is aborted on failure.
+func uploadObjectMultipart(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, source io.ReaderAt, size int64, opts multipartUploadOptions, incomplete *incompleteMultipartUpload, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, *incompleteMultipartUpload, error) {
+	setObjectLockChecksumAlgorithm(input)
+
+	bucket, key := aws.ToString(input.Bucket), aws.ToString(input.Key)
+	checksumAlgorithm := input.ChecksumAlgorithm
+	partSize := multipartUploadPartSize(size, opts.partSize)
+	nParts := int32((size + partSize - 1) / partSize)
+
+	createInput := expandCreateMultipartUploadInput(input)
+	resumable := opts.leavePartsOnError && !isDirectoryBucket(bucket)
+
+	var uploadID, inputHash string
+	uploadedParts := make(map[int32]types.Part)
+
+	if resumable {
+		var err error
+		inputHash, err = createMultipartUploadInputHash(createInput)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("hashing S3 Object (%s) multipart upload input: %w", key, err)
+		}
+
+		if incomplete != nil && incomplete.inputHash == inputHash {
+			uploadID = incomplete.uploadID
+		}
+	}
+
+	// Directory buckets only support listing multipart uploads by prefixes that end in a delimiter.
+	if !isDirectoryBucket(bucket) {
+		found, err := abortStaleMultipartUploads(ctx, conn, bucket, key, uploadID, optFns...)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("aborting S3 Object (%s) incomplete multipart uploads: %w", key, err)
+		}
+
+		if !found {
+			uploadID = ""
+		}
+	}
+
+	if uploadID != "" {
+		parts, err := findMultipartUploadParts(ctx, conn, bucket, key, uploadID, optFns...)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing S3 Object (%s) multipart upload (%s) parts: %w", key, uploadID, err)
+		}
+
+		for _, v := range parts {
+			uploadedParts[aws.ToInt32(v.PartNumber)] = v
+		}
+
+		log.Printf("[DEBUG] Resuming S3 Object (%s) multipart upload (%s): %d parts uploaded", key, uploadID, len(uploadedParts))
+	} else {
+		output, err := conn.CreateMultipartUpload(ctx, createInput, optFns...)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating S3 Object (%s) multipart upload: %w", key, err)
+		}
+
+		uploadID = aws.ToString(output.UploadId)
+	}
+
+	abort := func() *incompleteMultipartUpload {
+		if opts.leavePartsOnError {
+			log.Printf("[WARN] Leaving S3 Object (%s) multipart upload (%s) incomplete", key, uploadID)
+
+			if resumable {
+				return &incompleteMultipartUpload{
+					inputHash: inputHash,
+					uploadID:  uploadID,
+				}
+			}
+
+			return nil
+		}
+
+		if err := abortMultipartUpload(ctx, conn, bucket, key, uploadID, optFns...); err != nil {
+			log.Printf("[WARN] Aborting S3 Object (%s) multipart upload (%s): %s", key, uploadID, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object (%s) to Bucket (%s): %d bytes in %d parts", key, bucket, size, nParts)
+	parts, err := uploadMultipartUploadParts(ctx, conn, bucket, key, uploadID, checksumAlgorithm, source, size, partSize, uploadedParts, opts.concurrency, optFns...)
+
+	if err != nil {
+		return nil, abort(), err
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		MpuObjectSize: aws.Int64(size),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: aws.String(uploadID),
+	}
+
+	output, err := conn.CompleteMultipartUpload(ctx, completeInput, optFns...)
+
+	if err != nil {
+		return nil, abort(), fmt.Errorf("completing S3 Object (%s) multipart upload (%s): %w", key, uploadID, err)
+	}
+
+	return output, nil, nil
+}
+
+// multipartUploadPartSize returns the part size to use for an object of the specified size,
+// increasing the requested part size if necessary to stay within the maximum number of parts.
+func multipartUploadPartSize(size, partSize int64) int64 {
+	partSize = max(partSize, multipartUploadMinPartSize, (size+multipartUploadMaxParts-1)/multipartUploadMaxParts)
+
+	return min(partSize, multipartUploadMaxPartSize)
+}
+
+func uploadMultipartUploadParts(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, checksumAlgorithm types.ChecksumAlgorithm, source io.ReaderAt, size, partSize int64, uploadedParts map[int32]types.Part, concurrency int, optFns ...func(*s3.Options)) ([]types.CompletedPart, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	nParts := int32((size + partSize - 1) / partSize)
+	ch := make(chan int32)
+	var mu sync.Mutex
+	var errs []error
+	parts := make([]types.CompletedPart, 0, nParts)
+	var wg sync.WaitGroup
+
+	for range min(concurrency, int(nParts)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for partNumber := range ch {
+				offset := int64(partNumber-1) * partSize
+				body := io.NewSectionReader(source, offset, min(partSize, size-offset))
+
+				part, err := uploadMultipartUploadPart(ctx, conn, bucket, key, uploadID, partNumber, checksumAlgorithm, body, uploadedParts, optFns...)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err)
+					cancel()
+				} else {
+					parts = append(parts, part)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for partNumber := int32(1); partNumber <= nParts; partNumber++ {
+		if ctx.Err() != nil {
+			break
+		}
+		ch <- partNumber
+	}
+	close(ch)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	// Parts must be specified in ascending order of part number.
+	slices.SortFunc(parts, func(a, b types.CompletedPart) int {
+		return int(aws.ToInt32(a.PartNumber) - aws.ToInt32(b.PartNumber))
+	})
+
+	return parts, nil
+}
+
+// uploadMultipartUploadPart uploads a single part, unless an identical part has already been uploaded.
+func uploadMultipartUploadPart(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, partNumber int32, checksumAlgorithm types.ChecksumAlgorithm, body *io.SectionReader, uploadedParts map[int32]types.Part, optFns ...func(*s3.Options)) (types.CompletedPart, error) {
+	checksum, md5Sum, err := partChecksum(body, checksumAlgorithm)
+
+	if err != nil {
+		return types.CompletedPart{}, fmt.Errorf("reading S3 Object (%s) part %d: %w", key, partNumber, err)
+	}
+
+	if part, ok := uploadedParts[partNumber]; ok && aws.ToInt64(part.Size) == body.Size() {
+		var uploaded bool
+		if checksumAlgorithm != "" {
+			uploaded = partChecksumValue(part, checksumAlgorithm) == checksum
+		} else {
+			uploaded = strings.Trim(aws.ToString(part.ETag), `"`) == md5Sum
+		}
+
+		if uploaded {
+			return newCompletedPart(partNumber, aws.ToString(part.ETag), checksumAlgorithm, checksum), nil
+		}
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return types.CompletedPart{}, fmt.Errorf("reading S3 Object (%s) part %d: %w", key, partNumber, err)
+	}
+
+	input := &s3.UploadPartInput{
+		Body:          body,
+		Bucket:        aws.String(bucket),
+		ContentLength: aws.Int64(body.Size()),
+		Key:           aws.String(key),
+		PartNumber:    aws.Int32(partNumber),
+		UploadId:      aws.String(uploadID),
+	}
+	setUploadPartChecksum(input, checksumAlgorithm, checksum)
+
+	output, err := conn.UploadPart(ctx, input, optFns...)
+
+	if err != nil {
+		return types.CompletedPart{}, fmt.Errorf("uploading S3 Object (%s) part %d: %w", key, partNumber, err)
+	}
+
+	if checksumAlgorithm != "" {
+		if got := uploadPartOutputChecksum(output, checksumAlgorithm); got != checksum {
+			return types.CompletedPart{}, fmt.Errorf("uploading S3 Object (%s) part %d: %s checksum mismatch: got %q, want %q", key, partNumber, checksumAlgorithm, got, checksum)
+		}
+	}
+
+	return newCompletedPart(partNumber, aws.ToString(output.ETag), checksumAlgorithm, checksum), nil
+}
+
+// partChecksum returns the base64 encoded checksum of the part using the specified algorithm and the part's hex encoded MD5 hash.
+func partChecksum(r io.Reader, checksumAlgorithm types.ChecksumAlgorithm) (string, string, error) {
+	md5Hash := md5.New()
+	w := io.Writer(md5Hash)
+
+	var checksumHash hash.Hash
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		checksumHash = crc32.NewIEEE()
+	case types.ChecksumAlgorithmCrc32c:
+		checksumHash = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case types.ChecksumAlgorithmCrc64nvme:
+		checksumHash = crc64.New(crc64NVMETable)
+	case types.ChecksumAlgorithmSha1:
+		checksumHash = sha1.New()
+	case types.ChecksumAlgorithmSha256:
+		checksumHash = sha256.New()
+	case "":
+	default:
+		return "", "", fmt.Errorf("unsupported checksum algorithm: %s", checksumAlgorithm)
+	}
+
+	if checksumHash != nil {
+		w = io.MultiWriter(md5Hash, checksumHash)
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		return "", "", err
+	}
+
+	var checksum string
+	if checksumHash != nil {
+		checksum = base64.StdEncoding.EncodeToString(checksumHash.Sum(nil))
+	}
+
+	return checksum, hex.EncodeToString(md5Hash.Sum(nil)), nil
+}
+
+func setUploadPartChecksum(input *s3.UploadPartInput, checksumAlgorithm types.ChecksumAlgorithm, checksum string) {
+	input.ChecksumAlgorithm = checksumAlgorithm
+
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case types.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case types.ChecksumAlgorithmCrc64nvme:
+		input.ChecksumCRC64NVME = aws.String(checksum)
+	case types.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case types.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+func uploadPartOutputChecksum(output *s3.UploadPartOutput, checksumAlgorithm types.ChecksumAlgorithm) string {
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return aws.ToString(output.ChecksumCRC32)
+	case types.ChecksumAlgorithmCrc32c:
+		return aws.ToString(output.ChecksumCRC32C)
+	case types.ChecksumAlgorithmCrc64nvme:
+		return aws.ToString(output.ChecksumCRC64NVME)
+	case types.ChecksumAlgorithmSha1:
+		return aws.ToString(output.ChecksumSHA1)
+	case types.ChecksumAlgorithmSha256:
+		return aws.ToString(output.ChecksumSHA256)
+	}
+
+	return ""
+}
+
+func partChecksumValue(part types.Part, checksumAlgorithm types.ChecksumAlgorithm) string {
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return aws.ToString(part.ChecksumCRC32)
+	case types.ChecksumAlgorithmCrc32c:
+		return aws.ToString(part.ChecksumCRC32C)
+	case types.ChecksumAlgorithmCrc64nvme:
+		return aws.ToString(part.ChecksumCRC64NVME)
+	case types.ChecksumAlgorithmSha1:
+		return aws.ToString(part.ChecksumSHA1)
+	case types.ChecksumAlgorithmSha256:
+		return aws.ToString(part.ChecksumSHA256)
+	}
+
+	return ""
+}
+
+func newCompletedPart(partNumber int32, etag string, checksumAlgorithm types.ChecksumAlgorithm, checksum string) types.CompletedPart {
+	part := types.CompletedPart{
+		ETag:       aws.String(etag),
+		PartNumber: aws.Int32(partNumber),
+	}
+
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		part.ChecksumCRC32 = aws.String(checksum)
+	case types.ChecksumAlgorithmCrc32c:
+		part.ChecksumCRC32C = aws.String(checksum)
+	case types.ChecksumAlgorithmCrc64nvme:
+		part.ChecksumCRC64NVME = aws.String(checksum)
+	case types.ChecksumAlgorithmSha1:
+		part.ChecksumSHA1 = aws.String(checksum)
+	case types.ChecksumAlgorithmSha256:
+		part.ChecksumSHA256 = aws.String(checksum)
+	}
+
+	return part
+}
+
+func expandCreateMultipartUploadInput(input *s3.PutObjectInput) *s3.CreateMultipartUploadInput {
+	apiObject := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	// CRC-64/NVME checksums of multipart uploads are always full object checksums.
+	if input.ChecksumAlgorithm == types.ChecksumAlgorithmCrc64nvme {
+		apiObject.ChecksumType = types.ChecksumTypeFullObject
+	}
+
+	return apiObject
+}
+
+// abortStaleMultipartUploads aborts the incomplete multipart uploads of the object other than the specified upload,
+// returning whether the specified upload was found.
+func abortStaleMultipartUploads(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) (bool, error) {
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	}
+	var found bool
+
+	pages := s3.NewListMultipartUploadsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range page.Uploads {
+			if aws.ToString(v.Key) != key {
+				continue
+			}
+
+			id := aws.ToString(v.UploadId)
+			if id == uploadID {
+				found = true
+				continue
+			}
+
+			log.Printf("[DEBUG] Aborting S3 Object (%s) stale multipart upload (%s)", key, id)
+			if err := abortMultipartUpload(ctx, conn, bucket, key, id, optFns...); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// createMultipartUploadInputHash returns the hex encoded SHA-256 hash of the CreateMultipartUpload input.
+func createMultipartUploadInputHash(input *s3.CreateMultipartUploadInput) (string, error) {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func findMultipartUploadParts(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) ([]types.Part, error) {
+	input := &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+	var output []types.Part
+
+	pages := s3.NewListPartsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Parts...)
+	}
+
+	return output, nil
+}
+
+func abortMultipartUpload(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) error {
+	input := &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+
+	// Use a context that isn't canceled so that the upload is aborted even if the upload failed because the context was canceled.
+	_, err := conn.AbortMultipartUpload(context.WithoutCancel(ctx), input, optFns...)
+
+	return err
+}
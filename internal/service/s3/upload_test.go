@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestMultipartUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	const mib = 1024 * 1024
+
+	testCases := []struct {
+		TestName string
+		Size     int64
+		PartSize int64
+		Expected int64
+	}{
+		{
+			TestName: "requested part size",
+			Size:     1024 * mib,
+			PartSize: 16 * mib,
+			Expected: 16 * mib,
+		},
+		{
+			TestName: "below minimum",
+			Size:     1024 * mib,
+			PartSize: 1 * mib,
+			Expected: 5 * mib,
+		},
+		{
+			TestName: "too many parts",
+			Size:     200 * 1024 * mib,
+			PartSize: 16 * mib,
+			Expected: 21474837,
+		},
+		{
+			TestName: "above maximum",
+			Size:     100 * 1024 * 1024 * mib,
+			PartSize: 16 * mib,
+			Expected: 5 * 1024 * mib,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.MultipartUploadPartSize(testCase.Size, testCase.PartSize), testCase.Expected; got != want {
+				t.Errorf("MultipartUploadPartSize(%d, %d) = %d, want %d", testCase.Size, testCase.PartSize, got, want)
+			}
+		})
+	}
+}
+
+func TestPartChecksum(t *testing.T) {
+	t.Parallel()
+
+	const md5Sum = "25f9e794323b453885f5181f1b624d0b"
+
+	testCases := []struct {
+		ChecksumAlgorithm types.ChecksumAlgorithm
+		Expected          string
+	}{
+		{
+			ChecksumAlgorithm: "",
+			Expected:          "",
+		},
+		{
+			ChecksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			Expected:          "y/Q5Jg==",
+		},
+		{
+			ChecksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+			Expected:          "4waSgw==",
+		},
+		{
+			ChecksumAlgorithm: types.ChecksumAlgorithmCrc64nvme,
+			Expected:          "rosUhgp5mIg=",
+		},
+		{
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha1,
+			Expected:          "98O8HYCOBHMq32eZZczDTKeuNEE=",
+		},
+		{
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+			Expected:          "FeKw08M4keuw8e9gnsQZQgwg4yDOlMZfvIwzEkSOsiU=",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.ChecksumAlgorithm), func(t *testing.T) {
+			t.Parallel()
+
+			gotChecksum, gotMD5Sum, err := tfs3.PartChecksum(strings.NewReader("123456789"), testCase.ChecksumAlgorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := gotChecksum, testCase.Expected; got != want {
+				t.Errorf("checksum = %q, want %q", got, want)
+			}
+
+			if got, want := gotMD5Sum, md5Sum; got != want {
+				t.Errorf("MD5 = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCreateMultipartUploadInputHash(t *testing.T) {
+	t.Parallel()
+
+	newInput := func() *s3.CreateMultipartUploadInput {
+		return &s3.CreateMultipartUploadInput{
+			Bucket:            aws.String("bucket"),
+			ChecksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			Key:               aws.String("key"),
+			Metadata:          map[string]string{"a": "1", "b": "2"},
+		}
+	}
+
+	want, err := tfs3.CreateMultipartUploadInputHash(newInput())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, err := tfs3.CreateMultipartUploadInputHash(newInput()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != want {
+		t.Errorf("hash of identical input = %q, want %q", got, want)
+	}
+
+	input := newInput()
+	input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	input.SSEKMSKeyId = aws.String("alias/example")
+
+	if got, err := tfs3.CreateMultipartUploadInputHash(input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got == want {
+		t.Errorf("hash of different input = %q, want a different hash", got)
+	}
+}
+
+func TestExpandIncompleteMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		TFList   []interface{}
+		Expected []interface{}
+	}{
+		{
+			TestName: "none",
+		},
+		{
+			TestName: "incomplete upload",
+			TFList:   []interface{}{map[string]interface{}{"settings_hash": "0123abcd", "upload_id": "upload.ID-1"}},
+			Expected: []interface{}{map[string]interface{}{"settings_hash": "0123abcd", "upload_id": "upload.ID-1"}},
+		},
+		{
+			TestName: "no settings hash",
+			TFList:   []interface{}{map[string]interface{}{"settings_hash": "", "upload_id": "upload.ID-1"}},
+		},
+		{
+			TestName: "no upload ID",
+			TFList:   []interface{}{map[string]interface{}{"settings_hash": "0123abcd", "upload_id": ""}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.FlattenIncompleteMultipartUpload(tfs3.ExpandIncompleteMultipartUpload(testCase.TFList))
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ var (
 	ResourceBucket                             = resourceBucket
 	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
 	ResourceBucketPolicy                       = resourceBucketPolicy
+	ResourceJob                                = newJobResource
 	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
 	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
@@ -30,6 +31,7 @@ var (
 	FindBucketByTwoPartKey                                 = findBucketByTwoPartKey
 	FindBucketLifecycleConfigurationByTwoPartKey           = findBucketLifecycleConfigurationByTwoPartKey
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
+	FindJobByTwoPartKey                                    = findJobByTwoPartKey
 	FindMultiRegionAccessPointByTwoPartKey                 = findMultiRegionAccessPointByTwoPartKey
 	FindMultiRegionAccessPointPolicyDocumentByTwoPartKey   = findMultiRegionAccessPointPolicyDocumentByTwoPartKey
 	FindObjectLambdaAccessPointAliasByTwoPartKey           = findObjectLambdaAccessPointAliasByTwoPartKey
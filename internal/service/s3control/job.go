@@ -0,0 +1,1393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3control_job", name="Job")
+// @Tags
+func newJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &jobResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type jobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *jobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3control_job"
+}
+
+func (r *jobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3TagBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3TagModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 128),
+					},
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtMost(256),
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"confirmation_required": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPriority: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			"progress_summary": framework.ResourceComputedListOfObjectsAttribute[jobProgressSummaryModel](ctx),
+			"requested_job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RequestedJobStatus](),
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+			},
+			"status_update_reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"manifest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobManifestModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrLocation: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[jobManifestLocationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"etag": schema.StringAttribute{
+										Required: true,
+									},
+									"object_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"object_version_id": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"spec": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[jobManifestSpecModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringEnumType[awstypes.JobManifestFieldName](),
+										ElementType: fwtypes.StringEnumType[awstypes.JobManifestFieldName](),
+										Optional:    true,
+									},
+									names.AttrFormat: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.JobManifestFormat](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobManifestGeneratorModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_job_manifest_generator": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3JobManifestGeneratorModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enable_manifest_output": schema.BoolAttribute{
+										Required: true,
+									},
+									names.AttrExpectedBucketOwner: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											fwvalidators.AWSAccountID(),
+										},
+									},
+									"source_bucket": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrFilter: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[jobManifestGeneratorFilterModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"created_after": schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Optional:   true,
+												},
+												"created_before": schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Optional:   true,
+												},
+												"eligible_for_replication": schema.BoolAttribute{
+													Optional: true,
+												},
+												"match_any_storage_class": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringEnumType[awstypes.S3StorageClass](),
+													ElementType: fwtypes.StringEnumType[awstypes.S3StorageClass](),
+													Optional:    true,
+												},
+												"object_replication_statuses": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringEnumType[awstypes.ReplicationStatus](),
+													ElementType: fwtypes.StringEnumType[awstypes.ReplicationStatus](),
+													Optional:    true,
+												},
+												"object_size_greater_than_bytes": schema.Int64Attribute{
+													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(0),
+													},
+												},
+												"object_size_less_than_bytes": schema.Int64Attribute{
+													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(0),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"key_name_constraint": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[keyNameConstraintModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"match_any_prefix": schema.ListAttribute{
+																CustomType:  fwtypes.ListOfStringType,
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"match_any_substring": schema.ListAttribute{
+																CustomType:  fwtypes.ListOfStringType,
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"match_any_suffix": schema.ListAttribute{
+																CustomType:  fwtypes.ListOfStringType,
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"manifest_output_location": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3ManifestOutputLocationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												"expected_manifest_bucket_owner": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														fwvalidators.AWSAccountID(),
+													},
+												},
+												"manifest_format": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.GeneratedManifestFormat](),
+													Required:   true,
+												},
+												"manifest_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"manifest_encryption": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[generatedManifestEncryptionModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"sse_kms": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[sseKMSEncryptionModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("sse_s3")),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrKeyID: schema.StringAttribute{
+																			CustomType: fwtypes.ARNType,
+																			Required:   true,
+																		},
+																	},
+																},
+															},
+															"sse_s3": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[sseS3EncryptionModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobOperationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lambda_invoke": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaInvokeOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrFunctionARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"invocation_schema_version": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("1.0", "2.0"),
+										},
+									},
+									"user_arguments": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"s3_compute_object_checksum": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3ComputeObjectChecksumOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"checksum_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ComputeObjectChecksumAlgorithm](),
+										Optional:   true,
+									},
+									"checksum_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ComputeObjectChecksumType](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"s3_delete_object_tagging": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3DeleteObjectTaggingOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"s3_initiate_restore_object": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3InitiateRestoreObjectOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"expiration_in_days": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"glacier_job_tier": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3GlacierJobTier](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3SetObjectACLOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"access_control_policy": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3AccessControlPolicyModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"canned_access_control_list": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.S3CannedAccessControlList](),
+													Optional:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"access_control_list": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[s3AccessControlListModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("canned_access_control_list")),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"grant": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[s3GrantModel](ctx),
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"permission": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.S3Permission](),
+																			Required:   true,
+																		},
+																	},
+																	Blocks: map[string]schema.Block{
+																		"grantee": schema.ListNestedBlock{
+																			CustomType: fwtypes.NewListNestedObjectTypeOf[s3GranteeModel](ctx),
+																			Validators: []validator.List{
+																				listvalidator.IsRequired(),
+																				listvalidator.SizeAtLeast(1),
+																				listvalidator.SizeAtMost(1),
+																			},
+																			NestedObject: schema.NestedBlockObject{
+																				Attributes: map[string]schema.Attribute{
+																					names.AttrDisplayName: schema.StringAttribute{
+																						Optional: true,
+																					},
+																					names.AttrIdentifier: schema.StringAttribute{
+																						Required: true,
+																					},
+																					"type_identifier": schema.StringAttribute{
+																						CustomType: fwtypes.StringEnumType[awstypes.S3GranteeTypeIdentifier](),
+																						Required:   true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															names.AttrOwner: schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[s3ObjectOwnerModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtLeast(1),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrDisplayName: schema.StringAttribute{
+																			Optional: true,
+																		},
+																		names.AttrID: schema.StringAttribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3CopyObjectOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket_key_enabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									"canned_access_control_list": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3CannedAccessControlList](),
+										Optional:   true,
+									},
+									"checksum_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3ChecksumAlgorithm](),
+										Optional:   true,
+									},
+									"metadata_directive": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3MetadataDirective](),
+										Optional:   true,
+									},
+									"object_lock_legal_hold_status": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3ObjectLockLegalHoldStatus](),
+										Optional:   true,
+									},
+									"object_lock_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3ObjectLockMode](),
+										Optional:   true,
+									},
+									"object_lock_retain_until_date": schema.StringAttribute{
+										CustomType: timetypes.RFC3339Type{},
+										Optional:   true,
+									},
+									"requester_pays": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									"sse_aws_kms_key_id": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrStorageClass: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3StorageClass](),
+										Optional:   true,
+									},
+									"target_key_prefix": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+									"target_resource": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"new_object_tagging": s3TagBlock,
+								},
+							},
+						},
+						"s3_put_object_tagging": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3SetObjectTaggingOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"tag_set": s3TagBlock,
+								},
+							},
+						},
+						"s3_replicate_object": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3ReplicateObjectOperationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+			},
+			"report": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobReportModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucket: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						names.AttrEnabled: schema.BoolAttribute{
+							Required: true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.JobReportFormat](),
+							Optional:   true,
+						},
+						names.AttrPrefix: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 512),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[^/]`), "must not start with a slash"),
+							},
+						},
+						"report_scope": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.JobReportScope](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *jobResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	operation := path.MatchRoot("operation").AtListIndex(0)
+
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("manifest"),
+			path.MatchRoot("manifest_generator"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			operation.AtName("lambda_invoke"),
+			operation.AtName("s3_compute_object_checksum"),
+			operation.AtName("s3_delete_object_tagging"),
+			operation.AtName("s3_initiate_restore_object"),
+			operation.AtName("s3_put_object_acl"),
+			operation.AtName("s3_put_object_copy"),
+			operation.AtName("s3_put_object_tagging"),
+			operation.AtName("s3_replicate_object"),
+		),
+	}
+}
+
+func (r *jobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	input := &s3control.CreateJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, jobFlexOptions()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsInS3(ctx)
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating S3 Batch Operations Job", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.JobID = fwflex.StringToFramework(ctx, output.JobId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating S3 Batch Operations Job", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	job, err := waitJobPrepared(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString(), createTimeout)
+
+	if err != nil {
+		// Store the job's identifiers so that the resource is tainted.
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAccountID), data.AccountID)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("job_id"), data.JobID)...)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Batch Operations Job (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	job, diags := updateJobStatusAndWait(ctx, conn, &data, job, createTimeout)
+	response.Diagnostics.Append(diags...)
+	if job == nil {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAccountID), data.AccountID)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("job_id"), data.JobID)...)
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *jobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	job, err := findJobByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		// S3 Batch Operations only retains job records for 90 days after the job finishes.
+		// Keep finished jobs in state so that an expired record doesn't cause the job to be run again.
+		if jobStatusIsFinished(data.Status.ValueEnum()) {
+			return
+		}
+
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Operations Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set attributes for import.
+	if data.WaitForCompletion.IsNull() {
+		data.WaitForCompletion = types.BoolValue(true)
+	}
+
+	tags, err := jobListTags(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Batch Operations Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOutS3(ctx, tagsS3(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *jobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	accountID, jobID := new.AccountID.ValueString(), new.JobID.ValueString()
+
+	if !new.Priority.Equal(old.Priority) {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  int32(new.Priority.ValueInt64()),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Batch Operations Job (%s) priority", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, oldTagsAll, newTagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Batch Operations Job (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Operations Job (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	job, diags := updateJobStatusAndWait(ctx, conn, &new, job, r.UpdateTimeout(ctx, new.Timeouts))
+	response.Diagnostics.Append(diags...)
+	if job == nil {
+		return
+	}
+
+	response.Diagnostics.Append(new.refreshFromOutput(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *jobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	// Jobs can't be deleted, only cancelled. Finished jobs are simply removed from state.
+	job, err := findJobByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Operations Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if jobStatusIsFinished(job.Status) || job.Status == awstypes.JobStatusCancelling {
+		return
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          fwflex.StringFromFramework(ctx, data.AccountID),
+		JobId:              fwflex.StringFromFramework(ctx, data.JobID),
+		RequestedJobStatus: awstypes.RequestedJobStatusCancelled,
+	}
+
+	_, err = conn.UpdateJobStatus(ctx, input)
+
+	// The job finished between the status check and the cancellation request.
+	if errs.IsA[*awstypes.JobStatusException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling S3 Batch Operations Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *jobResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// updateJobStatusAndWait applies the job's requested status and, if configured, waits for the job to finish.
+// A nil job is returned if the job could not be updated or failed.
+func updateJobStatusAndWait(ctx context.Context, conn *s3control.Client, data *jobResourceModel, job *awstypes.JobDescriptor, timeout time.Duration) (*awstypes.JobDescriptor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if jobStatusIsFinished(job.Status) {
+		return job, diags
+	}
+
+	accountID, jobID := data.AccountID.ValueString(), data.JobID.ValueString()
+	requestedJobStatus := data.RequestedJobStatus.ValueEnum()
+
+	// A job can only be confirmed while it's awaiting confirmation.
+	if requestedJobStatus == awstypes.RequestedJobStatusCancelled ||
+		requestedJobStatus == awstypes.RequestedJobStatusReady && job.Status == awstypes.JobStatusSuspended {
+		input := &s3control.UpdateJobStatusInput{
+			AccountId:          aws.String(accountID),
+			JobId:              aws.String(jobID),
+			RequestedJobStatus: requestedJobStatus,
+			StatusUpdateReason: fwflex.StringFromFramework(ctx, data.StatusUpdateReason),
+		}
+
+		_, err := conn.UpdateJobStatus(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating S3 Batch Operations Job (%s) status", data.ID.ValueString()), err.Error())
+
+			return nil, diags
+		}
+	} else if job.Status == awstypes.JobStatusSuspended {
+		// Don't wait for a job that is awaiting confirmation.
+		return job, diags
+	}
+
+	if !data.WaitForCompletion.ValueBool() {
+		job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading S3 Batch Operations Job (%s)", data.ID.ValueString()), err.Error())
+
+			return nil, diags
+		}
+
+		return job, diags
+	}
+
+	job, err := waitJobCompleted(ctx, conn, accountID, jobID, timeout)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("waiting for S3 Batch Operations Job (%s) to complete", data.ID.ValueString()), err.Error())
+
+		return nil, diags
+	}
+
+	if job.Status == awstypes.JobStatusCancelled && requestedJobStatus != awstypes.RequestedJobStatusCancelled {
+		diags.AddWarning(
+			fmt.Sprintf("S3 Batch Operations Job (%s) cancelled", data.ID.ValueString()),
+			fmt.Sprintf("The job was cancelled before it completed: %s", aws.ToString(job.StatusUpdateReason)),
+		)
+	}
+
+	if v := job.ProgressSummary; v != nil && aws.ToInt64(v.NumberOfTasksFailed) > 0 {
+		detail := fmt.Sprintf("%d of %d tasks failed.", aws.ToInt64(v.NumberOfTasksFailed), aws.ToInt64(v.TotalNumberOfTasks))
+		if report := job.Report; report != nil && report.Enabled {
+			detail += fmt.Sprintf(" See the completion report in %s for the failed tasks.", aws.ToString(report.Bucket))
+		}
+
+		diags.AddWarning(fmt.Sprintf("S3 Batch Operations Job (%s) completed with failures", data.ID.ValueString()), detail)
+	}
+
+	return job, diags
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*awstypes.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) || tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func jobStatusIsFinished(status awstypes.JobStatus) bool {
+	return slices.Contains(enum.Slice(awstypes.JobStatusCancelled, awstypes.JobStatusComplete, awstypes.JobStatusFailed), string(status))
+}
+
+var jobWaiter = tfresource.NewStateWaiter("S3 Batch Operations Job", func(v *awstypes.JobDescriptor) string {
+	return string(v.Status)
+}, tfresource.WithFailureReason(func(v *awstypes.JobDescriptor) error {
+	var failures []error
+
+	for _, failure := range v.FailureReasons {
+		failures = append(failures, fmt.Errorf("%s: %s", aws.ToString(failure.FailureCode), aws.ToString(failure.FailureReason)))
+	}
+
+	if cause := aws.ToString(v.SuspendedCause); cause != "" {
+		failures = append(failures, errors.New(cause))
+	}
+
+	return errors.Join(failures...)
+}))
+
+func waitJobPrepared(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*awstypes.JobDescriptor, error) {
+	return jobWaiter.WaitFor(ctx, func(ctx context.Context) (*awstypes.JobDescriptor, error) {
+		return findJobByTwoPartKey(ctx, conn, accountID, jobID)
+	}, enum.Slice(awstypes.JobStatusNew, awstypes.JobStatusPreparing), enum.Slice(
+		awstypes.JobStatusActive,
+		awstypes.JobStatusCancelled,
+		awstypes.JobStatusCancelling,
+		awstypes.JobStatusComplete,
+		awstypes.JobStatusCompleting,
+		awstypes.JobStatusPaused,
+		awstypes.JobStatusPausing,
+		awstypes.JobStatusReady,
+		awstypes.JobStatusSuspended,
+	), timeout)
+}
+
+func waitJobCompleted(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*awstypes.JobDescriptor, error) {
+	return jobWaiter.WaitFor(ctx, func(ctx context.Context) (*awstypes.JobDescriptor, error) {
+		return findJobByTwoPartKey(ctx, conn, accountID, jobID)
+	}, enum.Slice(
+		awstypes.JobStatusActive,
+		awstypes.JobStatusCancelling,
+		awstypes.JobStatusCompleting,
+		awstypes.JobStatusNew,
+		awstypes.JobStatusPaused,
+		awstypes.JobStatusPausing,
+		awstypes.JobStatusPreparing,
+		awstypes.JobStatusReady,
+		awstypes.JobStatusSuspended,
+	), enum.Slice(awstypes.JobStatusCancelled, awstypes.JobStatusComplete), timeout, tfresource.WithMaxPollInterval(1*time.Minute))
+}
+
+func jobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsS3(ctx, output.Tags), nil
+}
+
+func jobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      tagsS3(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func jobFlexOptions() []fwflex.AutoFlexOptionsFunc {
+	return []fwflex.AutoFlexOptionsFunc{
+		fwflex.WithUnion[awstypes.JobManifestGenerator](&awstypes.JobManifestGeneratorMemberS3JobManifestGenerator{}),
+	}
+}
+
+type jobResourceModel struct {
+	AccountID            types.String                                               `tfsdk:"account_id"`
+	ConfirmationRequired types.Bool                                                 `tfsdk:"confirmation_required"`
+	Description          types.String                                               `tfsdk:"description"`
+	ID                   types.String                                               `tfsdk:"id"`
+	JobARN               types.String                                               `tfsdk:"job_arn"`
+	JobID                types.String                                               `tfsdk:"job_id"`
+	Manifest             fwtypes.ListNestedObjectValueOf[jobManifestModel]          `tfsdk:"manifest"`
+	ManifestGenerator    fwtypes.ListNestedObjectValueOf[jobManifestGeneratorModel] `tfsdk:"manifest_generator"`
+	Operation            fwtypes.ListNestedObjectValueOf[jobOperationModel]         `tfsdk:"operation"`
+	Priority             types.Int64                                                `tfsdk:"priority"`
+	ProgressSummary      fwtypes.ListNestedObjectValueOf[jobProgressSummaryModel]   `tfsdk:"progress_summary"`
+	Report               fwtypes.ListNestedObjectValueOf[jobReportModel]            `tfsdk:"report"`
+	RequestedJobStatus   fwtypes.StringEnum[awstypes.RequestedJobStatus]            `tfsdk:"requested_job_status"`
+	RoleARN              fwtypes.ARN                                                `tfsdk:"role_arn"`
+	Status               fwtypes.StringEnum[awstypes.JobStatus]                     `tfsdk:"status"`
+	StatusUpdateReason   types.String                                               `tfsdk:"status_update_reason"`
+	Tags                 tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                 `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+	WaitForCompletion    types.Bool                                                 `tfsdk:"wait_for_completion"`
+}
+
+const (
+	jobResourceIDPartCount = 2
+)
+
+func (data *jobResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, jobResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.AccountID = types.StringValue(parts[0])
+	data.JobID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *jobResourceModel) setID() (string, error) {
+	parts := []string{
+		data.AccountID.ValueString(),
+		data.JobID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, jobResourceIDPartCount, false)
+}
+
+func (data *jobResourceModel) refreshFromOutput(ctx context.Context, job *awstypes.JobDescriptor) diag.Diagnostics {
+	// The status update reason is only ever set from configuration.
+	return fwflex.Flatten(ctx, job, data, append(jobFlexOptions(), fwflex.WithIgnoredFieldNamesAppend("StatusUpdateReason"))...)
+}
+
+type jobManifestModel struct {
+	Location fwtypes.ListNestedObjectValueOf[jobManifestLocationModel] `tfsdk:"location"`
+	Spec     fwtypes.ListNestedObjectValueOf[jobManifestSpecModel]     `tfsdk:"spec"`
+}
+
+type jobManifestLocationModel struct {
+	ETag            types.String `tfsdk:"etag"`
+	ObjectARN       fwtypes.ARN  `tfsdk:"object_arn"`
+	ObjectVersionID types.String `tfsdk:"object_version_id"`
+}
+
+type jobManifestSpecModel struct {
+	Fields fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.JobManifestFieldName]] `tfsdk:"fields"`
+	Format fwtypes.StringEnum[awstypes.JobManifestFormat]                         `tfsdk:"format"`
+}
+
+type jobManifestGeneratorModel struct {
+	S3JobManifestGenerator fwtypes.ListNestedObjectValueOf[s3JobManifestGeneratorModel] `tfsdk:"s3_job_manifest_generator"`
+}
+
+type s3JobManifestGeneratorModel struct {
+	EnableManifestOutput   types.Bool                                                       `tfsdk:"enable_manifest_output"`
+	ExpectedBucketOwner    types.String                                                     `tfsdk:"expected_bucket_owner"`
+	Filter                 fwtypes.ListNestedObjectValueOf[jobManifestGeneratorFilterModel] `tfsdk:"filter"`
+	ManifestOutputLocation fwtypes.ListNestedObjectValueOf[s3ManifestOutputLocationModel]   `tfsdk:"manifest_output_location"`
+	SourceBucket           fwtypes.ARN                                                      `tfsdk:"source_bucket"`
+}
+
+type jobManifestGeneratorFilterModel struct {
+	CreatedAfter               timetypes.RFC3339                                                   `tfsdk:"created_after"`
+	CreatedBefore              timetypes.RFC3339                                                   `tfsdk:"created_before"`
+	EligibleForReplication     types.Bool                                                          `tfsdk:"eligible_for_replication"`
+	KeyNameConstraint          fwtypes.ListNestedObjectValueOf[keyNameConstraintModel]             `tfsdk:"key_name_constraint"`
+	MatchAnyStorageClass       fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.S3StorageClass]]    `tfsdk:"match_any_storage_class"`
+	ObjectReplicationStatuses  fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.ReplicationStatus]] `tfsdk:"object_replication_statuses"`
+	ObjectSizeGreaterThanBytes types.Int64                                                         `tfsdk:"object_size_greater_than_bytes"`
+	ObjectSizeLessThanBytes    types.Int64                                                         `tfsdk:"object_size_less_than_bytes"`
+}
+
+type keyNameConstraintModel struct {
+	MatchAnyPrefix    fwtypes.ListValueOf[types.String] `tfsdk:"match_any_prefix"`
+	MatchAnySubstring fwtypes.ListValueOf[types.String] `tfsdk:"match_any_substring"`
+	MatchAnySuffix    fwtypes.ListValueOf[types.String] `tfsdk:"match_any_suffix"`
+}
+
+type s3ManifestOutputLocationModel struct {
+	Bucket                      fwtypes.ARN                                                       `tfsdk:"bucket"`
+	ExpectedManifestBucketOwner types.String                                                      `tfsdk:"expected_manifest_bucket_owner"`
+	ManifestEncryption          fwtypes.ListNestedObjectValueOf[generatedManifestEncryptionModel] `tfsdk:"manifest_encryption"`
+	ManifestFormat              fwtypes.StringEnum[awstypes.GeneratedManifestFormat]              `tfsdk:"manifest_format"`
+	ManifestPrefix              types.String                                                      `tfsdk:"manifest_prefix"`
+}
+
+type generatedManifestEncryptionModel struct {
+	SSEKMS fwtypes.ListNestedObjectValueOf[sseKMSEncryptionModel] `tfsdk:"sse_kms"`
+	SSES3  fwtypes.ListNestedObjectValueOf[sseS3EncryptionModel]  `tfsdk:"sse_s3"`
+}
+
+type sseKMSEncryptionModel struct {
+	KeyID fwtypes.ARN `tfsdk:"key_id"`
+}
+
+type sseS3EncryptionModel struct{}
+
+type jobOperationModel struct {
+	LambdaInvoke            fwtypes.ListNestedObjectValueOf[lambdaInvokeOperationModel]            `tfsdk:"lambda_invoke"`
+	S3ComputeObjectChecksum fwtypes.ListNestedObjectValueOf[s3ComputeObjectChecksumOperationModel] `tfsdk:"s3_compute_object_checksum"`
+	S3DeleteObjectTagging   fwtypes.ListNestedObjectValueOf[s3DeleteObjectTaggingOperationModel]   `tfsdk:"s3_delete_object_tagging"`
+	S3InitiateRestoreObject fwtypes.ListNestedObjectValueOf[s3InitiateRestoreObjectOperationModel] `tfsdk:"s3_initiate_restore_object"`
+	S3PutObjectACL          fwtypes.ListNestedObjectValueOf[s3SetObjectACLOperationModel]          `tfsdk:"s3_put_object_acl"`
+	S3PutObjectCopy         fwtypes.ListNestedObjectValueOf[s3CopyObjectOperationModel]            `tfsdk:"s3_put_object_copy"`
+	S3PutObjectTagging      fwtypes.ListNestedObjectValueOf[s3SetObjectTaggingOperationModel]      `tfsdk:"s3_put_object_tagging"`
+	S3ReplicateObject       fwtypes.ListNestedObjectValueOf[s3ReplicateObjectOperationModel]       `tfsdk:"s3_replicate_object"`
+}
+
+type lambdaInvokeOperationModel struct {
+	FunctionARN             fwtypes.ARN         `tfsdk:"function_arn"`
+	InvocationSchemaVersion types.String        `tfsdk:"invocation_schema_version"`
+	UserArguments           fwtypes.MapOfString `tfsdk:"user_arguments"`
+}
+
+type s3ComputeObjectChecksumOperationModel struct {
+	ChecksumAlgorithm fwtypes.StringEnum[awstypes.ComputeObjectChecksumAlgorithm] `tfsdk:"checksum_algorithm"`
+	ChecksumType      fwtypes.StringEnum[awstypes.ComputeObjectChecksumType]      `tfsdk:"checksum_type"`
+}
+
+type s3DeleteObjectTaggingOperationModel struct{}
+
+type s3InitiateRestoreObjectOperationModel struct {
+	ExpirationInDays types.Int64                                   `tfsdk:"expiration_in_days"`
+	GlacierJobTier   fwtypes.StringEnum[awstypes.S3GlacierJobTier] `tfsdk:"glacier_job_tier"`
+}
+
+type s3SetObjectACLOperationModel struct {
+	AccessControlPolicy fwtypes.ListNestedObjectValueOf[s3AccessControlPolicyModel] `tfsdk:"access_control_policy"`
+}
+
+type s3AccessControlPolicyModel struct {
+	AccessControlList       fwtypes.ListNestedObjectValueOf[s3AccessControlListModel] `tfsdk:"access_control_list"`
+	CannedAccessControlList fwtypes.StringEnum[awstypes.S3CannedAccessControlList]    `tfsdk:"canned_access_control_list"`
+}
+
+type s3AccessControlListModel struct {
+	Grant fwtypes.ListNestedObjectValueOf[s3GrantModel]       `tfsdk:"grant"`
+	Owner fwtypes.ListNestedObjectValueOf[s3ObjectOwnerModel] `tfsdk:"owner"`
+}
+
+type s3GrantModel struct {
+	Grantee    fwtypes.ListNestedObjectValueOf[s3GranteeModel] `tfsdk:"grantee"`
+	Permission fwtypes.StringEnum[awstypes.S3Permission]       `tfsdk:"permission"`
+}
+
+type s3GranteeModel struct {
+	DisplayName    types.String                                         `tfsdk:"display_name"`
+	Identifier     types.String                                         `tfsdk:"identifier"`
+	TypeIdentifier fwtypes.StringEnum[awstypes.S3GranteeTypeIdentifier] `tfsdk:"type_identifier"`
+}
+
+type s3ObjectOwnerModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	ID          types.String `tfsdk:"id"`
+}
+
+type s3CopyObjectOperationModel struct {
+	BucketKeyEnabled          types.Bool                                               `tfsdk:"bucket_key_enabled"`
+	CannedAccessControlList   fwtypes.StringEnum[awstypes.S3CannedAccessControlList]   `tfsdk:"canned_access_control_list"`
+	ChecksumAlgorithm         fwtypes.StringEnum[awstypes.S3ChecksumAlgorithm]         `tfsdk:"checksum_algorithm"`
+	MetadataDirective         fwtypes.StringEnum[awstypes.S3MetadataDirective]         `tfsdk:"metadata_directive"`
+	NewObjectTagging          fwtypes.ListNestedObjectValueOf[s3TagModel]              `tfsdk:"new_object_tagging"`
+	ObjectLockLegalHoldStatus fwtypes.StringEnum[awstypes.S3ObjectLockLegalHoldStatus] `tfsdk:"object_lock_legal_hold_status"`
+	ObjectLockMode            fwtypes.StringEnum[awstypes.S3ObjectLockMode]            `tfsdk:"object_lock_mode"`
+	ObjectLockRetainUntilDate timetypes.RFC3339                                        `tfsdk:"object_lock_retain_until_date"`
+	RequesterPays             types.Bool                                               `tfsdk:"requester_pays"`
+	SSEAWSKMSKeyID            types.String                                             `tfsdk:"sse_aws_kms_key_id"`
+	StorageClass              fwtypes.StringEnum[awstypes.S3StorageClass]              `tfsdk:"storage_class"`
+	TargetKeyPrefix           types.String                                             `tfsdk:"target_key_prefix"`
+	TargetResource            fwtypes.ARN                                              `tfsdk:"target_resource"`
+}
+
+type s3SetObjectTaggingOperationModel struct {
+	TagSet fwtypes.ListNestedObjectValueOf[s3TagModel] `tfsdk:"tag_set"`
+}
+
+type s3TagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type s3ReplicateObjectOperationModel struct{}
+
+type jobProgressSummaryModel struct {
+	NumberOfTasksFailed    types.Int64 `tfsdk:"number_of_tasks_failed"`
+	NumberOfTasksSucceeded types.Int64 `tfsdk:"number_of_tasks_succeeded"`
+	TotalNumberOfTasks     types.Int64 `tfsdk:"total_number_of_tasks"`
+}
+
+type jobReportModel struct {
+	Bucket      fwtypes.ARN                                  `tfsdk:"bucket"`
+	Enabled     types.Bool                                   `tfsdk:"enabled"`
+	Format      fwtypes.StringEnum[awstypes.JobReportFormat] `tfsdk:"format"`
+	Prefix      types.String                                 `tfsdk:"prefix"`
+	ReportScope fwtypes.StringEnum[awstypes.JobReportScope]  `tfsdk:"report_scope"`
+}
@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "job_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", "S3BatchOperations_CSV_20180820"),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "2"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "2"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccJobConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_confirmation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_confirmation(rName, 10, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+					resource.TestCheckNoResourceAttr(resourceName, "requested_job_status"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusSuspended)),
+				),
+			},
+			{
+				Config: testAccJobConfig_confirmation(rName, 20, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "20"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusSuspended)),
+				),
+			},
+			{
+				Config: testAccJobConfig_confirmation(rName, 20, string(awstypes.RequestedJobStatusReady)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requested_job_status", string(awstypes.RequestedJobStatusReady)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_cancel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_confirmation(rName, 10, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusSuspended)),
+				),
+			},
+			{
+				Config: testAccJobConfig_confirmation(rName, 10, string(awstypes.RequestedJobStatusCancelled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusCancelled)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_manifestGenerator(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_manifestGenerator(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.enable_manifest_output", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.filter.0.key_name_constraint.0.match_any_prefix.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_generator.0.s3_job_manifest_generator.0.source_bucket", "aws_s3_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.storage_class", "STANDARD_IA"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_computeObjectChecksum(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_computeObjectChecksum(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_compute_object_checksum.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_compute_object_checksum.0.checksum_algorithm", "CRC64NVME"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_compute_object_checksum.0.checksum_type", "FULL_OBJECT"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_job" {
+				continue
+			}
+
+			// Jobs can't be deleted, only cancelled.
+			output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAccountID], rs.Primary.Attributes["job_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			switch output.Status {
+			case awstypes.JobStatusCancelled, awstypes.JobStatusCancelling, awstypes.JobStatusComplete, awstypes.JobStatusFailed:
+				continue
+			}
+
+			return fmt.Errorf("S3 Batch Operations Job %s still %s", rs.Primary.ID, output.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		_, err := tfs3control.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAccountID], rs.Primary.Attributes["job_id"])
+
+		return err
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  count = 2
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/object-${count.index}"
+  content = "test"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = join("\n", [for o in aws_s3_object.test : "${o.bucket},${o.key}"])
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      }
+      Effect = "Allow"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:GetObjectTagging",
+        "s3:GetObjectVersion",
+        "s3:ListBucket",
+        "s3:PutInventoryConfiguration",
+        "s3:PutObject",
+        "s3:PutObjectTagging",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set {
+        key   = "classification"
+        value = "internal"
+      }
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.test.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "AllTasks"
+  }
+}
+`)
+}
+
+func testAccJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccJobConfig_confirmation(rName string, priority int, requestedJobStatus string) string {
+	if requestedJobStatus == "" {
+		requestedJobStatus = "null"
+	} else {
+		requestedJobStatus = fmt.Sprintf("%q", requestedJobStatus)
+	}
+
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = %[1]d
+  requested_job_status  = %[2]s
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set {
+        key   = "classification"
+        value = "internal"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, priority, requestedJobStatus))
+}
+
+func testAccJobConfig_manifestGenerator(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest_generator {
+    s3_job_manifest_generator {
+      enable_manifest_output = false
+      source_bucket          = aws_s3_bucket.test.arn
+
+      filter {
+        key_name_constraint {
+          match_any_prefix = ["data/object-0"]
+        }
+      }
+    }
+  }
+
+  operation {
+    s3_put_object_copy {
+      storage_class   = "STANDARD_IA"
+      target_resource = aws_s3_bucket.test.arn
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.test.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "FailedTasksOnly"
+  }
+}
+`)
+}
+
+func testAccJobConfig_computeObjectChecksum(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest_generator {
+    s3_job_manifest_generator {
+      enable_manifest_output = false
+      source_bucket          = aws_s3_bucket.test.arn
+
+      filter {
+        key_name_constraint {
+          match_any_prefix = ["data/object-0"]
+        }
+      }
+    }
+  }
+
+  operation {
+    s3_compute_object_checksum {
+      checksum_algorithm = "CRC64NVME"
+      checksum_type      = "FULL_OBJECT"
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.test.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "FailedTasksOnly"
+  }
+}
+`)
+}
@@ -43,6 +43,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Name:     "Access Grants Location",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  newJobResource,
+			TypeName: "aws_s3control_job",
+			Name:     "Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -133,6 +139,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*s3control.Options){
 		s3control.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *s3control.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
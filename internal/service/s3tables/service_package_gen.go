@@ -18,6 +18,16 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newListResourceTableBucket,
+			TypeName: "aws_s3tables_table_bucket",
+			Name:     "Table Bucket",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
@@ -34,6 +44,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceTableBucket,
 			TypeName: "aws_s3tables_table_bucket",
 			Name:     "Table Bucket",
+			Identity: &types.ServicePackageResourceIdentity{
+				IsARN: true,
+				Attributes: []types.ServicePackageResourceIdentityAttribute{
+					{
+						Name: names.AttrARN,
+					},
+				},
+			},
 		},
 		{
 			Factory:  newResourceTableBucketPolicy,
@@ -66,6 +84,9 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*s3tables.Options){
 		s3tables.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *s3tables.Options) {
+			o.APIOptions = append(o.APIOptions, conns.APIOptions(ctx, config)...)
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -111,6 +112,11 @@ func sweepTables(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 	return sweepResources, nil
 }
 
+// @FrameworkListResource("aws_s3tables_table_bucket", name="Table Bucket")
+func newListResourceTableBucket(context.Context) (list.ListResourceWithConfigure, error) {
+	return framework.NewListResource(newResourceTableBucket, sweepTableBuckets), nil
+}
+
 func sweepTableBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.S3TablesClient(ctx)
 
@@ -34,6 +34,7 @@ import (
 )
 
 // @FrameworkResource("aws_s3tables_table_bucket", name="Table Bucket")
+// @ArnIdentity
 func newResourceTableBucket(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTableBucket{}, nil
 }
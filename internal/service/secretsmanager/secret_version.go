@@ -12,14 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -58,7 +61,7 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
+				ConflictsWith: []string{"secret_string", "secret_string_wo"},
 				ValidateFunc:  verify.ValidBase64String,
 			},
 			"secret_string": {
@@ -66,7 +69,18 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				ConflictsWith: []string{"secret_binary", "secret_string_wo"},
+			},
+			"secret_string_wo": sdkv2.WriteOnlyStringSchema("secret_string_wo", "secret_binary", "secret_string"),
+			"secret_string_wo_version": func() *schema.Schema {
+				schema := sdkv2.WriteOnlyVersionSchema("secret_string_wo")
+				// Secret versions are immutable, so a new value requires a new version.
+				schema.ForceNew = true
+				return schema
+			}(),
+			"has_secret_string_wo": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -79,6 +93,10 @@ func resourceSecretVersion() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("secret_string"), cty.GetAttrPath("secret_string_wo")),
+		},
 	}
 }
 
@@ -102,6 +120,15 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		input.SecretString = aws.String(v.(string))
 	}
 
+	secretStringWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_string_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if secretStringWO != "" {
+		input.SecretString = aws.String(secretStringWO)
+	}
+
 	if v, ok := d.GetOk("version_stages"); ok && v.(*schema.Set).Len() > 0 {
 		input.VersionStages = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -114,6 +141,7 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 
 	versionID := aws.ToString(output.VersionId)
 	d.SetId(secretVersionCreateResourceID(secretID, versionID))
+	d.Set("has_secret_string_wo", secretStringWO != "")
 
 	_, err = tfresource.RetryWhenNotFound(ctx, PropagationTimeout, func() (interface{}, error) {
 		return findSecretVersionByTwoPartKey(ctx, conn, secretID, versionID)
@@ -150,7 +178,10 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrARN, output.ARN)
 	d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// A value set via the write-only attribute is never persisted to state.
+	if !d.Get("has_secret_string_wo").(bool) {
+		d.Set("secret_string", output.SecretString)
+	}
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
@@ -13,6 +13,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
@@ -54,6 +55,45 @@ func TestAccSecretsManagerSecretVersion_basicString(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_stringWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version1, version2 secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version1),
+					testAccCheckSecretVersionString(&version1, "test-string"),
+					resource.TestCheckResourceAttr(resourceName, "has_secret_string_wo", acctest.CtTrue),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string-updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version2),
+					testAccCheckSecretVersionString(&version2, "test-string-updated"),
+					resource.TestCheckResourceAttr(resourceName, "has_secret_string_wo", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_base64Binary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var version secretsmanager.GetSecretValueOutput
@@ -322,6 +362,16 @@ func testAccCheckSecretVersionExists(ctx context.Context, n string, v *secretsma
 	}
 }
 
+func testAccCheckSecretVersionString(v *secretsmanager.GetSecretValueOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(v.SecretString); got != want {
+			return fmt.Errorf("Secrets Manager Secret Version secret string = %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccSecretVersionConfig_string(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -335,6 +385,20 @@ resource "aws_secretsmanager_secret_version" "test" {
 `, rName)
 }
 
+func testAccSecretVersionConfig_stringWriteOnly(rName, secretString string, secretStringVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id                = aws_secretsmanager_secret.test.id
+  secret_string_wo         = %[2]q
+  secret_string_wo_version = %[3]d
+}
+`, rName, secretString, secretStringVersion)
+}
+
 func testAccSecretVersionConfig_binary(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
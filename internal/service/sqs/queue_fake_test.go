@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestQueueFake_basic(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := acctest.Context(t)
+	backend := fakeaws.New()
+	conn := sqs.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+	rName := "fake-queue"
+	resourceName := "aws_sqs_queue.test"
+	queueURL := fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", fakeaws.DefaultRegion, fakeaws.DefaultAccountID, rName)
+
+	acctest.FakeTest(ctx, t, backend, resource.TestCase{
+		CheckDestroy: testQueueFakeCheckDestroy(ctx, conn),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testQueueFakeCheckAttribute(ctx, conn, queueURL, awstypes.QueueAttributeNameVisibilityTimeout, "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, fmt.Sprintf("arn:aws:sqs:%s:%s:%s", fakeaws.DefaultRegion, fakeaws.DefaultAccountID, rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrURL, queueURL),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testQueueFakeCheckAttribute(ctx, conn, queueURL, awstypes.QueueAttributeNameVisibilityTimeout, "60"),
+					resource.TestCheckResourceAttr(resourceName, "delay_seconds", "90"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+				),
+			},
+			// Drift is detected and corrected.
+			{
+				PreConfig: func() {
+					if _, err := conn.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+						Attributes: map[string]string{
+							string(awstypes.QueueAttributeNameVisibilityTimeout): "120",
+						},
+						QueueUrl: aws.String(queueURL),
+					}); err != nil {
+						t.Fatalf("modifying SQS Queue (%s): %s", queueURL, err)
+					}
+				},
+				Config: testAccQueueConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testQueueFakeCheckAttribute(ctx, conn, queueURL, awstypes.QueueAttributeNameVisibilityTimeout, "60"),
+			},
+		},
+	})
+}
+
+func testQueueFakeCheckAttribute(ctx context.Context, conn *sqs.Client, url string, name awstypes.QueueAttributeName, want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		output, err := tfsqs.FindQueueAttributesByURL(ctx, conn, url)
+
+		if err != nil {
+			return err
+		}
+
+		if got := output[name]; got != want {
+			return fmt.Errorf("SQS Queue (%s) %s = %q, want %q", url, name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testQueueFakeCheckDestroy(ctx context.Context, conn *sqs.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue" {
+				continue
+			}
+
+			_, err := tfsqs.FindQueueAttributesByURL(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SQS Queue %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -68,11 +71,15 @@ func resourceParameter() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"has_value_wo": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"insecure_value": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
 			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
@@ -114,8 +121,14 @@ func resourceParameter() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
 			},
+			"value_wo": func() *schema.Schema {
+				schema := sdkv2.WriteOnlyStringSchema("value_wo")
+				schema.ExactlyOneOf = []string{"insecure_value", names.AttrValue, "value_wo"}
+				return schema
+			}(),
+			"value_wo_version": sdkv2.WriteOnlyVersionSchema("value_wo"),
 			names.AttrVersion: {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -129,7 +142,7 @@ func resourceParameter() *schema.Resource {
 				return awstypes.ParameterTier(old.(string)) == awstypes.ParameterTierAdvanced && awstypes.ParameterTier(new.(string)) == awstypes.ParameterTierStandard
 			}),
 			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange(names.AttrValue)
+				return diff.HasChanges(names.AttrValue, "value_wo_version")
 			}),
 			customdiff.ComputedIf(names.AttrValue, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("insecure_value")
@@ -140,6 +153,10 @@ func resourceParameter() *schema.Resource {
 
 			verify.SetTagsDiff,
 		),
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrValue), cty.GetAttrPath("value_wo")),
+		},
 	}
 }
 
@@ -153,6 +170,14 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 	if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 		value = v
 	}
+	valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if valueWO != "" {
+		value = valueWO
+	}
 	input := &ssm.PutParameterInput{
 		AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 		Name:           aws.String(name),
@@ -207,6 +232,7 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(name)
+	d.Set("has_value_wo", valueWO != "")
 
 	return append(diags, resourceParameterRead(ctx, d, meta)...)
 }
@@ -250,7 +276,8 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	if _, ok := d.GetOk("insecure_value"); ok && param.Type != awstypes.ParameterTypeSecureString {
 		d.Set("insecure_value", param.Value)
-	} else {
+	} else if !d.Get("has_value_wo").(bool) {
+		// A value set via the write-only attribute is never persisted to state.
 		d.Set(names.AttrValue, param.Value)
 	}
 
@@ -289,6 +316,14 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 			value = v
 		}
+		valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+		if valueWO != "" {
+			value = valueWO
+		}
 		input := &ssm.PutParameterInput{
 			AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 			Name:           aws.String(d.Id()),
@@ -326,6 +361,8 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SSM Parameter (%s): %s", d.Id(), err)
 		}
+
+		d.Set("has_value_wo", valueWO != "")
 	}
 
 	return append(diags, resourceParameterRead(ctx, d, meta)...)
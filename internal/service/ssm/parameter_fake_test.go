@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/fakeaws"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParameterFake_basic(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := acctest.Context(t)
+	backend := fakeaws.New()
+	conn := ssm.NewFromConfig(backend.Config(fakeaws.DefaultRegion))
+	name := "/fake/parameter"
+	resourceName := "aws_ssm_parameter.test"
+
+	acctest.FakeTest(ctx, t, backend, resource.TestCase{
+		CheckDestroy: testParameterFakeCheckDestroy(ctx, conn),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_basic(name, "String", "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testParameterFakeCheckValue(ctx, conn, name, "test1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, fmt.Sprintf("arn:aws:ssm:%s:%s:parameter%s", fakeaws.DefaultRegion, fakeaws.DefaultAccountID, name)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParameterConfig_basic(name, "String", "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testParameterFakeCheckValue(ctx, conn, name, "test2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			// Drift is detected and corrected.
+			{
+				PreConfig: func() {
+					if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+						Name:      aws.String(name),
+						Overwrite: aws.Bool(true),
+						Value:     aws.String("drifted"),
+					}); err != nil {
+						t.Fatalf("modifying SSM Parameter (%s): %s", name, err)
+					}
+				},
+				Config: testAccParameterConfig_basic(name, "String", "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testParameterFakeCheckValue(ctx, conn, name, "test2"),
+			},
+		},
+	})
+}
+
+func testParameterFakeCheckValue(ctx context.Context, conn *ssm.Client, name, want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		output, err := tfssm.FindParameterByName(ctx, conn, name, true)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.Value); got != want {
+			return fmt.Errorf("SSM Parameter (%s) value = %q, want %q", name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testParameterFakeCheckDestroy(ctx context.Context, conn *ssm.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter" {
+				continue
+			}
+
+			_, err := tfssm.FindParameterByName(ctx, conn, rs.Primary.ID, false)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Parameter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
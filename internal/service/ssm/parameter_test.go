@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -809,6 +810,55 @@ func TestAccSSMParameter_Secure_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_Secure_writeOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param awstypes.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_secureWriteOnly(name, "secret", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					testAccCheckParameterValue(&param, "secret"),
+					resource.TestCheckResourceAttr(resourceName, "has_value_wo", acctest.CtTrue),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrValue),
+					resource.TestCheckNoResourceAttr(resourceName, "value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				Config: testAccParameterConfig_secureWriteOnly(name, "updated-secret", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					testAccCheckParameterValue(&param, "updated-secret"),
+					resource.TestCheckResourceAttr(resourceName, "has_value_wo", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			{
+				Config: testAccParameterConfig_secure(name, "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "has_value_wo", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "secret"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_Secure_insecure(t *testing.T) {
 	ctx := acctest.Context(t)
 	var param awstypes.Parameter
@@ -1097,6 +1147,16 @@ func testAccCheckParameterRecreated(t *testing.T, before, after *awstypes.Parame
 	}
 }
 
+func testAccCheckParameterValue(v *awstypes.Parameter, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(v.Value); got != want {
+			return fmt.Errorf("SSM Parameter value = %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckParameterExists(ctx context.Context, n string, v *awstypes.Parameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1462,6 +1522,18 @@ resource "aws_ssm_parameter" "test" {
 `, rName, value)
 }
 
+func testAccParameterConfig_secureWriteOnly(rName string, value string, valueVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name             = "test_secure_parameter-%[1]s"
+  description      = "description for parameter %[1]s"
+  type             = "SecureString"
+  value_wo         = %[2]q
+  value_wo_version = %[3]d
+}
+`, rName, value, valueVersion)
+}
+
 func testAccParameterConfig_secureKey(rName string, value string, keyAlias string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+// Identifier is implemented by Sweepables that can identify the resource that they delete.
+type Identifier interface {
+	ID() string
+}
+
+// orchestrator holds the sweeper engine's settings for SweepOrchestrator.
+var orchestrator struct {
+	mu             sync.Mutex
+	dryRun         bool
+	maxConcurrency int
+	recorder       *dryRunRecorder
+}
+
+func configureOrchestrator(options EngineOptions) func() {
+	orchestrator.mu.Lock()
+	defer orchestrator.mu.Unlock()
+
+	orchestrator.dryRun = options.DryRun
+	orchestrator.maxConcurrency = options.MaxConcurrency
+
+	return func() {
+		orchestrator.mu.Lock()
+		defer orchestrator.mu.Unlock()
+
+		orchestrator.dryRun = false
+		orchestrator.maxConcurrency = 0
+		orchestrator.recorder = nil
+	}
+}
+
+func orchestratorSettings() (bool, int, *dryRunRecorder) {
+	orchestrator.mu.Lock()
+	defer orchestrator.mu.Unlock()
+
+	return orchestrator.dryRun, orchestrator.maxConcurrency, orchestrator.recorder
+}
+
+// dryRunRecorder records the resources that SweepOrchestrator would have deleted
+// and the AWS API requests that were blocked.
+type dryRunRecorder struct {
+	mu        sync.Mutex
+	resources []string
+	blocked   []string
+}
+
+func startDryRun() *dryRunRecorder {
+	orchestrator.mu.Lock()
+	defer orchestrator.mu.Unlock()
+
+	orchestrator.recorder = &dryRunRecorder{
+		resources: make([]string, 0),
+	}
+
+	return orchestrator.recorder
+}
+
+func (r *dryRunRecorder) record(sweepables []Sweepable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range sweepables {
+		id := "(unknown)"
+		if v, ok := v.(Identifier); ok {
+			id = v.ID()
+		}
+		r.resources = append(r.resources, id)
+	}
+}
+
+func (r *dryRunRecorder) block(request string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.blocked = append(r.blocked, request)
+}
+
+// stop stops recording, returning the recorded resources and blocked requests.
+func (r *dryRunRecorder) stop() ([]string, []string) {
+	orchestrator.mu.Lock()
+	defer orchestrator.mu.Unlock()
+
+	orchestrator.recorder = nil
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.resources, r.blocked
+}
+
+// newReadOnlyHTTPClient returns an HTTP client for AWS APIs that blocks requests which may modify resources.
+func newReadOnlyHTTPClient() *http.Client {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	httpClient.Transport = readOnlyTransport{next: transport}
+
+	return httpClient
+}
+
+// readOnlyTransport is an http.RoundTripper that fails AWS API requests for operations that may modify resources.
+// It guards against sweepers that delete resources without using SweepOrchestrator during a dry run.
+// Some read operations, e.g. those of REST APIs that use POST, are also blocked.
+// Blocked requests are recorded so that the sweeper can be reported as not dry-run safe.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+var readOnlyOperationPrefixes = []string{
+	"AssumeRole",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func (t readOnlyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// Only signed AWS API requests are checked.
+	// Unsigned requests, for example to the EC2 Instance Metadata Service, are used to obtain credentials.
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-") {
+		return t.next.RoundTrip(r)
+	}
+
+	operation, err := requestOperation(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation == "" {
+		// REST APIs.
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return t.next.RoundTrip(r)
+		}
+		return nil, block(fmt.Sprintf("%s %s request to %s", r.Method, r.URL.Path, r.URL.Host))
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return t.next.RoundTrip(r)
+		}
+	}
+
+	return nil, block(fmt.Sprintf("%s request to %s", operation, r.URL.Host))
+}
+
+// block records a blocked request and returns the error that fails it.
+func block(request string) error {
+	if _, _, recorder := orchestratorSettings(); recorder != nil {
+		recorder.block(request)
+	}
+
+	return fmt.Errorf("sweeper dry run: blocked %s", request)
+}
+
+// requestOperation returns the name of the AWS API operation for a JSON, Smithy RPC v2 CBOR or Query protocol request.
+func requestOperation(r *http.Request) (string, error) {
+	// JSON protocol, e.g. "DynamoDB_20120810.ListTables".
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		_, operation, _ := strings.Cut(v, ".")
+		return operation, nil
+	}
+
+	// Smithy RPC v2 CBOR protocol, e.g. "/service/GraniteServiceVersion20100801/operation/ListMetrics".
+	if r.Header.Get("Smithy-Protocol") != "" {
+		return path.Base(r.URL.Path), nil
+	}
+
+	// Query and EC2 protocols.
+	if r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && r.Body != nil {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return "", err
+		}
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+
+		return values.Get("Action"), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+var (
+	// sweepers is the registry of sweepers run by the sweeper engine, keyed by name.
+	sweepers   = make(map[string]*resource.Sweeper)
+	sweepersMu sync.Mutex
+)
+
+// AddTestSweepers registers a sweeper with the sweeper engine and the acceptance test framework.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+// EngineOptions configures an Engine.
+type EngineOptions struct {
+	// AllowFailures continues sweeping after a sweeper fails.
+	AllowFailures bool
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun bool
+	// Filter is a comma-separated list of the names, or name prefixes, of the sweepers to run.
+	// Each sweeper's dependencies are also run. An empty Filter runs all sweepers.
+	Filter string
+	// MaxConcurrency is the maximum number of concurrent deletions per service. Zero means no limit.
+	MaxConcurrency int
+}
+
+// Engine runs sweepers in waves ordered by their dependencies.
+// A sweeper's dependencies are run in earlier waves and the sweepers in a wave are run concurrently.
+// The sweepers for a service are run one at a time.
+type Engine struct {
+	options  EngineOptions
+	sweepers map[string]*resource.Sweeper
+	services map[string]string // Sweeper name to service package name.
+}
+
+// NewEngine returns an Engine that runs the registered sweepers.
+func NewEngine(ctx context.Context, options EngineOptions) *Engine {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	return newEngine(ctx, options, sweepers)
+}
+
+func newEngine(ctx context.Context, options EngineOptions, sweepers map[string]*resource.Sweeper) *Engine {
+	services := make(map[string]string)
+	for _, sp := range ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+		for _, v := range sp.SDKResources(ctx) {
+			services[v.TypeName] = servicePackageName
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			services[v.TypeName] = servicePackageName
+		}
+	}
+
+	return &Engine{
+		options:  options,
+		sweepers: sweepers,
+		services: services,
+	}
+}
+
+// Waves returns the names of the sweepers to run, grouped into waves.
+// Dependencies on sweepers that are not registered are ignored.
+func (e *Engine) Waves(ctx context.Context) ([][]string, error) {
+	g := depgraph.New()
+
+	var add func(name string) error
+	add = func(name string) error {
+		if g.HasNode(name) {
+			return nil
+		}
+
+		g.AddNode(name)
+
+		for _, dependency := range e.sweepers[name].Dependencies {
+			if _, ok := e.sweepers[dependency]; !ok {
+				tflog.Warn(ctx, "Sweeper dependency not found", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+				continue
+			}
+
+			if err := add(dependency); err != nil {
+				return err
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	names := make([]string, 0, len(e.sweepers))
+	for name := range e.sweepers {
+		if e.selected(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+
+	waves, err := g.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	for _, v := range waves {
+		slices.Sort(v)
+	}
+
+	return waves, nil
+}
+
+func (e *Engine) selected(name string) bool {
+	if e.options.Filter == "" {
+		return true
+	}
+
+	for _, v := range strings.Split(strings.ToLower(e.options.Filter), ",") {
+		if strings.HasPrefix(strings.ToLower(name), v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Engine) service(name string) string {
+	if v, ok := e.services[name]; ok {
+		return v
+	}
+
+	return name
+}
+
+// Run runs the sweepers in each of the specified Regions and returns a report of the sweep.
+func (e *Engine) Run(ctx context.Context, regions []string) (*Report, error) {
+	waves, err := e.Waves(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		DryRun: e.options.DryRun,
+	}
+
+	restore := configureOrchestrator(e.options)
+	defer restore()
+
+	var errs []error
+	for _, region := range regions {
+		regionReport, err := e.runRegion(ctx, region, waves)
+		report.Regions = append(report.Regions, regionReport)
+
+		if err != nil {
+			errs = append(errs, err)
+
+			if !e.options.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return report, errors.Join(errs...)
+}
+
+func (e *Engine) runRegion(ctx context.Context, region string, waves [][]string) (RegionReport, error) {
+	report := RegionReport{
+		Region: region,
+	}
+
+	var errs []error
+	for i, wave := range waves {
+		tflog.Info(ctx, "Running sweeper wave", map[string]any{
+			"region":   region,
+			"wave":     i + 1,
+			"sweepers": wave,
+		})
+
+		reports, err := e.runWave(region, wave)
+
+		for j := range reports {
+			reports[j].Wave = i + 1
+		}
+		report.ResourceTypes = append(report.ResourceTypes, reports...)
+
+		if err != nil {
+			errs = append(errs, err)
+
+			if !e.options.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// runWave runs a wave of sweepers.
+// In a dry run the sweepers are run one at a time so that the listed resources can be attributed to each sweeper.
+func (e *Engine) runWave(region string, wave []string) ([]ResourceTypeReport, error) {
+	reports := make([]ResourceTypeReport, len(wave))
+	for i, name := range wave {
+		reports[i] = ResourceTypeReport{
+			Name:    name,
+			Service: e.service(name),
+		}
+	}
+
+	if e.options.DryRun {
+		for i := range reports {
+			e.dryRunSweeper(region, &reports[i])
+		}
+	} else {
+		byService := make(map[string][]*ResourceTypeReport)
+		for i := range reports {
+			byService[reports[i].Service] = append(byService[reports[i].Service], &reports[i])
+		}
+
+		var wg sync.WaitGroup
+		for _, v := range byService {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for _, report := range v {
+					e.runSweeper(region, report)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	var errs []error
+	for _, v := range reports {
+		// A sweeper that isn't dry-run safe most likely failed because a request was blocked.
+		if v.Error != "" && v.Status != StatusNotDryRunSafe {
+			errs = append(errs, fmt.Errorf("sweeper (%s) in %s: %s", v.Name, region, v.Error))
+		}
+	}
+
+	return reports, errors.Join(errs...)
+}
+
+func (e *Engine) runSweeper(region string, report *ResourceTypeReport) {
+	start := time.Now()
+	if err := e.sweepers[report.Name].F(region); err != nil {
+		report.Error = err.Error()
+	}
+	report.Duration = time.Since(start).Round(time.Second).String()
+}
+
+func (e *Engine) dryRunSweeper(region string, report *ResourceTypeReport) {
+	recorder := startDryRun()
+	e.runSweeper(region, report)
+	report.Resources, report.BlockedRequests = recorder.stop()
+	report.Count = len(report.Resources)
+	if len(report.BlockedRequests) > 0 {
+		report.Status = StatusNotDryRunSafe
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithReader is implemented by list resources that can read each listed resource's state
+// using a caller-supplied resource implementation, e.g. one wrapped with the provider's interceptors.
+type ListResourceWithReader interface {
+	list.ListResourceWithConfigure
+	ListWithReader(context.Context, list.ListRequest, *list.ListResultsStream, fwresource.Resource)
+}
+
+var _ ListResourceWithReader = &listResource{}
+
+type listResource struct {
+	factory func(context.Context) (fwresource.ResourceWithConfigure, error)
+	lister  sweep.SweeperFn
+	meta    *conns.AWSClient
+}
+
+// NewListResource returns a list resource that enumerates resources using the specified sweeper lister.
+// Each listed resource's state is populated by the resource's Read method, starting from the
+// attributes the lister sets for sweeping.
+// Only listers of Framework resources are supported, as listed resources must publish a resource identity.
+func NewListResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), lister sweep.SweeperFn) *listResource {
+	return &listResource{
+		factory: factory,
+		lister:  lister,
+	}
+}
+
+func (l *listResource) Metadata(ctx context.Context, request fwresource.MetadataRequest, response *fwresource.MetadataResponse) {
+	resource, err := l.factory(ctx)
+
+	if err != nil {
+		return
+	}
+
+	resource.Metadata(ctx, request, response)
+}
+
+func (l *listResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				CustomType:  fwtypes.RegexpType,
+				Optional:    true,
+				Description: "Regular expression that listed resources' names must match.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that listed resources must have.",
+			},
+		},
+	}
+}
+
+func (l *listResource) Configure(_ context.Context, request fwresource.ConfigureRequest, response *fwresource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// List enumerates resources, reading each resource's state with an unwrapped instance of the resource.
+func (l *listResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	resource, err := l.factory(ctx)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("creating resource", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: l.meta}, &fwresource.ConfigureResponse{})
+
+	l.ListWithReader(ctx, request, stream, resource)
+}
+
+// ListWithReader enumerates resources, reading each resource's state with the specified resource.
+func (l *listResource) ListWithReader(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, reader fwresource.Resource) {
+	var diags diag.Diagnostics
+
+	var filter listResourceModel
+	diags.Append(request.Config.Get(ctx, &filter)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sweepables, err := l.lister(ctx, l.meta)
+
+	if err != nil {
+		diags.AddError("listing resources", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var n int64
+
+		for _, v := range sweepables {
+			if request.Limit > 0 && n >= request.Limit {
+				return
+			}
+
+			sr, ok := v.(*sweepResource)
+			if !ok {
+				result := request.NewListResult(ctx)
+				result.Diagnostics.AddError("listing resources", fmt.Sprintf("unsupported sweeper resource type: %T", v))
+				push(result)
+				return
+			}
+
+			result, ok := readListResult(ctx, request, sr, reader)
+			if !ok {
+				continue
+			}
+
+			if !result.Diagnostics.HasError() && !filter.matches(ctx, result) {
+				continue
+			}
+
+			if !request.IncludeResource {
+				result.Resource = nil
+			}
+
+			n++
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readListResult reads the state of a listed resource.
+// Returns false if the resource no longer exists.
+func readListResult(ctx context.Context, request list.ListRequest, sr *sweepResource, reader fwresource.Resource) (list.ListResult, bool) {
+	result := request.NewListResult(ctx)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(request.ResourceSchema.Type().TerraformType(ctx), nil),
+		Schema: request.ResourceSchema,
+	}
+
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(attr.path), attr.value)...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+	}
+
+	readRequest := fwresource.ReadRequest{
+		State: state,
+	}
+	readResponse := fwresource.ReadResponse{
+		State:    state,
+		Identity: result.Identity,
+	}
+
+	tflog.Debug(ctx, "Reading listed resource")
+
+	reader.Read(ctx, readRequest, &readResponse)
+	result.Diagnostics.Append(readResponse.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return result, true
+	}
+
+	// The resource was deleted between listing and reading.
+	if readResponse.State.Raw.IsNull() {
+		return result, false
+	}
+
+	result.Resource.Raw = readResponse.State.Raw
+	result.Identity = readResponse.Identity
+	result.DisplayName = displayName(ctx, readResponse.State)
+
+	return result, true
+}
+
+// displayName returns a human-readable description of a listed resource: its name, ID or ARN.
+func displayName(ctx context.Context, state tfsdk.State) string {
+	for _, attributeName := range []string{names.AttrName, names.AttrID, names.AttrARN} {
+		if _, ok := state.Schema.GetAttributes()[attributeName]; !ok {
+			continue
+		}
+
+		var v *string
+		if diags := state.GetAttribute(ctx, path.Root(attributeName), &v); diags.HasError() {
+			continue
+		}
+
+		if s := aws.ToString(v); s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+type listResourceModel struct {
+	NameRegex fwtypes.Regexp      `tfsdk:"name_regex"`
+	Tags      fwtypes.MapOfString `tfsdk:"tags"`
+}
+
+// matches returns whether the listed resource matches the list resource's filters.
+func (m listResourceModel) matches(ctx context.Context, result list.ListResult) bool {
+	if re := m.NameRegex.ValueRegexp(); re != nil && !re.MatchString(result.DisplayName) {
+		return false
+	}
+
+	if wantTags := fwflex.ExpandFrameworkStringValueMap(ctx, m.Tags); len(wantTags) > 0 {
+		attributeName := names.AttrTagsAll
+		if _, ok := result.Resource.Schema.GetAttributes()[attributeName]; !ok {
+			attributeName = names.AttrTags
+		}
+		if _, ok := result.Resource.Schema.GetAttributes()[attributeName]; !ok {
+			return false
+		}
+
+		var tags map[string]string
+		if diags := result.Resource.GetAttribute(ctx, path.Root(attributeName), &tags); diags.HasError() {
+			return false
+		}
+
+		for k, want := range wantTags {
+			if got, ok := tags[k]; !ok || got != want {
+				return false
+			}
+		}
+	}
+
+	return true
+}
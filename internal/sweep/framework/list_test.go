@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+// testResource is a minimal resource whose Read populates tags from a fixed table and
+// removes from state any resource not in the table.
+type testResource struct {
+	tags map[string]map[string]string
+}
+
+func newTestResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &testResource{
+		tags: map[string]map[string]string{
+			"alpha": {"env": "prod"},
+			"beta":  {"env": "test"},
+			"gamma": {"env": "prod", "team": "blue"},
+		},
+	}, nil
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test_resource"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *testResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var name string
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, ok := r.tags[name]
+	if !ok {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), tags)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), name)...)
+	}
+}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func testLister(names ...string) sweep.SweeperFn {
+	return func(_ context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+		var sweepResources []sweep.Sweepable
+
+		for _, name := range names {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newTestResource, client,
+				framework.NewAttribute("name", name)))
+		}
+
+		return sweepResources, nil
+	}
+}
+
+func TestListResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		names     []string
+		nameRegex tftypes.Value
+		tags      tftypes.Value
+		limit     int64
+		expected  []string
+	}{
+		"no filters": {
+			names:    []string{"alpha", "beta", "gamma"},
+			expected: []string{"alpha", "beta", "gamma"},
+		},
+		"deleted after listing": {
+			names:    []string{"alpha", "delta", "gamma"},
+			expected: []string{"alpha", "gamma"},
+		},
+		"name_regex": {
+			names:     []string{"alpha", "beta", "gamma"},
+			nameRegex: tftypes.NewValue(tftypes.String, "^(alpha|beta)$"),
+			expected:  []string{"alpha", "beta"},
+		},
+		"tags": {
+			names: []string{"alpha", "beta", "gamma"},
+			tags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+			expected: []string{"alpha", "gamma"},
+		},
+		"name_regex and tags": {
+			names:     []string{"alpha", "beta", "gamma"},
+			nameRegex: tftypes.NewValue(tftypes.String, "^g"),
+			tags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+			expected: []string{"gamma"},
+		},
+		"limit": {
+			names:    []string{"alpha", "beta", "gamma"},
+			limit:    2,
+			expected: []string{"alpha", "beta"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := framework.NewListResource(newTestResource, testLister(testCase.names...))
+
+			var schemaResponse list.ListResourceSchemaResponse
+			l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+			nameRegex, tags := testCase.nameRegex, testCase.tags
+			if nameRegex.Type() == nil {
+				nameRegex = tftypes.NewValue(tftypes.String, nil)
+			}
+			if tags.Type() == nil {
+				tags = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+			}
+			config := tfsdk.Config{
+				Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"name_regex": nameRegex,
+					"tags":       tags,
+				}),
+				Schema: schemaResponse.Schema,
+			}
+
+			r, _ := newTestResource(ctx)
+			var resourceSchemaResponse resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResponse)
+
+			request := list.ListRequest{
+				Config:          config,
+				IncludeResource: true,
+				Limit:           testCase.limit,
+				ResourceSchema:  resourceSchemaResponse.Schema,
+				ResourceIdentitySchema: identityschema.Schema{
+					Attributes: map[string]identityschema.Attribute{
+						"name": identityschema.StringAttribute{
+							RequiredForImport: true,
+						},
+					},
+				},
+			}
+			var stream list.ListResultsStream
+			l.List(ctx, request, &stream)
+
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %s", result.Diagnostics)
+				}
+
+				var identityName string
+				if diags := result.Identity.GetAttribute(ctx, path.Root("name"), &identityName); diags.HasError() {
+					t.Fatalf("reading identity: %s", diags)
+				}
+				if identityName != result.DisplayName {
+					t.Errorf("identity name %q does not match display name %q", identityName, result.DisplayName)
+				}
+				if result.Resource == nil {
+					t.Errorf("no resource for %q", result.DisplayName)
+				}
+
+				got = append(got, result.DisplayName)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
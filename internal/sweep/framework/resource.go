@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -118,6 +120,20 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ID returns the ID of the resource to be deleted.
+// Resources without an "id" attribute are identified by their attribute values.
+func (sr *sweepResource) ID() string {
+	parts := make([]string, 0, len(sr.attributes))
+	for _, v := range sr.attributes {
+		if v.path == names.AttrID {
+			return fmt.Sprint(v.value)
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", v.path, v.value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
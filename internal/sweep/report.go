@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Report is a report of a sweep.
+type Report struct {
+	DryRun  bool           `json:"dry_run"`
+	Regions []RegionReport `json:"regions"`
+}
+
+// RegionReport is a report of a sweep of a single Region.
+type RegionReport struct {
+	Region        string               `json:"region"`
+	ResourceTypes []ResourceTypeReport `json:"resource_types"`
+}
+
+// ResourceTypeReport is a report of a single sweeper's run.
+// Count, Resources, Status and BlockedRequests are only populated for dry runs.
+type ResourceTypeReport struct {
+	Name            string   `json:"name"`
+	Service         string   `json:"service"`
+	Wave            int      `json:"wave"`
+	Count           int      `json:"count,omitempty"`
+	Resources       []string `json:"resources,omitempty"`
+	Status          string   `json:"status,omitempty"`
+	BlockedRequests []string `json:"blocked_requests,omitempty"`
+	Duration        string   `json:"duration"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// StatusNotDryRunSafe is the dry run status of a sweeper that made AWS API requests which were blocked.
+// The sweeper's listed resources may be incomplete.
+const StatusNotDryRunSafe = "sweeper not dry-run safe"
+
+// WriteFile writes the report to the specified file.
+// The report is written as Markdown if the file's extension is ".md" and as JSON otherwise.
+func (r *Report) WriteFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".md") {
+		err = r.WriteMarkdown(f)
+	} else {
+		err = r.WriteJSON(f)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteMarkdown writes the report as Markdown.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var sb strings.Builder
+
+	if r.DryRun {
+		sb.WriteString("# Sweeper Dry Run Report\n")
+	} else {
+		sb.WriteString("# Sweeper Report\n")
+	}
+
+	for _, region := range r.Regions {
+		fmt.Fprintf(&sb, "\n## %s\n\n", region.Region)
+
+		if r.DryRun {
+			sb.WriteString("| Wave | Service | Sweeper | Resources | Status | Duration | Error |\n")
+			sb.WriteString("|------|---------|---------|-----------|--------|----------|-------|\n")
+		} else {
+			sb.WriteString("| Wave | Service | Sweeper | Duration | Error |\n")
+			sb.WriteString("|------|---------|---------|----------|-------|\n")
+		}
+
+		for _, v := range region.ResourceTypes {
+			if r.DryRun {
+				fmt.Fprintf(&sb, "| %d | %s | %s | %d | %s | %s | %s |\n", v.Wave, v.Service, v.Name, v.Count, v.Status, v.Duration, markdownTableCell(v.Error))
+			} else {
+				fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s |\n", v.Wave, v.Service, v.Name, v.Duration, markdownTableCell(v.Error))
+			}
+		}
+
+		for _, v := range region.ResourceTypes {
+			if len(v.Resources) == 0 && len(v.BlockedRequests) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(&sb, "\n### %s\n\n", v.Name)
+			for _, resource := range v.Resources {
+				fmt.Fprintf(&sb, "- `%s`\n", resource)
+			}
+
+			if len(v.BlockedRequests) > 0 {
+				if len(v.Resources) > 0 {
+					sb.WriteString("\n")
+				}
+				sb.WriteString("Blocked requests:\n\n")
+				for _, request := range v.BlockedRequests {
+					fmt.Fprintf(&sb, "- %s\n", request)
+				}
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func markdownTableCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(s)
+}
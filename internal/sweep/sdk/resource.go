@@ -89,6 +89,11 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ID returns the ID of the resource to be deleted.
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -31,10 +32,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients   map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsMu sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	// The sweeper engine runs sweepers concurrently.
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -59,6 +67,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.SetServicePackages(ctx, servicePackageMap)
 
+	if dryRun, _, _ := orchestratorSettings(); dryRun {
+		meta.SetHTTPClient(ctx, newReadOnlyHTTPClient())
+	}
+
 	conf := &conns.Config{
 		MaxRetries:       5,
 		Region:           region,
@@ -87,7 +99,7 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 			ar.SessionName = v
 		}
 
-		conf.AssumeRole = []awsbase.AssumeRole{ar}
+		conf.AssumeRole = []conns.AssumeRole{{AssumeRole: ar}}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -106,15 +118,48 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// When run by the sweeper engine the number of concurrent deletions may be limited, and during a dry run no resources are deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	dryRun, maxConcurrency, recorder := orchestratorSettings()
+
+	if dryRun {
+		if recorder != nil {
+			recorder.record(sweepables)
+		}
+
+		for _, sweepable := range sweepables {
+			if v, ok := sweepable.(Identifier); ok {
+				tflog.Info(ctx, "Dry run, not deleting resource", map[string]any{
+					"id": v.ID(),
+				})
+			}
+		}
+
+		return nil
+	}
+
 	var g multierror.Group
+	var sem chan struct{}
+
+	if maxConcurrency > 0 {
+		sem = make(chan struct{}, maxConcurrency)
+	}
 
 	for _, sweepable := range sweepables {
+		if sem != nil {
+			sem <- struct{}{}
+		}
+
 		g.Go(func() error {
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+
 			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
 		})
 	}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMain runs the sweeper engine if the -sweep flag is set and otherwise runs the tests.
+// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the acceptance test framework.
+func TestMain(m interface {
+	Run() int
+}) {
+	// The flags are defined here rather than at package level so that they are only registered in the sweeper binary.
+	flagSweepDryRun := flag.Bool("sweep-dry-run", false, "List the resources that Sweepers would delete without deleting them")
+	flagSweepMaxConcurrency := flag.Int("sweep-max-concurrency", 10, "Maximum number of concurrent deletions per service, 0 for no limit")
+	flagSweepReport := flag.String("sweep-report", "", "File to write a report of the sweep to, as Markdown if the file extension is .md and as JSON otherwise")
+
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	ctx := context.Background()
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	engine := NewEngine(ctx, EngineOptions{
+		AllowFailures:  allowFailures,
+		DryRun:         *flagSweepDryRun,
+		Filter:         flagValue("sweep-run"),
+		MaxConcurrency: *flagSweepMaxConcurrency,
+	})
+
+	report, err := engine.Run(ctx, strings.Split(regions, ","))
+
+	if report != nil && *flagSweepReport != "" {
+		if err := report.WriteFile(*flagSweepReport); err != nil {
+			fmt.Fprintf(os.Stderr, "writing sweeper report (%s): %s\n", *flagSweepReport, err)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sweeper engine: %s\n", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
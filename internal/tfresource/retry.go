@@ -278,6 +278,8 @@ type Options struct {
 	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often
 	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
 	ContinuousTargetOccurence int           // Number of times the Target state has to occur continuously
+	MaxPollInterval           time.Duration // Largest time to wait between refreshes (StateWaiter only)
+	ProgressInterval          time.Duration // How often to log that a wait is still in progress (StateWaiter only)
 }
 
 func (o Options) Apply(c *retry.StateChangeConf) {
@@ -341,6 +343,18 @@ func WithContinuousTargetOccurence(continuousTargetOccurence int) OptionsFunc {
 	}
 }
 
+func WithMaxPollInterval(maxPollInterval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.MaxPollInterval = maxPollInterval
+	}
+}
+
+func WithProgressInterval(progressInterval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.ProgressInterval = progressInterval
+	}
+}
+
 // Retry allows configuration of StateChangeConf's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems.
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+const (
+	defaultStateWaiterMinPollInterval  = 5 * time.Second
+	defaultStateWaiterMaxPollInterval  = 30 * time.Second
+	defaultStateWaiterNotFoundChecks   = 20
+	defaultStateWaiterProgressInterval = 1 * time.Minute
+	stateWaiterBackoffMultiplier       = 1.5
+)
+
+// StateWaiter waits for a resource to transition between states.
+// It is declared once per resource type from the resource's status and, optionally, failure reason accessors
+// and is then used by the resource's create, update and delete waiters, replacing hand-written
+// retry.StateChangeConf and retry.StateRefreshFunc pairs.
+//
+//	var clusterWaiter = tfresource.NewStateWaiter("EKS Cluster", func(v *types.Cluster) string {
+//		return string(v.Status)
+//	})
+//
+//	func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
+//		return clusterWaiter.WaitFor(ctx, func(ctx context.Context) (*types.Cluster, error) {
+//			return findClusterByName(ctx, conn, name)
+//		}, enum.Slice(types.ClusterStatusCreating), enum.Slice(types.ClusterStatusActive), timeout)
+//	}
+//
+// Errors returned are of the same types as those returned by retry.StateChangeConf
+// so that NotFound, TimedOut and SetLastError continue to work.
+type StateWaiter[T any] struct {
+	name          string
+	status        func(T) string
+	failureReason func(T) error
+}
+
+type StateWaiterOptionsFunc[T any] func(*StateWaiter[T])
+
+// WithFailureReason sets a function that extracts the reason a resource is in an unexpected state.
+// The returned error, if not nil, is set as the LastError of the wait's error.
+func WithFailureReason[T any](f func(T) error) StateWaiterOptionsFunc[T] {
+	return func(w *StateWaiter[T]) {
+		w.failureReason = f
+	}
+}
+
+// NewStateWaiter returns a new StateWaiter.
+// name identifies the resource type in log messages, e.g. "RDS DB Instance".
+func NewStateWaiter[T any](name string, status func(T) string, optFns ...StateWaiterOptionsFunc[T]) *StateWaiter[T] {
+	w := &StateWaiter[T]{
+		name:   name,
+		status: status,
+	}
+
+	for _, fn := range optFns {
+		fn(w)
+	}
+
+	return w
+}
+
+// WaitFor calls `find` until the resource's status is one of `target`, returning the resource.
+// If `target` is empty the resource is waited for until `find` returns a retry.NotFoundError.
+// A status that is neither pending nor target stops the wait with a retry.UnexpectedStateError.
+// `find` is called with exponential backoff and jitter, bounded by the MinPollInterval and MaxPollInterval options
+// or at a fixed PollInterval, and progress is logged every ProgressInterval.
+// If the timeout expires, `find` is called once more and the wait succeeds if the resource has since reached a target status.
+func (w *StateWaiter[T]) WaitFor(ctx context.Context, find func(context.Context) (T, error), pending, target []string, timeout time.Duration, optFns ...OptionsFunc) (T, error) {
+	options := Options{
+		MinPollInterval:  defaultStateWaiterMinPollInterval,
+		MaxPollInterval:  defaultStateWaiterMaxPollInterval,
+		NotFoundChecks:   defaultStateWaiterNotFoundChecks,
+		ProgressInterval: defaultStateWaiterProgressInterval,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	backoff := retry.Options{
+		BackoffMinDuration: options.MinPollInterval,
+		BackoffMaxDuration: max(options.MinPollInterval, options.MaxPollInterval),
+		BackoffMultiplier:  stateWaiterBackoffMultiplier,
+	}
+	if options.PollInterval > 0 {
+		backoff = retry.Options{
+			BackoffMinDuration: options.PollInterval,
+			BackoffMaxDuration: options.PollInterval,
+			BackoffMultiplier:  1,
+		}
+	}
+	continuousTargetOccurence := max(options.ContinuousTargetOccurence, 1)
+
+	ctx = tflog.SetField(ctx, "waiter_resource", w.name)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		zero            T
+		last            T
+		lastState       string
+		notFoundCount   int
+		targetOccurence int
+		start           = time.Now()
+		lastProgress    = start
+	)
+
+	if options.Delay > 0 {
+		tflog.Debug(ctx, "Waiting before first status check", map[string]any{
+			"delay": options.Delay.String(),
+		})
+		if err := sleepContext(waitCtx, options.Delay); err != nil {
+			return zero, w.stopError(ctx, err, last, lastState, target, timeout)
+		}
+	}
+
+	for r := retry.BeginWithOptions(backoff); r.Continue(waitCtx); {
+		v, err := find(waitCtx)
+
+		if NotFound(err) {
+			if len(target) == 0 {
+				targetOccurence++
+				if targetOccurence >= continuousTargetOccurence {
+					tflog.Info(ctx, "Resource no longer exists", map[string]any{
+						"elapsed": time.Since(start).Round(time.Second).String(),
+					})
+					return zero, nil
+				}
+				continue
+			}
+
+			targetOccurence = 0
+			notFoundCount++
+			if notFoundCount > options.NotFoundChecks {
+				return zero, &sdkretry.NotFoundError{
+					LastError: err,
+					Retries:   notFoundCount,
+				}
+			}
+			tflog.Debug(ctx, "Resource not found, retrying", map[string]any{
+				"not_found_count": notFoundCount,
+			})
+			continue
+		}
+
+		if err != nil {
+			// The per-call context may have expired mid-request.
+			if waitCtx.Err() != nil {
+				break
+			}
+			return zero, err
+		}
+
+		notFoundCount = 0
+		last = v
+		state := w.status(v)
+
+		if state != lastState {
+			tflog.Info(ctx, "Resource status changed", map[string]any{
+				"status":          state,
+				"previous_status": lastState,
+				"elapsed":         time.Since(start).Round(time.Second).String(),
+			})
+			lastState = state
+			lastProgress = time.Now()
+		} else if options.ProgressInterval > 0 && time.Since(lastProgress) >= options.ProgressInterval {
+			tflog.Info(ctx, "Still waiting for resource", map[string]any{
+				"status":  state,
+				"target":  target,
+				"elapsed": time.Since(start).Round(time.Second).String(),
+			})
+			lastProgress = time.Now()
+		}
+
+		switch {
+		case slices.Contains(target, state):
+			targetOccurence++
+			if targetOccurence >= continuousTargetOccurence {
+				return v, nil
+			}
+
+		case slices.Contains(pending, state):
+			targetOccurence = 0
+
+		default:
+			err := &sdkretry.UnexpectedStateError{
+				State:         state,
+				ExpectedState: target,
+			}
+			w.setFailureReason(err, v)
+
+			return v, err
+		}
+	}
+
+	// As with Retry, check once more after the timeout in case the resource reached its target in the meantime.
+	if ctx.Err() == nil && errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+		v, err := find(ctx)
+
+		switch {
+		case NotFound(err):
+			if len(target) == 0 {
+				return zero, nil
+			}
+		case err == nil:
+			last, lastState = v, w.status(v)
+			if slices.Contains(target, lastState) {
+				return v, nil
+			}
+		}
+	}
+
+	return last, w.stopError(ctx, waitCtx.Err(), last, lastState, target, timeout)
+}
+
+// stopError returns the error for a wait stopped by its context.
+func (w *StateWaiter[T]) stopError(ctx context.Context, err error, last T, lastState string, target []string, timeout time.Duration) error {
+	// Cancellation of the caller's context is not a timeout.
+	if ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	timeoutErr := &sdkretry.TimeoutError{
+		LastState:     lastState,
+		Timeout:       timeout,
+		ExpectedState: target,
+	}
+	if lastState != "" {
+		w.setFailureReason(timeoutErr, last)
+	}
+
+	return timeoutErr
+}
+
+func (w *StateWaiter[T]) setFailureReason(err error, v T) {
+	if w.failureReason == nil {
+		return
+	}
+
+	if reason := w.failureReason(v); reason != nil {
+		SetLastError(err, reason)
+	}
+}
+
+// sleepContext sleeps for the specified duration or until the context is done, returning the context's error.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testWaiterResource struct {
+	status string
+	reason string
+}
+
+// testWaiterFinder returns a finder that returns each of the specified statuses in turn, repeating the last.
+// An empty status means "not found".
+func testWaiterFinder(statuses ...string) func(context.Context) (*testWaiterResource, error) {
+	i := 0
+
+	return func(context.Context) (*testWaiterResource, error) {
+		status := statuses[min(i, len(statuses)-1)]
+		i++
+
+		if status == "" {
+			return nil, &retry.NotFoundError{}
+		}
+
+		return &testWaiterResource{status: status, reason: "reason: " + status}, nil
+	}
+}
+
+func TestStateWaiterWaitFor(t *testing.T) {
+	t.Parallel()
+
+	waiter := tfresource.NewStateWaiter("Test Resource", func(v *testWaiterResource) string {
+		return v.status
+	}, tfresource.WithFailureReason(func(v *testWaiterResource) error {
+		return errors.New(v.reason)
+	}))
+
+	testCases := map[string]struct {
+		find          func(context.Context) (*testWaiterResource, error)
+		pending       []string
+		target        []string
+		timeout       time.Duration
+		optFns        []tfresource.OptionsFunc
+		expectStatus  string
+		expectError   func(error) bool
+		expectLastErr string
+	}{
+		"target reached": {
+			find:         testWaiterFinder("CREATING", "CREATING", "ACTIVE"),
+			pending:      []string{"CREATING"},
+			target:       []string{"ACTIVE"},
+			expectStatus: "ACTIVE",
+		},
+		"initially not found": {
+			find:         testWaiterFinder("", "", "ACTIVE"),
+			pending:      []string{"CREATING"},
+			target:       []string{"ACTIVE"},
+			expectStatus: "ACTIVE",
+		},
+		"too many not found": {
+			find:    testWaiterFinder(""),
+			pending: []string{"CREATING"},
+			target:  []string{"ACTIVE"},
+			optFns:  []tfresource.OptionsFunc{tfresource.WithNotFoundChecks(2)},
+			expectError: func(err error) bool {
+				return tfresource.NotFound(err)
+			},
+		},
+		"stable target": {
+			// The first ACTIVE is not followed by a second, so the wait continues.
+			find:         testWaiterFinder("CREATING", "ACTIVE", "CREATING", "ACTIVE", "ACTIVE"),
+			pending:      []string{"CREATING"},
+			target:       []string{"ACTIVE"},
+			optFns:       []tfresource.OptionsFunc{tfresource.WithContinuousTargetOccurence(2)},
+			expectStatus: "ACTIVE",
+		},
+		"unexpected state": {
+			find:    testWaiterFinder("CREATING", "FAILED"),
+			pending: []string{"CREATING"},
+			target:  []string{"ACTIVE"},
+			expectError: func(err error) bool {
+				var e *retry.UnexpectedStateError
+				return errors.As(err, &e) && e.State == "FAILED"
+			},
+			expectStatus:  "FAILED",
+			expectLastErr: "reason: FAILED",
+		},
+		"timeout": {
+			find:    testWaiterFinder("CREATING"),
+			pending: []string{"CREATING"},
+			target:  []string{"ACTIVE"},
+			timeout: 50 * time.Millisecond,
+			expectError: func(err error) bool {
+				var e *retry.TimeoutError
+				return errors.As(err, &e) && e.LastState == "CREATING"
+			},
+			expectStatus:  "CREATING",
+			expectLastErr: "reason: CREATING",
+		},
+		"target reached after timeout": {
+			// The final check after the timeout is made without the wait's deadline.
+			find: func(ctx context.Context) (*testWaiterResource, error) {
+				if _, ok := ctx.Deadline(); ok {
+					return &testWaiterResource{status: "CREATING"}, nil
+				}
+				return &testWaiterResource{status: "ACTIVE"}, nil
+			},
+			pending:      []string{"CREATING"},
+			target:       []string{"ACTIVE"},
+			timeout:      50 * time.Millisecond,
+			expectStatus: "ACTIVE",
+		},
+		"deleted after timeout": {
+			find: func(ctx context.Context) (*testWaiterResource, error) {
+				if _, ok := ctx.Deadline(); ok {
+					return &testWaiterResource{status: "DELETING"}, nil
+				}
+				return nil, &retry.NotFoundError{}
+			},
+			pending: []string{"DELETING"},
+			timeout: 50 * time.Millisecond,
+		},
+		"deleted": {
+			find:    testWaiterFinder("DELETING", "DELETING", ""),
+			pending: []string{"ACTIVE", "DELETING"},
+		},
+		"stable deleted": {
+			find:    testWaiterFinder("DELETING", "", "DELETING", "", ""),
+			pending: []string{"DELETING"},
+			optFns:  []tfresource.OptionsFunc{tfresource.WithContinuousTargetOccurence(2)},
+		},
+		"find error": {
+			find: func(context.Context) (*testWaiterResource, error) {
+				return nil, errors.New("TestCode")
+			},
+			pending: []string{"CREATING"},
+			target:  []string{"ACTIVE"},
+			expectError: func(err error) bool {
+				return err.Error() == "TestCode"
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timeout := testCase.timeout
+			if timeout == 0 {
+				timeout = 5 * time.Second
+			}
+			optFns := append([]tfresource.OptionsFunc{tfresource.WithPollInterval(time.Millisecond)}, testCase.optFns...)
+
+			output, err := waiter.WaitFor(context.Background(), testCase.find, testCase.pending, testCase.target, timeout, optFns...)
+
+			if testCase.expectError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !testCase.expectError(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if testCase.expectStatus == "" {
+				if output != nil && testCase.expectError == nil {
+					t.Errorf("expected no output, got %v", output)
+				}
+			} else if output == nil || output.status != testCase.expectStatus {
+				t.Errorf("expected status %q, got %v", testCase.expectStatus, output)
+			}
+
+			if testCase.expectLastErr != "" {
+				var lastErr error
+				switch err := err.(type) { //nolint:errorlint // Test
+				case *retry.UnexpectedStateError:
+					lastErr = err.LastError
+				case *retry.TimeoutError:
+					lastErr = err.LastError
+				}
+				if lastErr == nil || lastErr.Error() != testCase.expectLastErr {
+					t.Errorf("expected last error %q, got %v", testCase.expectLastErr, lastErr)
+				}
+			}
+		})
+	}
+}
+
+func TestStateWaiterWaitForCanceled(t *testing.T) {
+	t.Parallel()
+
+	waiter := tfresource.NewStateWaiter("Test Resource", func(v *testWaiterResource) string {
+		return v.status
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waiter.WaitFor(ctx, testWaiterFinder("CREATING"), []string{"CREATING"}, []string{"ACTIVE"}, time.Minute)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,12 +19,53 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceAdoption represents resource-level adoption information, used to adopt existing resources on create.
+// A nil value indicates that existing resources cannot be adopted.
+type ServicePackageResourceAdoption struct {
+	// Finder returns the ID of the existing resource matching the planned resource.
+	// If tagKey is not empty the existing resource must also have the adoption tag tagKey with value tagValue.
+	// A NotFound error is returned if there is no matching resource.
+	// Finder must be idempotent and must not modify any AWS resources.
+	Finder func(ctx context.Context, d *schema.ResourceData, meta any, tagKey, tagValue string) (string, error)
+}
+
+// ServicePackageResourceRegion represents resource-level Region information.
+// A nil value is equivalent to a regional resource for which per-resource Region override is enabled.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Is per-resource Region override supported?
+}
+
+// IsRegionOverrideEnabled returns whether per-resource Region override is enabled for the resource.
+func (r *ServicePackageResourceRegion) IsRegionOverrideEnabled() bool {
+	if r == nil {
+		return true
+	}
+
+	return !r.IsGlobal && r.IsOverrideEnabled
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information, used for import by identity.
+// A nil value indicates that the resource does not publish an identity.
+type ServicePackageResourceIdentity struct {
+	IsARN      bool                                      // Is the resource identified by its ARN?
+	IsGlobal   bool                                      // Is the resource global, i.e. its identity has no Region?
+	Attributes []ServicePackageResourceIdentityAttribute // Resource-specific identifying attributes
+}
+
+// ServicePackageResourceIdentityAttribute represents a resource-specific identifying attribute.
+type ServicePackageResourceIdentityAttribute struct {
+	Name     string // The attribute's name, which is the same in identity and state
+	Optional bool   // Is the attribute optional for import?
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -33,6 +75,16 @@ type ServicePackageFrameworkDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+}
+
+// ServicePackageFrameworkListResource represents a Terraform Plugin Framework list resource
+// implemented by a service package.
+// A list resource enumerates existing resources of the Framework resource with the same type name.
+type ServicePackageFrameworkListResource struct {
+	Factory  func(context.Context) (list.ListResourceWithConfigure, error)
+	TypeName string
+	Name     string
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -42,6 +94,8 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -51,6 +105,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -60,4 +115,6 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Adoption *ServicePackageResourceAdoption
 }
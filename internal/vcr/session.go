@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ModeRecord = "record"
+	ModeReplay = "replay"
+)
+
+// Modes returns the supported provider-level recording modes.
+func Modes() []string {
+	return []string{
+		ModeRecord,
+		ModeReplay,
+	}
+}
+
+const (
+	cassetteExtension = ".yaml"
+	replayedExtension = ".replayed"
+)
+
+// NewSessionTransport returns an http.RoundTripper that records or replays a session's AWS API interactions.
+//
+// Terraform starts a new provider process for each command (and for each of the plan and apply phases of `terraform apply`),
+// so each configured provider instance is a session with its own cassette in dir.
+// Sessions are numbered in the order in which they are started. In record mode the next unused cassette is created.
+// In replay mode the next cassette not yet replayed is claimed by creating a marker file alongside it.
+func NewSessionTransport(ctx context.Context, mode, dir string, realTransport http.RoundTripper) (http.RoundTripper, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	switch mode {
+	case ModeRecord:
+		name, err := claimSession(dir, cassetteExtension)
+		if err != nil {
+			return nil, err
+		}
+
+		// Replace the placeholder file created when claiming the session with an empty cassette.
+		w, err := newCassetteWriter(name + cassetteExtension)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := NewRecorder(ctx, &recorder.Options{
+			CassetteName:  name,
+			Mode:          recorder.ModeRecordOnly,
+			RealTransport: realTransport,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Provider processes are stopped by Terraform without notice, so the recorder is never stopped
+		// and each interaction is instead appended to the cassette as it is captured.
+		r.AddHook(w.append, recorder.AfterCaptureHook)
+
+		return r, nil
+
+	case ModeReplay:
+		name, err := claimSession(dir, replayedExtension)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := os.Stat(name + cassetteExtension); errors.Is(err, fs.ErrNotExist) {
+			os.Remove(name + replayedExtension)
+			return nil, fmt.Errorf("all recorded sessions in %s have been replayed; remove the %s files to replay them again", dir, replayedExtension)
+		}
+
+		r, err := NewRecorder(ctx, &recorder.Options{
+			CassetteName:       name,
+			Mode:               recorder.ModeReplayOnly,
+			RealTransport:      realTransport,
+			SkipRequestLatency: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &replayingTransport{recorder: r}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported recording mode: %s", mode)
+	}
+}
+
+// claimSession atomically claims the lowest numbered session without a file with the specified extension in dir.
+// The session's cassette name (path without extension) is returned.
+func claimSession(dir, extension string) (string, error) {
+	for i := 0; ; i++ {
+		name := filepath.Join(dir, fmt.Sprintf("session-%03d", i))
+		f, err := os.OpenFile(name+extension, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		return name, f.Close()
+	}
+}
+
+// cassetteWriter appends interactions to a cassette file in the format written by go-vcr.
+type cassetteWriter struct {
+	mu     sync.Mutex
+	file   string
+	nextID int
+}
+
+// newCassetteWriter creates a cassette file with no interactions.
+func newCassetteWriter(file string) (*cassetteWriter, error) {
+	header := fmt.Sprintf("---\nversion: %d\ninteractions:\n", cassette.CassetteFormatV2)
+
+	if err := os.WriteFile(file, []byte(header), 0o644); err != nil {
+		return nil, err
+	}
+
+	return &cassetteWriter{file: file}, nil
+}
+
+// append scrubs a copy of a captured interaction and appends it to the cassette file's interactions.
+// Interaction IDs are assigned in the order in which interactions are appended, as go-vcr does.
+func (w *cassetteWriter) append(i *cassette.Interaction) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	v := *i
+	v.ID = w.nextID
+	v.Request.Headers = i.Request.Headers.Clone()
+	v.Request.Form = maps.Clone(i.Request.Form)
+
+	if err := scrubInteraction(&v); err != nil {
+		return err
+	}
+
+	b, err := yaml.Marshal([]*cassette.Interaction{&v})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(w.file, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	w.nextID++
+
+	return nil
+}
+
+// replayingTransport stops AWS SDK retries when a request has no recorded interaction.
+type replayingTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *replayingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.recorder.RoundTrip(r)
+
+	if IsInteractionNotFound(err) {
+		return nil, &interactionNotFoundError{method: r.Method, url: r.URL.Host + r.URL.Path, err: err}
+	}
+
+	return resp, err
+}
+
+type interactionNotFoundError struct {
+	method string
+	url    string
+	err    error
+}
+
+func (e *interactionNotFoundError) Error() string {
+	return fmt.Sprintf("replaying %s %s: %s", e.method, e.url, e.err)
+}
+
+func (e *interactionNotFoundError) Unwrap() error {
+	return e.err
+}
+
+// RetryableError implements the AWS SDK for Go v2 retry.RetryableError interface.
+func (e *interactionNotFoundError) RetryableError() bool {
+	return false
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vcr records and replays AWS API interactions using go-vcr.
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	// Redacted replaces sensitive values in recorded interactions.
+	Redacted = "REDACTED"
+)
+
+var (
+	// sensitiveHeaders are removed from recorded requests.
+	sensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+		"X-Amz-Sso_bearer_token",
+	}
+
+	// sensitiveFormFields are STS query protocol parameters whose values are redacted in recorded requests.
+	sensitiveFormFields = []string{
+		"SAMLAssertion",
+		"WebIdentityToken",
+	}
+
+	// idempotencyTokens are request members that the AWS SDKs populate with a random value.
+	// They are ignored when matching request bodies.
+	idempotencyTokens = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+		"clientRequestToken",
+		"clientToken",
+		"idempotencyToken",
+	}
+
+	// bodyRedactions are the secret-bearing members of AWS API request and response bodies.
+	// Their values are redacted in recorded interactions.
+	bodyRedactions = []bodyRedaction{
+		{
+			endpointPrefix: "kms",
+			members:        []string{"Plaintext"},
+		},
+		{
+			// Includes Amazon DocumentDB and Amazon Neptune.
+			endpointPrefix: "rds",
+			members:        []string{"MasterUserPassword"},
+		},
+		{
+			endpointPrefix: "redshift",
+			members:        []string{"MasterUserPassword"},
+		},
+		{
+			endpointPrefix: "secretsmanager",
+			members:        []string{"SecretBinary", "SecretString"},
+		},
+		{
+			// Tag values are also named "Value".
+			endpointPrefix: "ssm",
+			operations:     []string{"GetParameter", "GetParameterHistory", "GetParameters", "GetParametersByPath", "PutParameter"},
+			members:        []string{"Value"},
+		},
+	}
+
+	sensitiveFormFieldsRegexp    = regexache.MustCompile(`((?:^|&)(?:` + strings.Join(sensitiveFormFields, "|") + `)=)[^&]*`)
+	sensitiveXMLElementsRegexp   = regexache.MustCompile(`(<(SecretAccessKey|SessionToken)>)[^<]*(</(SecretAccessKey|SessionToken)>)`)
+	sensitiveJSONPropertiesRegex = regexache.MustCompile(`("(?i:secretAccessKey|sessionToken)"\s*:\s*")(?:[^"\\]|\\.)*(")`)
+)
+
+// NewRecorder returns a go-vcr recorder that removes credentials from interactions before they are saved
+// and matches requests to recorded interactions by operation and normalized body.
+// Interactions are not scrubbed when captured, as the captured response is returned to the caller.
+func NewRecorder(ctx context.Context, opts *recorder.Options) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddHook(scrubInteraction, recorder.BeforeSaveHook)
+	r.SetMatcher(newMatcher(ctx))
+
+	return r, nil
+}
+
+// IsInteractionNotFound returns whether the error indicates that no recorded interaction matched a request.
+func IsInteractionNotFound(err error) bool {
+	return errors.Is(err, cassette.ErrInteractionNotFound)
+}
+
+// bodyRedaction identifies the secret-bearing members of a service's request and response bodies.
+type bodyRedaction struct {
+	endpointPrefix string   // The service's endpoint prefix, e.g. "kms"
+	operations     []string // The operations whose bodies are redacted; all operations if empty
+	members        []string // The members whose values are redacted, at any depth
+}
+
+// sensitiveMembers returns the secret-bearing members of the bodies of a request to the specified host and operation.
+func sensitiveMembers(host, operation string) []string {
+	var members []string
+
+	for _, v := range bodyRedactions {
+		if !strings.HasPrefix(host, v.endpointPrefix+".") {
+			continue
+		}
+
+		if len(v.operations) > 0 && !slices.Contains(v.operations, operation) {
+			continue
+		}
+
+		members = append(members, v.members...)
+	}
+
+	return members
+}
+
+// operationName returns the name of a request's operation.
+// The JSON protocols identify the operation in a header and the query protocols in the body.
+// https://smithy.io/2.0/aws/protocols/index.html.
+func operationName(header http.Header, body string) string {
+	if v := header.Get("X-Amz-Target"); v != "" {
+		return v[strings.LastIndex(v, ".")+1:]
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil && mediaType == "application/x-www-form-urlencoded" {
+		if values, err := url.ParseQuery(body); err == nil {
+			return values.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+func scrubInteraction(i *cassette.Interaction) error {
+	var host string
+	if u, err := url.Parse(i.Request.URL); err == nil {
+		host = u.Host
+	}
+	operation := operationName(i.Request.Headers, i.Request.Body)
+
+	for _, v := range sensitiveHeaders {
+		delete(i.Request.Headers, v)
+	}
+
+	i.Request.Body = scrubRequestBody(host, operation, i.Request.Headers.Get("Content-Type"), i.Request.Body)
+	for _, v := range sensitiveFormFields {
+		if i.Request.Form.Has(v) {
+			i.Request.Form.Set(v, Redacted)
+		}
+	}
+	members := sensitiveMembers(host, operation)
+	for k := range i.Request.Form {
+		// Query protocol members are flattened, e.g. "Parameters.member.1.Value".
+		if slices.Contains(members, k[strings.LastIndex(k, ".")+1:]) {
+			i.Request.Form.Set(k, Redacted)
+		}
+	}
+
+	i.Response.Body = scrubResponseBody(host, operation, i.Response.Headers.Get("Content-Type"), i.Response.Body)
+
+	return nil
+}
+
+// scrubRequestBody redacts web identity and SAML assertions passed to STS and the values of secret-bearing members.
+func scrubRequestBody(host, operation, contentType, s string) string {
+	s = sensitiveFormFieldsRegexp.ReplaceAllString(s, "${1}"+Redacted)
+	s = redactBody(sensitiveMembers(host, operation), contentType, s)
+
+	return s
+}
+
+// scrubResponseBody redacts temporary credentials returned by STS (XML) and IAM Identity Center (JSON)
+// and the values of secret-bearing members.
+func scrubResponseBody(host, operation, contentType, s string) string {
+	s = sensitiveXMLElementsRegexp.ReplaceAllString(s, "${1}"+Redacted+"${3}")
+	s = sensitiveJSONPropertiesRegex.ReplaceAllString(s, "${1}"+Redacted+"${2}")
+	s = redactBody(sensitiveMembers(host, operation), contentType, s)
+
+	return s
+}
+
+// redactBody redacts the string values of the specified members in a JSON, query (form) or XML body.
+func redactBody(members []string, contentType, s string) string {
+	if len(members) == 0 {
+		return s
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return s
+	}
+
+	names := strings.Join(members, "|")
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return regexache.MustCompile(`("(?:`+names+`)"\s*:\s*")(?:[^"\\]|\\.)*(")`).ReplaceAllString(s, "${1}"+Redacted+"${2}")
+
+	case "application/x-www-form-urlencoded":
+		return regexache.MustCompile(`((?:^|&)(?:[^&=]*\.)?(?:`+names+`)=)[^&]*`).ReplaceAllString(s, "${1}"+Redacted)
+
+	case "application/xml", "text/xml":
+		return regexache.MustCompile(`(<(?:`+names+`)>)[^<]*(</(?:`+names+`)>)`).ReplaceAllString(s, "${1}"+Redacted+"${2}")
+	}
+
+	return s
+}
+
+// newMatcher returns a function that matches a request to a recorded interaction.
+// Requests match if their method, URL (ignoring query parameter order), operation and normalized body are the same.
+func newMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		u, err := url.Parse(i.URL)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette URL", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if r.URL.Scheme != u.Scheme || r.URL.Host != u.Host || r.URL.EscapedPath() != u.EscapedPath() {
+			return false
+		}
+
+		if !reflect.DeepEqual(r.URL.Query(), u.Query()) {
+			return false
+		}
+
+		// JSON protocols identify the operation in a header.
+		if r.Header.Get("X-Amz-Target") != http.Header(i.Headers).Get("X-Amz-Target") {
+			return false
+		}
+
+		var body string
+		if r.Body != nil && r.Body != http.NoBody {
+			var b bytes.Buffer
+			if _, err := b.ReadFrom(r.Body); err != nil {
+				tflog.Debug(ctx, "Failed to read request body", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			r.Body = io.NopCloser(&b)
+			body = b.String()
+		}
+
+		contentType := r.Header.Get("Content-Type")
+
+		return bodiesEquivalent(ctx, contentType, scrubRequestBody(r.URL.Host, operationName(r.Header, body), contentType, body), i.Body)
+	}
+}
+
+// bodiesEquivalent returns whether two request bodies are equivalent.
+// Structured bodies are compared after parsing so that member order and idempotency tokens are not significant.
+// https://smithy.io/2.0/aws/protocols/index.html.
+func bodiesEquivalent(ctx context.Context, contentType, x, y string) bool {
+	if x == y {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var vx, vy any
+
+		if err := json.Unmarshal([]byte(x), &vx); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := json.Unmarshal([]byte(y), &vy); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		for _, v := range []any{vx, vy} {
+			if m, ok := v.(map[string]any); ok {
+				for _, k := range idempotencyTokens {
+					delete(m, k)
+				}
+			}
+		}
+
+		return reflect.DeepEqual(vx, vy)
+
+	case "application/x-www-form-urlencoded":
+		vx, err := url.ParseQuery(x)
+		if err != nil {
+			return false
+		}
+
+		vy, err := url.ParseQuery(y)
+		if err != nil {
+			return false
+		}
+
+		for _, k := range idempotencyTokens {
+			vx.Del(k)
+			vy.Del(k)
+		}
+
+		return reflect.DeepEqual(vx, vy)
+
+	case "application/xml", "text/xml":
+		vx, err := xmlTokens(x)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		vy, err := xmlTokens(y)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return slices.Equal(vx, vy)
+	}
+
+	return false
+}
+
+// xmlTokens returns a canonical representation of an XML document's elements, attributes and character data.
+// Insignificant whitespace, comments and processing instructions are ignored.
+func xmlTokens(s string) ([]string, error) {
+	var tokens []string
+
+	d := xml.NewDecoder(strings.NewReader(s))
+	for {
+		t, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := t.(type) {
+		case xml.StartElement:
+			attrs := make([]string, 0, len(t.Attr))
+			for _, a := range t.Attr {
+				attrs = append(attrs, a.Name.Space+":"+a.Name.Local+"="+a.Value)
+			}
+			sort.Strings(attrs)
+			tokens = append(tokens, "<"+t.Name.Space+":"+t.Name.Local+" "+strings.Join(attrs, " ")+">")
+		case xml.EndElement:
+			tokens = append(tokens, "</"+t.Name.Space+":"+t.Name.Local+">")
+		case xml.CharData:
+			if v := strings.TrimSpace(string(t)); v != "" {
+				tokens = append(tokens, v)
+			}
+		}
+	}
+
+	return tokens, nil
+}
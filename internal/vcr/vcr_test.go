@@ -0,0 +1,511 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestBodiesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		x, y        string
+		expected    bool
+	}{
+		"identical": {
+			contentType: "application/octet-stream",
+			x:           "abc",
+			y:           "abc",
+			expected:    true,
+		},
+		"different": {
+			contentType: "application/octet-stream",
+			x:           "abc",
+			y:           "abd",
+		},
+		"JSON reordered": {
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"Name":"a","Tags":[{"Key":"k","Value":"v"}]}`,
+			y:           `{"Tags":[{"Value":"v","Key":"k"}],"Name":"a"}`,
+			expected:    true,
+		},
+		"JSON different": {
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"Name":"a"}`,
+			y:           `{"Name":"b"}`,
+		},
+		"JSON idempotency token": {
+			contentType: "application/json",
+			x:           `{"Name":"a","clientToken":"0c9a8c1e"}`,
+			y:           `{"Name":"a","clientToken":"6f1b2d4a"}`,
+			expected:    true,
+		},
+		"form reordered": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1",
+			y:           "VpcId.1=vpc-1&Action=DescribeVpcs&Version=2016-11-15",
+			expected:    true,
+		},
+		"form different operation": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           "Action=DescribeVpcs&Version=2016-11-15",
+			y:           "Action=DescribeSubnets&Version=2016-11-15",
+		},
+		"form idempotency token": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           "Action=RunInstances&ClientToken=a&Version=2016-11-15",
+			y:           "Action=RunInstances&ClientToken=b&Version=2016-11-15",
+			expected:    true,
+		},
+		"XML whitespace": {
+			contentType: "application/xml",
+			x:           `<Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`,
+			y:           "<?xml version=\"1.0\"?>\n<Tagging>\n  <TagSet>\n    <Tag><Key>k</Key><Value>v</Value></Tag>\n  </TagSet>\n</Tagging>",
+			expected:    true,
+		},
+		"XML different": {
+			contentType: "application/xml",
+			x:           `<Tagging><TagSet><Tag><Key>k</Key><Value>v1</Value></Tag></TagSet></Tagging>`,
+			y:           `<Tagging><TagSet><Tag><Key>k</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := bodiesEquivalent(context.Background(), testCase.contentType, testCase.x, testCase.y), testCase.expected; got != want {
+				t.Errorf("bodiesEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		f           func(string, string, string, string) string
+		host        string
+		operation   string
+		contentType string
+		input       string
+		expected    string
+	}{
+		"form": {
+			f:           scrubRequestBody,
+			host:        "sts.amazonaws.com",
+			operation:   "AssumeRoleWithWebIdentity",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			input:       "Action=AssumeRoleWithWebIdentity&RoleArn=arn&WebIdentityToken=eyJ0eXAi&Version=2011-06-15",
+			expected:    "Action=AssumeRoleWithWebIdentity&RoleArn=arn&WebIdentityToken=REDACTED&Version=2011-06-15",
+		},
+		"XML": {
+			f:           scrubResponseBody,
+			host:        "sts.amazonaws.com",
+			operation:   "AssumeRole",
+			contentType: "text/xml",
+			input:       "<Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>",
+			expected:    "<Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>",
+		},
+		"JSON": {
+			f:           scrubResponseBody,
+			host:        "portal.sso.us-west-2.amazonaws.com",
+			operation:   "",
+			contentType: "application/json",
+			input:       `{"roleCredentials":{"accessKeyId":"ASIA","secretAccessKey":"sec\"ret","sessionToken":"token"}}`,
+			expected:    `{"roleCredentials":{"accessKeyId":"ASIA","secretAccessKey":"REDACTED","sessionToken":"REDACTED"}}`,
+		},
+		"Secrets Manager request": {
+			f:           scrubRequestBody,
+			host:        "secretsmanager.us-west-2.amazonaws.com",
+			operation:   "PutSecretValue",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"SecretId":"s","SecretString":"{\"password\":\"p\"}"}`,
+			expected:    `{"SecretId":"s","SecretString":"REDACTED"}`,
+		},
+		"Secrets Manager response": {
+			f:           scrubResponseBody,
+			host:        "secretsmanager.us-west-2.amazonaws.com",
+			operation:   "GetSecretValue",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"ARN":"arn","Name":"s","SecretBinary":"cGFzc3dvcmQ=","VersionId":"v"}`,
+			expected:    `{"ARN":"arn","Name":"s","SecretBinary":"REDACTED","VersionId":"v"}`,
+		},
+		"SSM parameter": {
+			f:           scrubResponseBody,
+			host:        "ssm.us-west-2.amazonaws.com",
+			operation:   "GetParameter",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"Parameter":{"Name":"p","Type":"SecureString","Value":"secret"}}`,
+			expected:    `{"Parameter":{"Name":"p","Type":"SecureString","Value":"REDACTED"}}`,
+		},
+		"SSM tags": {
+			f:           scrubResponseBody,
+			host:        "ssm.us-west-2.amazonaws.com",
+			operation:   "ListTagsForResource",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"TagList":[{"Key":"k","Value":"v"}]}`,
+			expected:    `{"TagList":[{"Key":"k","Value":"v"}]}`,
+		},
+		"KMS": {
+			f:           scrubResponseBody,
+			host:        "kms.us-west-2.amazonaws.com",
+			operation:   "GenerateDataKey",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"CiphertextBlob":"AQID","KeyId":"k","Plaintext":"BAUG"}`,
+			expected:    `{"CiphertextBlob":"AQID","KeyId":"k","Plaintext":"REDACTED"}`,
+		},
+		"RDS": {
+			f:           scrubRequestBody,
+			host:        "rds.us-west-2.amazonaws.com",
+			operation:   "CreateDBInstance",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			input:       "Action=CreateDBInstance&DBInstanceIdentifier=db&MasterUserPassword=p%40ss&Version=2014-10-31",
+			expected:    "Action=CreateDBInstance&DBInstanceIdentifier=db&MasterUserPassword=REDACTED&Version=2014-10-31",
+		},
+		"Redshift XML": {
+			f:           scrubResponseBody,
+			host:        "redshift.us-west-2.amazonaws.com",
+			operation:   "ModifyCluster",
+			contentType: "text/xml",
+			input:       "<ModifyClusterResult><Cluster><PendingModifiedValues><MasterUserPassword>p</MasterUserPassword></PendingModifiedValues></Cluster></ModifyClusterResult>",
+			expected:    "<ModifyClusterResult><Cluster><PendingModifiedValues><MasterUserPassword>REDACTED</MasterUserPassword></PendingModifiedValues></Cluster></ModifyClusterResult>",
+		},
+		"other service": {
+			f:           scrubRequestBody,
+			host:        "logs.us-west-2.amazonaws.com",
+			operation:   "CreateLogGroup",
+			contentType: "application/x-amz-json-1.1",
+			input:       `{"logGroupName":"g","SecretString":"s"}`,
+			expected:    `{"logGroupName":"g","SecretString":"s"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.f(testCase.host, testCase.operation, testCase.contentType, testCase.input), testCase.expected; got != want {
+				t.Errorf("scrub = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		header   http.Header
+		body     string
+		expected string
+	}{
+		"JSON": {
+			header:   http.Header{"Content-Type": {"application/x-amz-json-1.1"}, "X-Amz-Target": {"AmazonSSM.GetParameter"}},
+			body:     `{"Name":"p"}`,
+			expected: "GetParameter",
+		},
+		"query": {
+			header:   http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}},
+			body:     "Action=CreateDBInstance&Version=2014-10-31",
+			expected: "CreateDBInstance",
+		},
+		"REST": {
+			header: http.Header{"Content-Type": {"application/xml"}},
+			body:   "<Tagging></Tagging>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := operationName(testCase.header, testCase.body), testCase.expected; got != want {
+				t.Errorf("operationName() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestScrubInteraction(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body:    "Action=ModifyDBCluster&DBClusterIdentifier=c&MasterUserPassword=secret&Version=2014-10-31",
+			Form:    url.Values{"Action": {"ModifyDBCluster"}, "DBClusterIdentifier": {"c"}, "MasterUserPassword": {"secret"}, "Version": {"2014-10-31"}},
+			Headers: http.Header{"Authorization": {"AWS4-HMAC-SHA256 Credential=AKIA"}, "Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}},
+			URL:     "https://rds.us-west-2.amazonaws.com/",
+		},
+		Response: cassette.Response{
+			Body:    "<ModifyDBClusterResult><DBCluster><DBClusterIdentifier>c</DBClusterIdentifier></DBCluster></ModifyDBClusterResult>",
+			Headers: http.Header{"Content-Type": {"text/xml"}},
+		},
+	}
+
+	if err := scrubInteraction(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := i.Request.Body, "Action=ModifyDBCluster&DBClusterIdentifier=c&MasterUserPassword=REDACTED&Version=2014-10-31"; got != want {
+		t.Errorf("request body = %q, want %q", got, want)
+	}
+	if got, want := i.Request.Form.Get("MasterUserPassword"), Redacted; got != want {
+		t.Errorf("request form MasterUserPassword = %q, want %q", got, want)
+	}
+	if got := i.Request.Headers.Get("Authorization"); got != "" {
+		t.Errorf("request header Authorization = %q, want none", got)
+	}
+}
+
+func TestSessionTransport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		fmt.Fprintf(w, "response %d", n)
+	}))
+	defer server.Close()
+
+	do := func(t *testing.T, transport http.RoundTripper) (string, error) {
+		t.Helper()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/?b=2&a=1", strings.NewReader(`{"Name":"a"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIA")
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		response, err := (&http.Client{Transport: transport}).Do(request)
+		if err != nil {
+			return "", err
+		}
+		defer response.Body.Close()
+
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(body), nil
+	}
+
+	// Two sessions, e.g. `terraform plan` and `terraform apply`.
+	var recorded []string
+	for range 2 {
+		transport, err := NewSessionTransport(ctx, ModeRecord, dir, http.DefaultTransport)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := do(t, transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorded = append(recorded, got)
+	}
+
+	for i := range 2 {
+		b, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("session-%03d.yaml", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "AKIA") {
+			t.Errorf("session %d cassette contains credentials", i)
+		}
+	}
+
+	server.Close()
+
+	for i := range 2 {
+		transport, err := NewSessionTransport(ctx, ModeReplay, dir, http.DefaultTransport)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := do(t, transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := recorded[i]; got != want {
+			t.Errorf("session %d replayed %q, want %q", i, got, want)
+		}
+
+		// Each interaction is replayed once.
+		if _, err := do(t, transport); !IsInteractionNotFound(err) {
+			t.Errorf("session %d: expected interaction not found error, got %v", i, err)
+		}
+	}
+
+	if _, err := NewSessionTransport(ctx, ModeReplay, dir, http.DefaultTransport); err == nil {
+		t.Error("expected error replaying more sessions than were recorded")
+	}
+}
+
+func TestCassetteWriter(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "session-000")
+
+	w, err := newCassetteWriter(name + cassetteExtension)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(c.Interactions); got != 0 {
+		t.Errorf("new cassette has %d interactions, want 0", got)
+	}
+
+	for n := range 3 {
+		i := &cassette.Interaction{
+			Request: cassette.Request{
+				Body:   fmt.Sprintf("request %d\nline 2", n),
+				Method: http.MethodPost,
+				URL:    "https://ssm.us-west-2.amazonaws.com/",
+			},
+			Response: cassette.Response{
+				Body: fmt.Sprintf("response %d", n),
+				Code: http.StatusOK,
+			},
+		}
+
+		if err := w.append(i); err != nil {
+			t.Fatal(err)
+		}
+
+		// The cassette is complete after each interaction is appended.
+		c, err := cassette.Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(c.Interactions), n+1; got != want {
+			t.Fatalf("cassette has %d interactions, want %d", got, want)
+		}
+	}
+
+	c, err = cassette.Load(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n, i := range c.Interactions {
+		if got, want := i.ID, n; got != want {
+			t.Errorf("interaction %d ID = %d, want %d", n, got, want)
+		}
+		if got, want := i.Request.Body, fmt.Sprintf("request %d\nline 2", n); got != want {
+			t.Errorf("interaction %d request body = %q, want %q", n, got, want)
+		}
+		if got, want := i.Response.Body, fmt.Sprintf("response %d", n); got != want {
+			t.Errorf("interaction %d response body = %q, want %q", n, got, want)
+		}
+	}
+}
+
+// TestSessionTransportRedactedResponse documents that redacted response values are replayed as the placeholder.
+// Resources that read back a secret value therefore show a difference from their configuration when replayed.
+func TestSessionTransportRedactedResponse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	const responseBody = `{"Parameter":{"Name":"p","Type":"SecureString","Value":"secret","Version":1}}`
+	realTransport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		// The request body is recorded as it is sent.
+		if _, err := io.Copy(io.Discard, r.Body); err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			Body:       io.NopCloser(strings.NewReader(responseBody)),
+			Header:     http.Header{"Content-Type": {"application/x-amz-json-1.1"}},
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Request:    r,
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+		}, nil
+	})
+
+	do := func(t *testing.T, transport http.RoundTripper) string {
+		t.Helper()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://ssm.us-west-2.amazonaws.com/", strings.NewReader(`{"Name":"p","WithDecryption":true}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		request.Header.Set("X-Amz-Target", "AmazonSSM.GetParameter")
+
+		response, err := (&http.Client{Transport: transport}).Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(body)
+	}
+
+	transport, err := NewSessionTransport(ctx, ModeRecord, dir, realTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider receives the secret value while recording, but it is not saved.
+	if got := do(t, transport); got != responseBody {
+		t.Errorf("recorded %q, want %q", got, responseBody)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "session-000"+cassetteExtension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") {
+		t.Error("cassette contains secret value")
+	}
+
+	transport, err = NewSessionTransport(ctx, ModeReplay, dir, realTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := do(t, transport), `{"Parameter":{"Name":"p","Type":"SecureString","Value":"REDACTED","Version":1}}`; got != want {
+		t.Errorf("replayed %q, want %q", got, want)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -401,6 +401,11 @@ var (
 	ValidRegionName = validation.StringMatch(regionRegexp, "must be a valid AWS Region Code")
 )
 
+// IsValidRegionName returns whether the specified value looks like an AWS Region Code.
+func IsValidRegionName(v string) bool {
+	return regionRegexp.MatchString(v)
+}
+
 func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJSONString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
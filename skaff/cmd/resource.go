@@ -4,7 +4,10 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
 	"github.com/spf13/cobra"
 )
 
@@ -15,12 +18,19 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    shape.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if operations != (shape.Operations{}) {
+			if pluginSDKV2 {
+				return fmt.Errorf("generating from API shapes is only supported for Terraform Plugin Framework")
+			}
+			return resource.CreateFromShapes(name, snakeName, !clearComments, force, operations)
+		}
 		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
 	},
 }
@@ -33,4 +43,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-op", "", "generate from AWS API shapes: name of the Create operation (e.g., CreateCluster)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-op", "", "generate from AWS API shapes: name of the Read/Describe operation (e.g., GetCluster)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-op", "", "generate from AWS API shapes: name of the Update operation, if any (e.g., UpdateCluster)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-op", "", "generate from AWS API shapes: name of the Delete operation, if any (e.g., DeleteCluster)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-op", "", "generate from AWS API shapes: name of the List operation used by the sweeper, if any (e.g., ListClusters)")
 }
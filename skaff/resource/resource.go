@@ -31,6 +31,21 @@ var resourceTestTmpl string
 //go:embed websitedoc.gtpl
 var websiteTmpl string
 
+//go:embed resourceshape.gtpl
+var resourceShapeTmpl string
+
+//go:embed resourceshapetest.gtpl
+var resourceShapeTestTmpl string
+
+//go:embed resourceshapesweep.gtpl
+var resourceShapeSweepTmpl string
+
+//go:embed sweep.gtpl
+var sweepTmpl string
+
+//go:embed exports.gtpl
+var exportsTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -49,23 +64,54 @@ type TemplateData struct {
 }
 
 func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+	snakeName = templateData.ResourceSnake
+	servicePackage := templateData.ServicePackage
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, pluginFramework, tags bool) (TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	if snakeName == "" {
@@ -74,10 +120,10 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 
 	service, err := data.LookupService(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+		return TemplateData{}, fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -92,32 +138,34 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    convert.ToHumanResName(resName),
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
-	}
+	}, nil
+}
 
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
 	}
 
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
+	return writeFile(filename, contents, force)
+}
+
+func executeTemplate(templateName, tmpl string, td any) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	return nil
+	return buffer.Bytes(), nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+func writeFile(filename string, contents []byte, force bool) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
@@ -127,24 +175,7 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
-	tplate, err := template.New(templateName).Parse(tmpl)
-	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
-	}
-
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
-	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
-	}
-
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
-
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
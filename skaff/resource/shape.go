@@ -0,0 +1,915 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// ShapeTemplateData is the template data for a resource generated from the
+// shapes of its AWS API operations.
+type ShapeTemplateData struct {
+	TemplateData
+
+	Shape *shape.Resource
+
+	// ResourceLowerCamel is the resource name with a lowercase prefix, e.g.
+	// "dbInstance".
+	ResourceLowerCamel string
+
+	// Rendered Go source fragments.
+	SchemaAttributes string
+	SchemaBlocks     string
+	Models           string
+	FlexOptions      string
+
+	// IDSource is the Go expression for the new resource's identifier in
+	// Create, e.g. "out.Cluster.ClusterArn".
+	IDSource string
+
+	// TagsIdentifierAttribute is the @Tags annotation identifier attribute.
+	TagsIdentifierAttribute string
+
+	// HasARN is true if the resource has a computed "arn" attribute.
+	HasARN bool
+
+	// Updatable is true if any argument can be updated in-place.
+	Updatable bool
+
+	// ReadTypeRef is the qualified resource structure type, e.g.
+	// "awstypes.Cluster".
+	ReadTypeRef string
+
+	// Lifecycle status values for waiters, e.g. "enum.Slice(awstypes.StatusActive)".
+	StatusCreating  string
+	StatusUpdating  string
+	StatusDeleting  string
+	StatusAvailable string
+
+	// TestConfigArguments are the required top-level arguments for the
+	// acceptance test configuration.
+	TestConfigArguments []TestConfigArgument
+
+	// ImportStateVerifyIgnore are the arguments which are not returned by
+	// the Read operation.
+	ImportStateVerifyIgnore []string
+
+	// DeclarePreCheck is true if the service's test package does not yet
+	// declare testAccPreCheck.
+	DeclarePreCheck bool
+
+	// HasEndpointID is true if the names package declares an endpoint ID
+	// constant for the service.
+	HasEndpointID bool
+
+	// Sweeper source fragments.
+	SweeperRegistration string
+	SweeperFunc         string
+}
+
+// TestConfigArgument is an argument in a generated acceptance test configuration.
+type TestConfigArgument struct {
+	Name      string
+	Padding   string
+	Value     string
+	Sensitive bool
+}
+
+// CreateFromShapes generates a Terraform Plugin Framework resource, its
+// acceptance tests, sweeper and website documentation from the shapes of the
+// resource's AWS API operations in the AWS SDK for Go v2.
+func CreateFromShapes(resName, snakeName string, comments, force bool, ops shape.Operations) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, true, false)
+	if err != nil {
+		return err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	svc, err := shape.Load(wd, templateData.SDKPackage)
+	if err != nil {
+		return err
+	}
+
+	res, err := svc.Resource(resName, ops)
+	if err != nil {
+		return err
+	}
+
+	td := newShapeGenerator(res).templateData(templateData)
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err := writeGoTemplate("newres", f, resourceShapeTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err := writeGoTemplate("restest", tf, resourceShapeTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td.TemplateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if err := writeExports(td); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	if res.ListItemsField == "" {
+		fmt.Printf("No sweeper generated: %q returns no list of resources or requires input\n", ops.List)
+	} else if err := writeSweeper(td); err != nil {
+		return fmt.Errorf("writing sweeper: %w", err)
+	}
+
+	return nil
+}
+
+// templateData returns the template data for the resource.
+func (g *shapeGenerator) templateData(base TemplateData) ShapeTemplateData {
+	r := g.resource
+	td := ShapeTemplateData{
+		TemplateData:       base,
+		Shape:              r,
+		ResourceLowerCamel: convert.ToLowercasePrefix(r.Name),
+		FlexOptions:        g.renderFlexOptions(),
+		Updatable:          r.Operations.Update != "",
+		DeclarePreCheck:    !declaresFunc(".", "_test.go", "testAccPreCheck", base.ResourceSnake+"_test.go"),
+		HasEndpointID:      declaresEndpointID(base.Service),
+	}
+	td.IncludeTags = r.Tags
+	td.SchemaAttributes, td.SchemaBlocks = g.renderSchema()
+	td.Models = g.renderModels(fmt.Sprintf("resource%sModel", r.Name))
+	td.TestConfigArguments = g.testConfigArguments()
+
+	for _, a := range r.Attributes {
+		name := tfName(a.ModelName)
+		if name == names.AttrARN && a.Computed {
+			td.HasARN = true
+		}
+		if !a.Returned {
+			td.ImportStateVerifyIgnore = append(td.ImportStateVerifyIgnore, name)
+		}
+	}
+
+	td.TagsIdentifierAttribute = names.AttrID
+	if td.HasARN {
+		td.TagsIdentifierAttribute = names.AttrARN
+	}
+
+	switch {
+	case r.IdentifierAttribute != nil:
+		td.IDSource = fmt.Sprintf("plan.%s.ValueStringPointer()", g.goName(r.IdentifierAttribute.ModelName))
+	case r.CreateOutputIdentifier != "":
+		td.IDSource = "out." + r.CreateOutputIdentifier
+	}
+
+	td.ReadTypeRef = "awstypes." + r.ReadType
+	if r.ReadTypeIsOutput {
+		td.ReadTypeRef = fmt.Sprintf("%s.%s", base.SDKPackage, r.ReadType)
+	}
+
+	td.StatusCreating = enumSlice(r.StatusCreating)
+	td.StatusUpdating = enumSlice(r.StatusUpdating)
+	td.StatusDeleting = enumSlice(append(slices.Clone(r.StatusDeleting), r.StatusAvailable...))
+	td.StatusAvailable = enumSlice(r.StatusAvailable)
+
+	if r.ListItemsField != "" {
+		td.SweeperRegistration = fmt.Sprintf("awsv2.Register(%q, sweep%ss)", base.ProviderResourceName, r.Name)
+	}
+
+	return td
+}
+
+// enumSlice renders a slice of enum values for a retry.StateChangeConf.
+func enumSlice(values []shape.EnumValue) string {
+	if len(values) == 0 {
+		return "[]string{} // TODO: Add status values."
+	}
+
+	consts := make([]string, 0, len(values))
+	for _, v := range values {
+		consts = append(consts, "awstypes."+v.Const)
+	}
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(consts, ", "))
+}
+
+// shapeGenerator renders Go source for a resource generated from shapes.
+type shapeGenerator struct {
+	resource *shape.Resource
+
+	// caps maps incorrectly capitalized words to their correct form, e.g.
+	// "Arn" to "ARN".
+	caps map[string]string
+
+	// attrConsts maps attribute names to names.Attr constant suffixes,
+	// e.g. "kms_key_id" to "KMSKeyID".
+	attrConsts map[string]string
+
+	nested map[string]bool
+}
+
+// Data files shared with the provider's code generators, relative to a service directory.
+var (
+	capsDataFile     = filepath.Join("..", "..", "..", "names", "caps.csv")
+	attrConstantFile = filepath.Join("..", "..", "..", "names", "attr_constants.csv")
+	namesFile        = filepath.Join("..", "..", "..", "names", "names.go")
+)
+
+func newShapeGenerator(r *shape.Resource) *shapeGenerator {
+	g := &shapeGenerator{
+		resource: r,
+		caps: map[string]string{
+			"Arn": "ARN",
+			"Id":  "ID",
+			"Ids": "IDs",
+		},
+		attrConsts: map[string]string{},
+		nested:     map[string]bool{},
+	}
+
+	// The data files are optional; without them names are less idiomatic.
+	for _, row := range readCSV(capsDataFile) {
+		g.caps[row[0]] = row[1]
+	}
+	for _, row := range readCSV(attrConstantFile) {
+		g.attrConsts[row[0]] = row[1]
+	}
+
+	for _, s := range r.Structs {
+		g.nested[s.Name] = true
+	}
+
+	return g
+}
+
+func readCSV(filename string) [][]string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var rows [][]string
+	r := csv.NewReader(f)
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil || len(row) < 2 {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// goName returns an AWS API field name with idiomatic capitalization, e.g.
+// "KmsKeyId" becomes "KMSKeyID".
+func (g *shapeGenerator) goName(name string) string {
+	var sb strings.Builder
+	for _, word := range splitCamel(name) {
+		if v, ok := g.caps[word]; ok {
+			word = v
+		}
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
+
+// splitCamel splits a camel case name into words, e.g. "VpcSecurityGroupIds"
+// into "Vpc", "Security", "Group", "Ids".
+func splitCamel(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		if unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && unicode.IsLower(next))) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
+
+// tfName returns the Terraform attribute name for an AWS API field name,
+// e.g. "SubnetIds" becomes "subnet_ids".
+func tfName(name string) string {
+	return names.ToSnakeCase(name)
+}
+
+// attrKey returns the schema map key for a Terraform attribute name,
+// preferring the names.Attr constants.
+func (g *shapeGenerator) attrKey(name string) string {
+	if v, ok := g.attrConsts[name]; ok {
+		return "names.Attr" + v
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+func modelTypeName(structName string) string {
+	return convert.ToLowercasePrefix(structName) + "Model"
+}
+
+// modelType returns the model struct field type for a field, or "" if the
+// field's type is not supported.
+func (g *shapeGenerator) modelType(f *shape.Field) string {
+	switch f.Kind {
+	case shape.KindBool:
+		return "types.Bool"
+	case shape.KindInt32:
+		return "types.Int32"
+	case shape.KindInt64:
+		return "types.Int64"
+	case shape.KindFloat32:
+		return "types.Float32"
+	case shape.KindFloat64:
+		return "types.Float64"
+	case shape.KindString:
+		return "types.String"
+	case shape.KindEnum:
+		return fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", f.TypeName)
+	case shape.KindTimestamp:
+		return "timetypes.RFC3339"
+	case shape.KindDocument:
+		return "fwtypes.SmithyJSON[document.Interface]"
+	case shape.KindListOfString:
+		return "fwtypes.ListOfString"
+	case shape.KindListOfEnum:
+		return fmt.Sprintf("fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.%s]]", f.TypeName)
+	case shape.KindMapOfString:
+		return "fwtypes.MapOfString"
+	case shape.KindObject, shape.KindObjectList, shape.KindUnion:
+		if g.nested[f.Struct.Name] {
+			return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelTypeName(f.Struct.Name))
+		}
+	}
+	return ""
+}
+
+// schemaAttribute describes how an attribute is declared in the schema.
+type schemaAttribute struct {
+	required, optional, computed, requiresReplace, sensitive bool
+}
+
+func (g *shapeGenerator) todo(f *shape.Field) string {
+	return fmt.Sprintf("// TODO: %s (%s) is not supported by skaff, add it manually.\n", f.Name, f.GoType)
+}
+
+// renderAttribute renders a schema attribute or nested block map entry.
+func (g *shapeGenerator) renderAttribute(key string, f *shape.Field, sa schemaAttribute, depth int) (string, bool) {
+	if g.modelType(f) == "" || depth > shape.MaxDepth {
+		return g.todo(f), false
+	}
+
+	var sb strings.Builder
+	isBlock := (f.Kind == shape.KindObject || f.Kind == shape.KindObjectList || f.Kind == shape.KindUnion) && !sa.computed
+
+	switch {
+	case isBlock:
+		fmt.Fprintf(&sb, "%s: schema.ListNestedBlock{\n", key)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelTypeName(f.Struct.Name))
+		var validators []string
+		if f.Kind != shape.KindObjectList {
+			validators = append(validators, "listvalidator.SizeAtMost(1)")
+		}
+		if sa.required {
+			validators = append(validators, "listvalidator.IsRequired()")
+		}
+		if len(validators) > 0 {
+			sb.WriteString("Validators: []validator.List{\n")
+			for _, v := range validators {
+				sb.WriteString(v + ",\n")
+			}
+			sb.WriteString("},\n")
+		}
+		if sa.requiresReplace {
+			sb.WriteString("PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n},\n")
+		}
+		attributes, blocks := g.renderNestedObject(f.Struct, depth)
+		sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+		if attributes != "" {
+			sb.WriteString("Attributes: map[string]schema.Attribute{\n" + attributes + "},\n")
+		}
+		if blocks != "" {
+			sb.WriteString("Blocks: map[string]schema.Block{\n" + blocks + "},\n")
+		}
+		sb.WriteString("},\n")
+		sb.WriteString("},\n")
+		return sb.String(), true
+	}
+
+	var attrType, planModifierType string
+	switch f.Kind {
+	case shape.KindBool:
+		attrType, planModifierType = "Bool", "bool"
+	case shape.KindInt32:
+		attrType, planModifierType = "Int32", "int32"
+	case shape.KindInt64:
+		attrType, planModifierType = "Int64", "int64"
+	case shape.KindFloat32:
+		attrType, planModifierType = "Float32", "float32"
+	case shape.KindFloat64:
+		attrType, planModifierType = "Float64", "float64"
+	case shape.KindString, shape.KindEnum, shape.KindTimestamp, shape.KindDocument:
+		attrType, planModifierType = "String", "string"
+	case shape.KindListOfString, shape.KindListOfEnum, shape.KindObject, shape.KindObjectList, shape.KindUnion:
+		attrType, planModifierType = "List", "list"
+	case shape.KindMapOfString:
+		attrType, planModifierType = "Map", "map"
+	}
+
+	fmt.Fprintf(&sb, "%s: schema.%sAttribute{\n", key, attrType)
+	switch f.Kind {
+	case shape.KindEnum:
+		fmt.Fprintf(&sb, "CustomType: fwtypes.StringEnumType[awstypes.%s](),\n", f.TypeName)
+	case shape.KindTimestamp:
+		sb.WriteString("CustomType: timetypes.RFC3339Type{},\n")
+	case shape.KindDocument:
+		sb.WriteString("CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),\n")
+	case shape.KindListOfString:
+		sb.WriteString("CustomType: fwtypes.ListOfStringType,\nElementType: types.StringType,\n")
+	case shape.KindListOfEnum:
+		fmt.Fprintf(&sb, "CustomType: fwtypes.ListOfStringEnumType[awstypes.%[1]s](),\nElementType: fwtypes.StringEnumType[awstypes.%[1]s](),\n", f.TypeName)
+	case shape.KindMapOfString:
+		sb.WriteString("CustomType: fwtypes.MapOfStringType,\nElementType: types.StringType,\n")
+	case shape.KindObject, shape.KindObjectList, shape.KindUnion:
+		fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%[1]s](ctx),\nElementType: fwtypes.NewObjectTypeOf[%[1]s](ctx),\n", modelTypeName(f.Struct.Name))
+	}
+	if sa.required {
+		sb.WriteString("Required: true,\n")
+	}
+	if sa.optional {
+		sb.WriteString("Optional: true,\n")
+	}
+	if sa.computed {
+		sb.WriteString("Computed: true,\n")
+	}
+	if sa.sensitive {
+		sb.WriteString("Sensitive: true,\n")
+	}
+	switch {
+	case sa.requiresReplace:
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.RequiresReplace(),\n},\n", attrType, planModifierType)
+	case sa.computed && !sa.optional && depth == 0:
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.UseStateForUnknown(),\n},\n", attrType, planModifierType)
+	}
+	sb.WriteString("},\n")
+
+	return sb.String(), false
+}
+
+// renderNestedObject renders the attributes and blocks of a nested block.
+func (g *shapeGenerator) renderNestedObject(s *shape.Struct, depth int) (string, string) {
+	var attributes, blocks strings.Builder
+
+	if s.IsUnion() {
+		for _, m := range s.Members {
+			sa := schemaAttribute{optional: true}
+			v, isBlock := g.renderAttribute(g.attrKey(tfName(m.Name)), m.Value, sa, depth+1)
+			if isBlock {
+				blocks.WriteString(v)
+			} else {
+				attributes.WriteString(v)
+			}
+		}
+		return attributes.String(), blocks.String()
+	}
+
+	for _, f := range sortedFields(s.Fields) {
+		sa := schemaAttribute{required: f.Required, optional: !f.Required}
+		v, isBlock := g.renderAttribute(g.attrKey(tfName(f.Name)), f, sa, depth+1)
+		if isBlock {
+			blocks.WriteString(v)
+		} else {
+			attributes.WriteString(v)
+		}
+	}
+
+	return attributes.String(), blocks.String()
+}
+
+func sortedFields(fields []*shape.Field) []*shape.Field {
+	fields = slices.Clone(fields)
+	slices.SortFunc(fields, func(a, b *shape.Field) int {
+		return strings.Compare(tfName(a.Name), tfName(b.Name))
+	})
+	return fields
+}
+
+// renderSchema renders the top-level schema attributes and blocks.
+func (g *shapeGenerator) renderSchema() (string, string) {
+	type entry struct {
+		name, source string
+	}
+	var attributes, blocks []entry
+
+	for _, a := range g.resource.Attributes {
+		name := tfName(a.ModelName)
+		if name == names.AttrARN && a.Computed && !a.Optional && a.Kind == shape.KindString {
+			attributes = append(attributes, entry{name, "names.AttrARN: framework.ARNAttributeComputedOnly(),\n"})
+			continue
+		}
+
+		sa := schemaAttribute{
+			required:        a.Required,
+			optional:        a.Optional,
+			computed:        a.Computed,
+			requiresReplace: a.RequiresReplace,
+			sensitive:       a.Sensitive,
+		}
+		v, isBlock := g.renderAttribute(g.attrKey(name), a.Field, sa, 0)
+		if isBlock {
+			blocks = append(blocks, entry{name, v})
+		} else {
+			attributes = append(attributes, entry{name, v})
+		}
+	}
+
+	attributes = append(attributes, entry{names.AttrID, "names.AttrID: framework.IDAttribute(),\n"})
+	if g.resource.Tags {
+		attributes = append(attributes,
+			entry{names.AttrTags, "names.AttrTags: tftags.TagsAttribute(),\n"},
+			entry{names.AttrTagsAll, "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),\n"},
+		)
+	}
+
+	var timeouts strings.Builder
+	timeouts.WriteString("names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{\nCreate: true,\n")
+	if g.resource.Operations.Update != "" {
+		timeouts.WriteString("Update: true,\n")
+	}
+	if g.resource.Operations.Delete != "" {
+		timeouts.WriteString("Delete: true,\n")
+	}
+	timeouts.WriteString("}),\n")
+	blocks = append(blocks, entry{names.AttrTimeouts, timeouts.String()})
+
+	compare := func(a, b entry) int {
+		return strings.Compare(a.name, b.name)
+	}
+	slices.SortStableFunc(attributes, compare)
+	slices.SortStableFunc(blocks, compare)
+
+	var sa, sb strings.Builder
+	for _, e := range attributes {
+		sa.WriteString(e.source)
+	}
+	for _, e := range blocks {
+		sb.WriteString(e.source)
+	}
+	return sa.String(), sb.String()
+}
+
+// renderModels renders the resource model struct and nested model structs.
+func (g *shapeGenerator) renderModels(resourceModel string) string {
+	type field struct {
+		name, typ, tag string
+	}
+	var sb strings.Builder
+	render := func(typeName string, fields []field, todos []string) {
+		slices.SortFunc(fields, func(a, b field) int {
+			return strings.Compare(a.tag, b.tag)
+		})
+		fmt.Fprintf(&sb, "type %s struct {\n", typeName)
+		for _, f := range fields {
+			fmt.Fprintf(&sb, "%s %s `tfsdk:%q`\n", f.name, f.typ, f.tag)
+		}
+		for _, t := range todos {
+			sb.WriteString(t)
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	var fields []field
+	var todos []string
+	for _, a := range g.resource.Attributes {
+		if typ := g.modelType(a.Field); typ != "" {
+			fields = append(fields, field{g.goName(a.ModelName), typ, tfName(a.ModelName)})
+		} else {
+			todos = append(todos, g.todo(a.Field))
+		}
+	}
+	fields = append(fields,
+		field{"ID", "types.String", names.AttrID},
+		field{"Timeouts", "timeouts.Value", names.AttrTimeouts},
+	)
+	if g.resource.Tags {
+		fields = append(fields,
+			field{"Tags", "tftags.Map", names.AttrTags},
+			field{"TagsAll", "tftags.Map", names.AttrTagsAll},
+		)
+	}
+	render(resourceModel, fields, todos)
+
+	for _, s := range g.resource.Structs {
+		fields, todos = nil, nil
+		if s.IsUnion() {
+			for _, m := range s.Members {
+				if typ := g.modelType(m.Value); typ != "" {
+					fields = append(fields, field{g.goName(m.Name), typ, tfName(m.Name)})
+				} else {
+					todos = append(todos, g.todo(m.Value))
+				}
+			}
+		} else {
+			for _, f := range s.Fields {
+				if typ := g.modelType(f); typ != "" {
+					fields = append(fields, field{g.goName(f.Name), typ, tfName(f.Name)})
+				} else {
+					todos = append(todos, g.todo(f))
+				}
+			}
+		}
+		render(modelTypeName(s.Name), fields, todos)
+	}
+
+	return strings.TrimSpace(sb.String())
+}
+
+// renderFlexOptions renders the AutoFlex options needed by the resource as
+// trailing function call arguments.
+func (g *shapeGenerator) renderFlexOptions() string {
+	var options []string
+
+	for _, a := range g.resource.Attributes {
+		if a.ModelName != a.Name {
+			options = append(options, fmt.Sprintf("fwflex.WithFieldNamePrefix(%q)", g.resource.Name))
+			break
+		}
+	}
+	if g.resource.SmithyDocument {
+		options = append(options, "fwflex.WithSmithyDocument(document.NewLazyDocument)")
+	}
+	for _, u := range g.resource.Unions {
+		var members []string
+		for _, m := range u.Members {
+			members = append(members, fmt.Sprintf("&awstypes.%s{}", m.TypeName))
+		}
+		options = append(options, fmt.Sprintf("fwflex.WithUnion[awstypes.%s](%s)", u.Name, strings.Join(members, ", ")))
+	}
+
+	var sb strings.Builder
+	for _, option := range options {
+		sb.WriteString(", " + option)
+	}
+	return sb.String()
+}
+
+// testConfigArguments returns placeholder values for the required top-level
+// arguments in the acceptance test configuration.
+func (g *shapeGenerator) testConfigArguments() []TestConfigArgument {
+	var args []TestConfigArgument
+
+	for _, a := range g.resource.Attributes {
+		if !a.Required {
+			continue
+		}
+		name := tfName(a.ModelName)
+		var value string
+		switch a.Kind {
+		case shape.KindBool:
+			value = "false"
+		case shape.KindInt32, shape.KindInt64, shape.KindFloat32, shape.KindFloat64:
+			value = "1"
+		case shape.KindString:
+			value = "%[1]q"
+		case shape.KindEnum:
+			if e := g.enum(a.TypeName); e != nil && len(e.Values) > 0 {
+				value = fmt.Sprintf("%q", e.Values[0].Value)
+			} else {
+				value = `"TODO"`
+			}
+		case shape.KindListOfString:
+			value = "[%[1]q]"
+		default:
+			value = "null # TODO"
+		}
+		args = append(args, TestConfigArgument{Name: name, Value: value, Sensitive: a.Sensitive})
+	}
+
+	// Align values as `terraform fmt` does.
+	var width int
+	for _, arg := range args {
+		width = max(width, len(arg.Name))
+	}
+	for i := range args {
+		args[i].Padding = strings.Repeat(" ", width-len(args[i].Name))
+	}
+
+	return args
+}
+
+func (g *shapeGenerator) enum(name string) *shape.Enum {
+	if g.resource.StatusEnum != nil && g.resource.StatusEnum.Name == name {
+		return g.resource.StatusEnum
+	}
+	return g.resource.Enums[name]
+}
+
+// writeGoTemplate writes a Go source file from a template, removing unused
+// imports and formatting the result.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := imports.Process(filename, contents, nil)
+	if err != nil {
+		// Write the unformatted source to help with debugging the template.
+		if err := writeFile(filename, contents, force); err != nil {
+			return err
+		}
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	return writeFile(filename, formatted, force)
+}
+
+// writeExports adds the resource and its finder to the service's test
+// exports, creating exports_test.go if necessary.
+func writeExports(td ShapeTemplateData) error {
+	const filename = "exports_test.go"
+
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return writeGoTemplate("exports", filename, exportsTmpl, false, td)
+	}
+	if err != nil {
+		return err
+	}
+
+	if bytes.Contains(src, []byte("= newResource"+td.Resource+"\n")) {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	// Add to the first parenthesized var declaration.
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.VAR && decl.Rparen.IsValid() {
+			exports := fmt.Sprintf("\n\tResource%[1]s = newResource%[1]s\n\tFind%[1]sByID = find%[1]sByID\n", td.Resource)
+			return insertAndFormat(filename, src, fset.Position(decl.Rparen).Offset, exports, "")
+		}
+	}
+
+	fmt.Printf("Add Resource%[1]s = newResource%[1]s and Find%[1]sByID = find%[1]sByID to %[2]s\n", td.Resource, filename)
+	return nil
+}
+
+// writeSweeper adds the resource's sweeper to the service's sweep.go,
+// creating the file if necessary.
+func writeSweeper(td ShapeTemplateData) error {
+	const filename = "sweep.go"
+
+	fn, err := executeTemplate("sweepfunc", resourceShapeSweepTmpl, td)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		td.SweeperFunc = string(fn)
+		return writeGoTemplate("sweep", filename, sweepTmpl, false, td)
+	}
+	if err != nil {
+		return err
+	}
+
+	if bytes.Contains(src, []byte("func sweep"+td.Resource+"s(")) {
+		fmt.Printf("Sweeper sweep%ss already exists in %s\n", td.Resource, filename)
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Name.Name == "RegisterSweepers" && decl.Body != nil {
+			return insertAndFormat(filename, src, fset.Position(decl.Body.Rbrace).Offset, "\t"+td.SweeperRegistration+"\n", "\n"+string(fn))
+		}
+	}
+
+	fmt.Printf("Add %s to RegisterSweepers in %s\n", td.SweeperRegistration, filename)
+	return nil
+}
+
+// insertAndFormat inserts text at offset in an existing Go source file,
+// appends text to its end, adds any missing imports and formats the result.
+func insertAndFormat(filename string, src []byte, offset int, insert, appendix string) error {
+	var buf bytes.Buffer
+	buf.Write(src[:offset])
+	buf.WriteString(insert)
+	buf.Write(src[offset:])
+	buf.WriteString(appendix)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("error parsing modified file (%s): %s", filename, err)
+	}
+
+	for _, path := range sweeperImports {
+		astutil.AddImport(fset, file, path)
+	}
+
+	buf.Reset()
+	if err := format.Node(&buf, fset, file); err != nil {
+		return fmt.Errorf("error formatting modified file (%s): %s", filename, err)
+	}
+
+	formatted, err := imports.Process(filename, buf.Bytes(), nil)
+	if err != nil {
+		return fmt.Errorf("error formatting modified file (%s): %s", filename, err)
+	}
+
+	return writeFile(filename, formatted, true)
+}
+
+// sweeperImports are the imports used by generated sweepers. Unused imports
+// are removed when formatting.
+var sweeperImports = []string{
+	"context",
+	"github.com/aws/aws-sdk-go-v2/aws",
+	"github.com/hashicorp/terraform-provider-aws/internal/conns",
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2",
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+	"github.com/hashicorp/terraform-provider-aws/names",
+}
+
+// declaresFunc returns true if any file in dir with the specified suffix,
+// other than exclude, declares the named function.
+func declaresFunc(dir, suffix, name, exclude string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), suffix) || entry.Name() == exclude {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		if bytes.Contains(src, []byte("func "+name+"(")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// declaresEndpointID returns true if the names package declares the
+// service's endpoint ID constant.
+func declaresEndpointID(service string) bool {
+	src, err := os.ReadFile(namesFile)
+	if err != nil {
+		return false
+	}
+
+	return regexp.MustCompile(`(?m)^\s*` + service + `EndpointID\s*=`).Match(src)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+	"golang.org/x/tools/imports"
+)
+
+// TestShapeTemplates renders resources from the shapes of a real AWS SDK for Go v2 service package
+// and checks that the generated Go source parses.
+func TestShapeTemplates(t *testing.T) {
+	// The service package is loaded, and the templates rendered, as if skaff were run in the
+	// provider's service directory.
+	t.Chdir(filepath.Join("..", "..", "internal", "service", "docdbelastic"))
+
+	svc, err := shape.Load(".", "docdbelastic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		TestName        string
+		Resource        string
+		Operations      shape.Operations
+		ExpectedFuncs   []string
+		ExpectedNoFuncs []string
+	}{
+		{
+			TestName: "create read update delete list",
+			Resource: "Cluster",
+			Operations: shape.Operations{
+				Create: "CreateCluster",
+				Read:   "GetCluster",
+				Update: "UpdateCluster",
+				Delete: "DeleteCluster",
+				List:   "ListClusters",
+			},
+			ExpectedFuncs: []string{"Create", "Read", "Update", "Delete", "findClusterByID", "waitClusterUpdated", "sweepClusters"},
+		},
+		{
+			TestName: "no update or list",
+			Resource: "ClusterSnapshot",
+			Operations: shape.Operations{
+				Create: "CreateClusterSnapshot",
+				Read:   "GetClusterSnapshot",
+				Delete: "DeleteClusterSnapshot",
+			},
+			ExpectedFuncs:   []string{"Create", "Read", "Delete", "findClusterSnapshotByID"},
+			ExpectedNoFuncs: []string{"Update", "waitClusterSnapshotUpdated", "sweepClusterSnapshots"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			base, err := newTemplateData(testCase.Resource, "", true, true, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := svc.Resource(testCase.Resource, testCase.Operations)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			td := newShapeGenerator(res).templateData(base)
+
+			if got, want := td.SweeperRegistration != "", testCase.Operations.List != ""; got != want {
+				t.Errorf("sweeper registration = %q, want sweeper %t", td.SweeperRegistration, want)
+			}
+
+			var funcs []string
+			files := []struct {
+				name, tmpl string
+			}{
+				{base.ResourceSnake + ".go", resourceShapeTmpl},
+				{base.ResourceSnake + "_test.go", resourceShapeTestTmpl},
+				{"exports_test.go", exportsTmpl},
+			}
+			if td.SweeperRegistration != "" {
+				fn, err := executeTemplate("sweepfunc", resourceShapeSweepTmpl, td)
+				if err != nil {
+					t.Fatal(err)
+				}
+				td.SweeperFunc = string(fn)
+				files = append(files, struct{ name, tmpl string }{"sweep.go", sweepTmpl})
+			}
+
+			for _, file := range files {
+				funcs = append(funcs, testParseTemplate(t, file.name, file.tmpl, td)...)
+			}
+
+			for _, name := range testCase.ExpectedFuncs {
+				if !slices.Contains(funcs, name) {
+					t.Errorf("expected function %s to be generated", name)
+				}
+			}
+			for _, name := range testCase.ExpectedNoFuncs {
+				if slices.Contains(funcs, name) {
+					t.Errorf("expected function %s not to be generated", name)
+				}
+			}
+		})
+	}
+}
+
+// testParseTemplate renders a Go source template as writeGoTemplate does, parses the result
+// and returns the names of the functions and methods it declares.
+func testParseTemplate(t *testing.T, filename, tmpl string, td ShapeTemplateData) []string {
+	t.Helper()
+
+	contents, err := executeTemplate(filename, tmpl, td)
+	if err != nil {
+		t.Fatalf("%s: %s", filename, err)
+	}
+
+	formatted, err := imports.Process(filename, contents, nil)
+	if err != nil {
+		t.Fatalf("%s: %s\n%s", filename, err, contents)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), filename, formatted, parser.AllErrors)
+	if err != nil {
+		t.Fatalf("%s: %s\n%s", filename, err, formatted)
+	}
+
+	var funcs []string
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok {
+			funcs = append(funcs, decl.Name.Name)
+		}
+	}
+
+	return funcs
+}
@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shape
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxDepth is the maximum nesting depth of structures that are mapped to
+// nested blocks. Deeper (usually recursive) structures are left as TODOs.
+const MaxDepth = 4
+
+// Operations are the names of the AWS API operations which manage a resource,
+// e.g. "CreateCluster". Create and Read are required.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// Resource is a resource derived from the shapes of its AWS API operations.
+type Resource struct {
+	// Name is the resource name, e.g. "Cluster".
+	Name string
+
+	Operations Operations
+
+	// Attributes are the resource's top-level attributes, excluding "id",
+	// tags and timeouts.
+	Attributes []*Attribute
+
+	// Identifier is the Read input field which identifies the resource.
+	Identifier *Field
+
+	// IdentifierAttribute is the configurable attribute holding the
+	// identifier, if any. Otherwise the identifier is read from the Create
+	// output using CreateOutputIdentifier.
+	IdentifierAttribute *Attribute
+
+	// CreateOutputIdentifier is the selector of the identifier in the
+	// Create output, e.g. "Cluster.ClusterArn".
+	CreateOutputIdentifier string
+
+	// ReadType is the structure describing the resource, e.g. "Cluster".
+	ReadType string
+
+	// ReadTypeIsOutput is true if ReadType is the Read output itself.
+	ReadTypeIsOutput bool
+
+	// ReadOutputField is the Read output field holding the resource, if
+	// ReadTypeIsOutput is false.
+	ReadOutputField string
+
+	// UpdateIdentifier and DeleteIdentifier are the input fields which
+	// identify the resource in the Update and Delete operations.
+	UpdateIdentifier string
+	DeleteIdentifier string
+
+	// CreateToken, UpdateToken and DeleteToken are the idempotency token
+	// input fields of the Create, Update and Delete operations, if any.
+	CreateToken string
+	UpdateToken string
+	DeleteToken string
+
+	// Tags is true if the Create input accepts tags.
+	Tags bool
+
+	// Status is the enum-typed lifecycle status field of ReadType, if any.
+	Status     *Field
+	StatusEnum *Enum
+
+	// Lifecycle status values, classified by name.
+	StatusCreating  []EnumValue
+	StatusUpdating  []EnumValue
+	StatusDeleting  []EnumValue
+	StatusAvailable []EnumValue
+
+	// NotFoundError is the error type returned for a missing resource, if any.
+	NotFoundError string
+
+	// ListItemsField is the List output field holding the resources, and
+	// ListItemIdentifier the item field holding each resource's identifier.
+	ListItemsField     string
+	ListItemIdentifier string
+	ListPaginated      bool
+
+	// Structs are the nested structures referenced by attributes, in the
+	// order they are first referenced.
+	Structs []*Struct
+
+	// Unions are the union types referenced by attributes.
+	Unions []*Struct
+
+	// Enums are the enumerations of top-level attributes, keyed by name.
+	Enums map[string]*Enum
+
+	// SmithyDocument is true if any attribute is a Smithy document.
+	SmithyDocument bool
+}
+
+// Attribute is a top-level resource attribute.
+type Attribute struct {
+	*Field
+
+	// ModelName is the model struct field name. The resource name prefix is
+	// removed, e.g. "ClusterName" becomes "Name".
+	ModelName string
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	Sensitive       bool
+
+	// Returned is true if the attribute is returned by the Read operation.
+	Returned bool
+}
+
+var idempotencyTokenFields = []string{"ClientToken", "ClientRequestToken", "IdempotencyToken"}
+
+// Resource analyzes the inputs and outputs of the specified operations.
+func (s *Service) Resource(name string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" {
+		return nil, fmt.Errorf("the Create and Read operations are required")
+	}
+
+	r := &Resource{
+		Name:       name,
+		Operations: ops,
+		Enums:      make(map[string]*Enum),
+	}
+
+	createInput, err := s.Input(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+	createOutput, err := s.Output(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+	readInput, err := s.Input(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+	readOutput, err := s.Output(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Identifier = identifier(readInput)
+	if r.Identifier == nil {
+		return nil, fmt.Errorf("no identifier found in %s", readInput.Name)
+	}
+
+	read := r.readStruct(readOutput)
+
+	var updateInput *Struct
+	if ops.Update != "" {
+		if updateInput, err = s.Input(ops.Update); err != nil {
+			return nil, err
+		}
+		r.UpdateIdentifier = matchIdentifier(updateInput, r.Identifier)
+		r.UpdateToken = idempotencyToken(updateInput)
+	}
+	if ops.Delete != "" {
+		deleteInput, err := s.Input(ops.Delete)
+		if err != nil {
+			return nil, err
+		}
+		r.DeleteIdentifier = matchIdentifier(deleteInput, r.Identifier)
+		r.DeleteToken = idempotencyToken(deleteInput)
+	}
+	r.CreateToken = idempotencyToken(createInput)
+
+	r.Status = statusField(read, name)
+	if r.Status != nil {
+		r.StatusEnum = s.Enum(r.Status.TypeName)
+		r.classifyStatus()
+	}
+
+	for _, f := range createInput.Fields {
+		switch {
+		case f.Name == "Tags":
+			r.Tags = true
+			continue
+		case f.Name == r.CreateToken:
+			continue
+		}
+
+		a := &Attribute{
+			Field:     f,
+			Required:  f.Required,
+			Optional:  !f.Required,
+			Sensitive: isSensitive(f.Name),
+			Returned:  read.Field(f.Name) != nil,
+		}
+		if updateInput == nil || updateInput.Field(f.Name) == nil {
+			a.RequiresReplace = true
+		}
+		// Optional arguments returned by the API usually have a service-side default.
+		if a.Optional && a.Returned && !isBlock(f.Kind) {
+			a.Computed = true
+		}
+		r.Attributes = append(r.Attributes, a)
+
+		if f.Name == r.Identifier.Name {
+			r.IdentifierAttribute = a
+		}
+	}
+
+	for _, f := range read.Fields {
+		if f.Name == "Tags" || (r.Status != nil && f.Name == r.Status.Name) || createInput.Field(f.Name) != nil {
+			continue
+		}
+		// A computed identifier is exposed as "id", except for ARNs.
+		if f.Name == r.Identifier.Name && !isARN(f.Name) {
+			continue
+		}
+		r.Attributes = append(r.Attributes, &Attribute{
+			Field:    f,
+			Computed: true,
+			Returned: true,
+		})
+	}
+
+	if r.IdentifierAttribute == nil {
+		r.CreateOutputIdentifier = selector(createOutput, r.Identifier.Name)
+	}
+
+	r.setModelNames()
+	slices.SortFunc(r.Attributes, func(a, b *Attribute) int {
+		return strings.Compare(a.ModelName, b.ModelName)
+	})
+
+	for _, n := range []string{"ResourceNotFoundException", "NotFoundException", name + "NotFoundException", name + "NotFoundFault"} {
+		if s.HasError(n) {
+			r.NotFoundError = n
+			break
+		}
+	}
+
+	if ops.List != "" {
+		if err := s.analyzeList(r); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, a := range r.Attributes {
+		if a.Kind == KindEnum || a.Kind == KindListOfEnum {
+			r.Enums[a.TypeName] = s.Enum(a.TypeName)
+		}
+		r.collectStructs(a.Field, 1, seen)
+	}
+
+	return r, nil
+}
+
+// readStruct returns the structure describing the resource in the Read output.
+func (r *Resource) readStruct(output *Struct) *Struct {
+	object := output.Field(r.Name)
+	if object == nil && len(output.Fields) == 1 {
+		object = output.Fields[0]
+	}
+
+	if object != nil && object.Kind == KindObject {
+		r.ReadOutputField = object.Name
+		r.ReadType = object.TypeName
+		return object.Struct
+	}
+
+	r.ReadType = output.Name
+	r.ReadTypeIsOutput = true
+	return output
+}
+
+func (r *Resource) classifyStatus() {
+	if r.StatusEnum == nil {
+		return
+	}
+
+	for _, v := range r.StatusEnum.Values {
+		switch normalizeStatus(v.Value) {
+		case "CREATING", "CREATE_IN_PROGRESS", "PENDING", "PENDING_CREATION", "PROVISIONING", "IN_PROGRESS", "INITIALIZING", "STARTING":
+			r.StatusCreating = append(r.StatusCreating, v)
+		case "UPDATING", "UPDATE_IN_PROGRESS", "MODIFYING", "PENDING_UPDATE":
+			r.StatusUpdating = append(r.StatusUpdating, v)
+		case "DELETING", "DELETE_IN_PROGRESS", "PENDING_DELETION":
+			r.StatusDeleting = append(r.StatusDeleting, v)
+		case "ACTIVE", "AVAILABLE", "READY", "CREATED", "ENABLED", "RUNNING", "SUCCEEDED", "COMPLETED", "IN_SERVICE", "DEPLOYED", "HEALTHY":
+			r.StatusAvailable = append(r.StatusAvailable, v)
+		}
+	}
+}
+
+func normalizeStatus(s string) string {
+	return strings.NewReplacer("-", "_", " ", "_").Replace(strings.ToUpper(s))
+}
+
+func (s *Service) analyzeList(r *Resource) error {
+	input, err := s.Input(r.Operations.List)
+	if err != nil {
+		return err
+	}
+	output, err := s.Output(r.Operations.List)
+	if err != nil {
+		return err
+	}
+
+	// Resources listed within a parent can't be swept without the parent.
+	for _, f := range input.Fields {
+		if f.Required {
+			return nil
+		}
+	}
+
+	for _, f := range output.Fields {
+		if f.Kind != KindObjectList {
+			continue
+		}
+		for _, n := range []string{r.Identifier.Name, "Arn", r.Name + "Arn", "Id", r.Name + "Id"} {
+			if f.Struct.Field(n) != nil {
+				r.ListItemsField = f.Name
+				r.ListItemIdentifier = n
+				r.ListPaginated = s.HasPaginator(r.Operations.List)
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Resource) collectStructs(f *Field, depth int, seen map[string]bool) {
+	switch f.Kind {
+	case KindDocument:
+		r.SmithyDocument = true
+		return
+	case KindObject, KindObjectList, KindUnion:
+	default:
+		return
+	}
+
+	if depth > MaxDepth || seen[f.Struct.Name] {
+		return
+	}
+	seen[f.Struct.Name] = true
+
+	r.Structs = append(r.Structs, f.Struct)
+	if f.Kind == KindUnion {
+		r.Unions = append(r.Unions, f.Struct)
+		for _, m := range f.Struct.Members {
+			r.collectStructs(m.Value, depth+1, seen)
+		}
+		return
+	}
+	for _, f := range f.Struct.Fields {
+		r.collectStructs(f, depth+1, seen)
+	}
+}
+
+// setModelNames removes the resource name prefix from model field names
+// where doing so does not cause a collision.
+func (r *Resource) setModelNames() {
+	names := make(map[string]bool)
+	for _, a := range r.Attributes {
+		names[a.Name] = true
+	}
+
+	for _, a := range r.Attributes {
+		a.ModelName = a.Name
+		if n, ok := strings.CutPrefix(a.Name, r.Name); ok && n != "" && n[0] >= 'A' && n[0] <= 'Z' && !names[n] {
+			a.ModelName = n
+		}
+	}
+}
+
+// identifier returns the first required field of a Read input, or its only field.
+func identifier(input *Struct) *Field {
+	for _, f := range input.Fields {
+		if f.Required {
+			return f
+		}
+	}
+	if len(input.Fields) == 1 {
+		return input.Fields[0]
+	}
+	return nil
+}
+
+// matchIdentifier returns the name of the input field identifying the resource.
+func matchIdentifier(input *Struct, id *Field) string {
+	if input.Field(id.Name) != nil {
+		return id.Name
+	}
+	if f := identifier(input); f != nil {
+		return f.Name
+	}
+	return ""
+}
+
+func idempotencyToken(input *Struct) string {
+	for _, n := range idempotencyTokenFields {
+		if input.Field(n) != nil {
+			return n
+		}
+	}
+	return ""
+}
+
+func statusField(read *Struct, name string) *Field {
+	for _, n := range []string{"Status", name + "Status", "State", name + "State"} {
+		if f := read.Field(n); f != nil && f.Kind == KindEnum {
+			return f
+		}
+	}
+	return nil
+}
+
+// selector returns the selector of the named field in an output structure,
+// looking one level deep, e.g. "Cluster.ClusterArn".
+func selector(output *Struct, name string) string {
+	if output.Field(name) != nil {
+		return name
+	}
+	for _, f := range output.Fields {
+		if f.Kind == KindObject && f.Struct.Field(name) != nil {
+			return f.Name + "." + name
+		}
+	}
+	return ""
+}
+
+func isBlock(k Kind) bool {
+	return k == KindObject || k == KindObjectList || k == KindUnion
+}
+
+func isARN(name string) bool {
+	return strings.HasSuffix(name, "Arn") || strings.HasSuffix(name, "ARN")
+}
+
+func isSensitive(name string) bool {
+	return (strings.Contains(name, "Password") || strings.Contains(name, "Secret")) && !isARN(name) && !strings.HasSuffix(name, "Id")
+}
@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package shape introspects the Go types of an AWS SDK for Go v2 service
+// package so that resource scaffolding can be generated from API shapes.
+package shape
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Kind classifies the Go type of an AWS API structure field.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindInt32
+	KindInt64
+	KindFloat32
+	KindFloat64
+	KindString
+	KindEnum
+	KindTimestamp
+	KindDocument
+	KindListOfString
+	KindListOfEnum
+	KindMapOfString
+	KindObject
+	KindObjectList
+	KindUnion
+)
+
+// Field is a field of an AWS API structure.
+type Field struct {
+	// Name is the Go field name, e.g. "ClusterName".
+	Name string
+
+	Kind Kind
+
+	// TypeName is the name of the enum, structure or union type in the
+	// service's types package, if any.
+	TypeName string
+
+	// GoType is the field's Go type as declared, for use in TODO comments.
+	GoType string
+
+	// Struct is the structure for KindObject, KindObjectList and KindUnion.
+	Struct *Struct
+
+	// Required is true if the field is documented as "This member is required.".
+	Required bool
+}
+
+// Struct is an AWS API structure or union.
+type Struct struct {
+	// Name is the Go type name, e.g. "Cluster" or "CreateClusterInput".
+	Name string
+
+	Fields []*Field
+
+	// Members are the members of a union, in declaration order.
+	Members []*Member
+}
+
+// Field returns the named field, or nil.
+func (s *Struct) Field(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// IsUnion returns true if the structure represents a union interface type.
+func (s *Struct) IsUnion() bool {
+	return len(s.Members) > 0
+}
+
+// Member is a member of a union, e.g. "PolicyDefinitionMemberStatic".
+type Member struct {
+	// Name is the member's name without the union prefix, e.g. "Static".
+	Name string
+
+	// TypeName is the member type's name, e.g. "PolicyDefinitionMemberStatic".
+	TypeName string
+
+	// Value is the member's Value field.
+	Value *Field
+}
+
+// Enum is an AWS API string enumeration.
+type Enum struct {
+	Name   string
+	Values []EnumValue
+}
+
+// EnumValue is a value of an enumeration.
+type EnumValue struct {
+	// Const is the Go constant name, e.g. "StatusActive".
+	Const string
+
+	// Value is the wire value, e.g. "ACTIVE".
+	Value string
+}
+
+// Service is a loaded AWS SDK for Go v2 service package.
+type Service struct {
+	// Package is the service package name, e.g. "docdbelastic".
+	Package string
+
+	api      *types.Package
+	awstypes *types.Package
+
+	required map[*types.Var]bool
+	structs  map[string]*Struct
+}
+
+const (
+	sdkServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	requiredMemberDoc = "This member is required."
+)
+
+// Load loads the named AWS SDK for Go v2 service package, e.g. "docdbelastic",
+// and its types package. Packages are resolved relative to dir, which must be
+// inside a module that requires the service package.
+func Load(dir, sdkPackage string) (*Service, error) {
+	apiPath := sdkServicePathPrefix + sdkPackage
+	typesPath := apiPath + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, apiPath, typesPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", apiPath, err)
+	}
+
+	s := newService(sdkPackage)
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("loading %s: %s", pkg.PkgPath, pkg.Errors[0])
+		}
+
+		switch pkg.PkgPath {
+		case apiPath:
+			s.api = pkg.Types
+		case typesPath:
+			s.awstypes = pkg.Types
+		}
+		s.addRequired(pkg.Syntax, pkg.TypesInfo)
+	}
+
+	if s.api == nil || s.awstypes == nil {
+		return nil, fmt.Errorf("loading %s: package not found", apiPath)
+	}
+
+	return s, nil
+}
+
+func newService(sdkPackage string) *Service {
+	return &Service{
+		Package:  sdkPackage,
+		required: make(map[*types.Var]bool),
+		structs:  make(map[string]*Struct),
+	}
+}
+
+// addRequired records struct fields documented as required.
+func (s *Service) addRequired(files []*ast.File, info *types.Info) {
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				if field.Doc == nil || !strings.Contains(field.Doc.Text(), requiredMemberDoc) {
+					continue
+				}
+				for _, name := range field.Names {
+					if v, ok := info.Defs[name].(*types.Var); ok {
+						s.required[v] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Input returns the input structure of the named operation, e.g. "CreateCluster".
+func (s *Service) Input(operation string) (*Struct, error) {
+	return s.operationStruct(operation, "Input")
+}
+
+// Output returns the output structure of the named operation.
+func (s *Service) Output(operation string) (*Struct, error) {
+	return s.operationStruct(operation, "Output")
+}
+
+func (s *Service) operationStruct(operation, suffix string) (*Struct, error) {
+	name := operation + suffix
+	obj, ok := s.api.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("operation %q not found in service %q (no type %s)", operation, s.Package, name)
+	}
+
+	return s.structOf(obj), nil
+}
+
+// HasPaginator returns true if the service package has a paginator for the named operation.
+func (s *Service) HasPaginator(operation string) bool {
+	_, ok := s.api.Scope().Lookup("New" + operation + "Paginator").(*types.Func)
+	return ok
+}
+
+// HasError returns true if the service's types package declares the named error type.
+func (s *Service) HasError(name string) bool {
+	obj, ok := s.awstypes.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return false
+	}
+	return types.Implements(types.NewPointer(obj.Type()), errorInterface)
+}
+
+var errorInterface = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// Enum returns the named enumeration from the service's types package, or nil.
+func (s *Service) Enum(name string) *Enum {
+	obj, ok := s.awstypes.Scope().Lookup(name).(*types.TypeName)
+	if !ok || !isString(obj.Type()) {
+		return nil
+	}
+
+	enum := &Enum{Name: name}
+	scope := s.awstypes.Scope()
+	for _, n := range scope.Names() {
+		c, ok := scope.Lookup(n).(*types.Const)
+		if !ok || !types.Identical(c.Type(), obj.Type()) {
+			continue
+		}
+		enum.Values = append(enum.Values, EnumValue{
+			Const: c.Name(),
+			Value: constant.StringVal(c.Val()),
+		})
+	}
+	// Constants are returned sorted by name; order them by declaration.
+	slices.SortFunc(enum.Values, func(a, b EnumValue) int {
+		return int(scope.Lookup(a.Const).Pos() - scope.Lookup(b.Const).Pos())
+	})
+
+	return enum
+}
+
+// structOf returns the structure for the named struct or union type.
+// Structures are cached so that recursive types terminate.
+func (s *Service) structOf(obj *types.TypeName) *Struct {
+	if st, ok := s.structs[obj.Name()]; ok {
+		return st
+	}
+
+	st := &Struct{Name: obj.Name()}
+	s.structs[obj.Name()] = st
+
+	switch u := obj.Type().Underlying().(type) {
+	case *types.Struct:
+		for v := range u.Fields() {
+			if !v.Exported() || v.Embedded() {
+				continue
+			}
+			if v.Name() == "ResultMetadata" {
+				continue
+			}
+			f := s.field(v.Name(), v.Type())
+			f.Required = s.required[v]
+			st.Fields = append(st.Fields, f)
+		}
+	case *types.Interface:
+		st.Members = s.unionMembers(obj)
+	}
+
+	return st
+}
+
+// unionMembers returns the member types of a union interface type, i.e. the
+// types named "<Union>Member<Name>" that implement it.
+func (s *Service) unionMembers(obj *types.TypeName) []*Member {
+	var members []*Member
+
+	iface := obj.Type().Underlying().(*types.Interface)
+	scope := obj.Pkg().Scope()
+	prefix := obj.Name() + "Member"
+	for _, n := range scope.Names() {
+		if !strings.HasPrefix(n, prefix) || n == prefix {
+			continue
+		}
+		tn, ok := scope.Lookup(n).(*types.TypeName)
+		if !ok || !types.Implements(types.NewPointer(tn.Type()), iface) {
+			continue
+		}
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		member := &Member{
+			Name:     strings.TrimPrefix(n, prefix),
+			TypeName: n,
+		}
+		for v := range st.Fields() {
+			if v.Name() == "Value" {
+				member.Value = s.field(v.Name(), v.Type())
+			}
+		}
+		if member.Value != nil {
+			members = append(members, member)
+		}
+	}
+
+	slices.SortFunc(members, func(a, b *Member) int {
+		return int(scope.Lookup(a.TypeName).Pos() - scope.Lookup(b.TypeName).Pos())
+	})
+
+	return members
+}
+
+// field classifies a structure field's Go type.
+func (s *Service) field(name string, typ types.Type) *Field {
+	f := &Field{
+		Name:   name,
+		GoType: types.TypeString(typ, func(p *types.Package) string { return p.Name() }),
+	}
+
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	switch t := typ.(type) {
+	case *types.Basic:
+		f.Kind = basicKind(t)
+	case *types.Named:
+		f.Kind, f.TypeName, f.Struct = s.namedKind(t)
+	case *types.Slice:
+		switch elem := t.Elem().(type) {
+		case *types.Basic:
+			if elem.Kind() == types.String {
+				f.Kind = KindListOfString
+			}
+		case *types.Named:
+			switch kind, typeName, st := s.namedKind(elem); kind {
+			case KindString:
+				f.Kind = KindListOfString
+			case KindEnum:
+				f.Kind, f.TypeName = KindListOfEnum, typeName
+			case KindObject:
+				f.Kind, f.TypeName, f.Struct = KindObjectList, typeName, st
+			}
+		}
+	case *types.Map:
+		if isString(t.Key()) && isString(t.Elem()) {
+			f.Kind = KindMapOfString
+		}
+	}
+
+	return f
+}
+
+func (s *Service) namedKind(t *types.Named) (Kind, string, *Struct) {
+	obj := t.Obj()
+	pkg := obj.Pkg()
+	if pkg == nil {
+		return KindUnsupported, "", nil
+	}
+
+	switch {
+	case pkg.Path() == "time" && obj.Name() == "Time":
+		return KindTimestamp, "", nil
+	case strings.HasSuffix(pkg.Path(), "/document") && obj.Name() == "Interface":
+		return KindDocument, "", nil
+	case pkg != s.awstypes && pkg != s.api:
+		return KindUnsupported, "", nil
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if u.Kind() == types.String {
+			if s.Enum(obj.Name()) != nil && hasValuesMethod(t) {
+				return KindEnum, obj.Name(), nil
+			}
+			return KindString, "", nil
+		}
+		return basicKind(u), "", nil
+	case *types.Struct:
+		return KindObject, obj.Name(), s.structOf(obj)
+	case *types.Interface:
+		if st := s.structOf(obj); st.IsUnion() {
+			return KindUnion, obj.Name(), st
+		}
+	}
+
+	return KindUnsupported, "", nil
+}
+
+func hasValuesMethod(t *types.Named) bool {
+	for m := range t.Methods() {
+		if m.Name() == "Values" {
+			return true
+		}
+	}
+	return false
+}
+
+func basicKind(t *types.Basic) Kind {
+	switch t.Kind() {
+	case types.Bool:
+		return KindBool
+	case types.Int32:
+		return KindInt32
+	case types.Int64:
+		return KindInt64
+	case types.Float32:
+		return KindFloat32
+	case types.Float64:
+		return KindFloat64
+	case types.String:
+		return KindString
+	}
+	return KindUnsupported
+}
+
+func isString(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.String
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shape
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testServiceSource = `package widgets
+
+type Status string
+
+const (
+	StatusCreating Status = "CREATING"
+	StatusActive   Status = "ACTIVE"
+	StatusUpdating Status = "UPDATING"
+	StatusDeleting Status = "DELETING"
+	StatusFailed   Status = "FAILED"
+)
+
+func (Status) Values() []Status {
+	return []Status{"CREATING", "ACTIVE", "UPDATING", "DELETING", "FAILED"}
+}
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+func (e *ResourceNotFoundException) Error() string { return "" }
+
+type Settings struct {
+	// This member is required.
+	Size *int32
+
+	Enabled *bool
+
+	Next *Settings
+}
+
+type Policy interface {
+	isPolicy()
+}
+
+type PolicyMemberInline struct {
+	Value string
+}
+
+func (*PolicyMemberInline) isPolicy() {}
+
+type PolicyMemberSettings struct {
+	Value Settings
+}
+
+func (*PolicyMemberSettings) isPolicy() {}
+
+type Widget struct {
+	// This member is required.
+	WidgetArn *string
+
+	// This member is required.
+	WidgetName *string
+
+	Description *string
+
+	Settings *Settings
+
+	Status Status
+
+	Tags map[string]string
+
+	Unsupported chan int
+}
+
+type WidgetSummary struct {
+	WidgetArn *string
+}
+
+type CreateWidgetInput struct {
+	// This member is required.
+	WidgetName *string
+
+	ClientToken *string
+
+	Description *string
+
+	Policy Policy
+
+	Settings *Settings
+
+	SubnetIds []string
+
+	Tags map[string]string
+}
+
+type CreateWidgetOutput struct {
+	Widget *Widget
+}
+
+type GetWidgetInput struct {
+	// This member is required.
+	WidgetArn *string
+}
+
+type GetWidgetOutput struct {
+	Widget *Widget
+}
+
+type UpdateWidgetInput struct {
+	// This member is required.
+	WidgetArn *string
+
+	Description *string
+}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	WidgetArn *string
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+}
+
+type ListWidgetsOutput struct {
+	NextToken *string
+
+	Widgets []WidgetSummary
+}
+`
+
+func testService(t *testing.T) *Service {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "widgets.go", testServiceSource, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	pkg, err := new(types.Config).Check("widgets", fset, []*ast.File{file}, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := newService("widgets")
+	s.api, s.awstypes = pkg, pkg
+	s.addRequired([]*ast.File{file}, info)
+
+	return s
+}
+
+func TestServiceInput(t *testing.T) {
+	t.Parallel()
+
+	s := testService(t)
+
+	input, err := s.Input("CreateWidget")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type field struct {
+		Name     string
+		Kind     Kind
+		TypeName string
+		Required bool
+	}
+	var got []field
+	for _, f := range input.Fields {
+		got = append(got, field{f.Name, f.Kind, f.TypeName, f.Required})
+	}
+	want := []field{
+		{"WidgetName", KindString, "", true},
+		{"ClientToken", KindString, "", false},
+		{"Description", KindString, "", false},
+		{"Policy", KindUnion, "Policy", false},
+		{"Settings", KindObject, "Settings", false},
+		{"SubnetIds", KindListOfString, "", false},
+		{"Tags", KindMapOfString, "", false},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	policy := input.Field("Policy").Struct
+	if got, want := len(policy.Members), 2; got != want {
+		t.Fatalf("union members = %d, want %d", got, want)
+	}
+	if got, want := policy.Members[0].Name, "Inline"; got != want {
+		t.Errorf("union member = %q, want %q", got, want)
+	}
+	if got, want := policy.Members[1].Value.Kind, KindObject; got != want {
+		t.Errorf("union member value kind = %v, want %v", got, want)
+	}
+
+	if _, err := s.Input("FrobWidget"); err == nil {
+		t.Error("expected error for unknown operation")
+	}
+}
+
+func TestServiceResource(t *testing.T) {
+	t.Parallel()
+
+	s := testService(t)
+
+	r, err := s.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type attribute struct {
+		Name                                                       string
+		ModelName                                                  string
+		Required, Optional, Computed, RequiresReplace, Returned bool
+	}
+	var got []attribute
+	for _, a := range r.Attributes {
+		got = append(got, attribute{a.Name, a.ModelName, a.Required, a.Optional, a.Computed, a.RequiresReplace, a.Returned})
+	}
+	want := []attribute{
+		{Name: "WidgetArn", ModelName: "Arn", Computed: true, Returned: true},
+		{Name: "Description", ModelName: "Description", Optional: true, Computed: true, Returned: true},
+		{Name: "WidgetName", ModelName: "Name", Required: true, RequiresReplace: true, Returned: true},
+		{Name: "Policy", ModelName: "Policy", Optional: true, RequiresReplace: true},
+		{Name: "Settings", ModelName: "Settings", Optional: true, RequiresReplace: true, Returned: true},
+		{Name: "SubnetIds", ModelName: "SubnetIds", Optional: true, RequiresReplace: true},
+		{Name: "Unsupported", ModelName: "Unsupported", Computed: true, Returned: true},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected attributes diff (+want, -got): %s", diff)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		got, want any
+	}{
+		{"Identifier", r.Identifier.Name, "WidgetArn"},
+		{"CreateOutputIdentifier", r.CreateOutputIdentifier, "Widget.WidgetArn"},
+		{"ReadType", r.ReadType, "Widget"},
+		{"ReadOutputField", r.ReadOutputField, "Widget"},
+		{"UpdateIdentifier", r.UpdateIdentifier, "WidgetArn"},
+		{"DeleteIdentifier", r.DeleteIdentifier, "WidgetArn"},
+		{"CreateToken", r.CreateToken, "ClientToken"},
+		{"Tags", r.Tags, true},
+		{"Status", r.Status.Name, "Status"},
+		{"StatusCreating", r.StatusCreating, []EnumValue{{"StatusCreating", "CREATING"}}},
+		{"StatusUpdating", r.StatusUpdating, []EnumValue{{"StatusUpdating", "UPDATING"}}},
+		{"StatusDeleting", r.StatusDeleting, []EnumValue{{"StatusDeleting", "DELETING"}}},
+		{"StatusAvailable", r.StatusAvailable, []EnumValue{{"StatusActive", "ACTIVE"}}},
+		{"NotFoundError", r.NotFoundError, "ResourceNotFoundException"},
+		{"ListItemsField", r.ListItemsField, "Widgets"},
+		{"ListItemIdentifier", r.ListItemIdentifier, "WidgetArn"},
+		{"Unions", len(r.Unions), 1},
+		// Settings is recursive and is only collected once.
+		{"Structs", len(r.Structs), 2},
+	} {
+		if diff := cmp.Diff(tc.got, tc.want); diff != "" {
+			t.Errorf("%s: unexpected diff (+want, -got): %s", tc.name, diff)
+		}
+	}
+}
+
+func TestServiceResourceRequiresCreateAndRead(t *testing.T) {
+	t.Parallel()
+
+	s := testService(t)
+
+	if _, err := s.Resource("Widget", Operations{Create: "CreateWidget"}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package check contains logic shared by the plan and state checks.
+package check
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// MergedTags verifies that the tags_all attribute equals defaultTags merged with the tags attribute,
+// with resource tags taking precedence.
+func MergedTags(attributes map[string]any, defaultTags map[string]string) error {
+	want := make(map[string]any, len(defaultTags))
+	for k, v := range defaultTags {
+		want[k] = v
+	}
+
+	if v, ok := attributes[names.AttrTags]; ok && v != nil {
+		tags, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected map value for %s attribute, got: %T", names.AttrTags, v)
+		}
+		maps.Copy(want, tags)
+	}
+
+	got := map[string]any{}
+	if v, ok := attributes[names.AttrTagsAll]; ok && v != nil {
+		tagsAll, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected map value for %s attribute, got: %T", names.AttrTagsAll, v)
+		}
+		got = tagsAll
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		return fmt.Errorf("%s does not equal default tags merged with %s (-want +got):\n%s", names.AttrTagsAll, names.AttrTags, diff)
+	}
+
+	return nil
+}
+
+// PathString returns the string representation of a Terraform JSON path, such as
+// an element of a resource change's ReplacePaths, matching tfjsonpath.Path's String().
+func PathString(path []any) (string, error) {
+	if len(path) == 0 {
+		return "", nil
+	}
+
+	var p tfjsonpath.Path
+	for i, step := range path {
+		switch step := step.(type) {
+		case string:
+			if i == 0 {
+				p = tfjsonpath.New(step)
+			} else {
+				p = p.AtMapKey(step)
+			}
+		case float64:
+			if i == 0 {
+				p = tfjsonpath.New(int(step))
+			} else {
+				p = p.AtSliceIndex(int(step))
+			}
+		default:
+			return "", fmt.Errorf("unexpected path step type: %T", step)
+		}
+	}
+
+	return p.String(), nil
+}
+
+// PathHasPrefix returns true if path equals prefix or is nested under it.
+func PathHasPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+".")
+}
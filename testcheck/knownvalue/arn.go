@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package knownvalue contains known value checks for AWS values for use with
+// terraform-plugin-testing plan and state checks, both in this provider and in
+// modules that use it.
+package knownvalue
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+)
+
+var _ knownvalue.Check = arnCheck{}
+
+type arnCheck struct {
+	partition      string
+	service        string
+	region         string
+	accountID      string
+	resourceRegexp *regexp.Regexp
+}
+
+// CheckValue determines whether the passed value is a string ARN with the expected components.
+func (v arnCheck) CheckValue(other any) error {
+	otherVal, ok := other.(string)
+
+	if !ok {
+		return fmt.Errorf("expected string value for ARN check, got: %T", other)
+	}
+
+	parsed, err := arn.Parse(otherVal)
+
+	if err != nil {
+		return fmt.Errorf("expected ARN value for ARN check, got: %s (%w)", otherVal, err)
+	}
+
+	for _, c := range []struct {
+		name, want, got string
+	}{
+		{"partition", v.partition, parsed.Partition},
+		{"service", v.service, parsed.Service},
+		{"region", v.region, parsed.Region},
+		{"account ID", v.accountID, parsed.AccountID},
+	} {
+		if c.got != c.want {
+			return fmt.Errorf("expected %s %q for ARN check, got: %s", c.name, c.want, otherVal)
+		}
+	}
+
+	if v.resourceRegexp != nil && !v.resourceRegexp.MatchString(parsed.Resource) {
+		return fmt.Errorf("expected resource matching %q for ARN check, got: %s", v.resourceRegexp, otherVal)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the value.
+func (v arnCheck) String() string {
+	resource := ".*"
+	if v.resourceRegexp != nil {
+		resource = v.resourceRegexp.String()
+	}
+
+	return arn.ARN{
+		Partition: v.partition,
+		Service:   v.service,
+		Region:    v.region,
+		AccountID: v.accountID,
+		Resource:  resource,
+	}.String()
+}
+
+// RegionalARN returns a Check for asserting equality between the supplied regional ARN components
+// and the value passed to the CheckValue method.
+// The ARN's resource component must match the anchored resource regular expression, if not nil.
+// Pass an empty accountID for ARNs without an account ID.
+func RegionalARN(partition, service, region, accountID string, resource *regexp.Regexp) arnCheck {
+	return arnCheck{
+		partition:      partition,
+		service:        service,
+		region:         region,
+		accountID:      accountID,
+		resourceRegexp: anchor(resource),
+	}
+}
+
+// GlobalARN returns a Check for asserting equality between the supplied global (region-less) ARN components
+// and the value passed to the CheckValue method.
+// The ARN's resource component must match the anchored resource regular expression, if not nil.
+// Pass an empty accountID for ARNs without an account ID.
+func GlobalARN(partition, service, accountID string, resource *regexp.Regexp) arnCheck {
+	return RegionalARN(partition, service, "", accountID, resource)
+}
+
+func anchor(re *regexp.Regexp) *regexp.Regexp {
+	if re == nil {
+		return nil
+	}
+
+	return regexp.MustCompile(`^(?:` + re.String() + `)$`)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package knownvalue_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/testcheck/knownvalue"
+)
+
+func TestARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		check       knownvalue.Check
+		value       any
+		expectError bool
+	}{
+		"regional match": {
+			check: tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", regexache.MustCompile(`tf-acc-.+`)),
+			value: "arn:aws:sqs:us-west-2:123456789012:tf-acc-test",
+		},
+		"regional any resource": {
+			check: tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", nil),
+			value: "arn:aws:sqs:us-west-2:123456789012:anything",
+		},
+		"regional resource is anchored": {
+			check:       tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", regexache.MustCompile(`queue`)),
+			value:       "arn:aws:sqs:us-west-2:123456789012:my-queue",
+			expectError: true,
+		},
+		"wrong partition": {
+			check:       tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", nil),
+			value:       "arn:aws-us-gov:sqs:us-west-2:123456789012:q",
+			expectError: true,
+		},
+		"wrong service": {
+			check:       tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", nil),
+			value:       "arn:aws:sns:us-west-2:123456789012:q",
+			expectError: true,
+		},
+		"wrong region": {
+			check:       tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", nil),
+			value:       "arn:aws:sqs:us-east-1:123456789012:q",
+			expectError: true,
+		},
+		"wrong account": {
+			check:       tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", nil),
+			value:       "arn:aws:sqs:us-west-2:210987654321:q",
+			expectError: true,
+		},
+		"global match": {
+			check: tfknownvalue.GlobalARN("aws", "iam", "123456789012", regexache.MustCompile(`role/.+`)),
+			value: "arn:aws:iam::123456789012:role/test",
+		},
+		"global no account": {
+			check: tfknownvalue.GlobalARN("aws", "s3", "", regexache.MustCompile(`bucket`)),
+			value: "arn:aws:s3:::bucket",
+		},
+		"global with region": {
+			check:       tfknownvalue.GlobalARN("aws", "iam", "123456789012", nil),
+			value:       "arn:aws:iam:us-west-2:123456789012:role/test",
+			expectError: true,
+		},
+		"not an ARN": {
+			check:       tfknownvalue.GlobalARN("aws", "iam", "123456789012", nil),
+			value:       "role/test",
+			expectError: true,
+		},
+		"not a string": {
+			check:       tfknownvalue.GlobalARN("aws", "iam", "123456789012", nil),
+			value:       true,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.check.CheckValue(testCase.value)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("CheckValue(%v) error = %v, expected error: %t", testCase.value, err, want)
+			}
+		})
+	}
+}
+
+func TestARNString(t *testing.T) {
+	t.Parallel()
+
+	if got, want := tfknownvalue.RegionalARN("aws", "sqs", "us-west-2", "123456789012", regexache.MustCompile(`q.+`)).String(), "arn:aws:sqs:us-west-2:123456789012:^(?:q.+)$"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestIAMPolicyEquivalent(t *testing.T) {
+	t.Parallel()
+
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`
+
+	testCases := map[string]struct {
+		value       any
+		expectError bool
+	}{
+		"equivalent": {
+			value: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": ["*"]
+  }
+}`,
+		},
+		"different": {
+			value:       `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectError: true,
+		},
+		"invalid": {
+			value:       `{`,
+			expectError: true,
+		},
+		"not a string": {
+			value:       42,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfknownvalue.IAMPolicyEquivalent(policy).CheckValue(testCase.value)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("CheckValue error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
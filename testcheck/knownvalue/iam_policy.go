@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package knownvalue
+
+import (
+	"fmt"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+)
+
+var _ knownvalue.Check = iamPolicyEquivalentCheck{}
+
+type iamPolicyEquivalentCheck struct {
+	policy string
+}
+
+// CheckValue determines whether the passed value is an IAM policy document equivalent to the expected policy.
+// Equivalence ignores formatting, statement ordering and single-element versus list values.
+func (v iamPolicyEquivalentCheck) CheckValue(other any) error {
+	otherVal, ok := other.(string)
+
+	if !ok {
+		return fmt.Errorf("expected string value for IAMPolicyEquivalent check, got: %T", other)
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(v.policy, otherVal)
+
+	if err != nil {
+		return fmt.Errorf("comparing IAM policies for IAMPolicyEquivalent check: %w", err)
+	}
+
+	if !equivalent {
+		return fmt.Errorf("expected IAM policy equivalent to %s for IAMPolicyEquivalent check, got: %s", v.policy, otherVal)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the value.
+func (v iamPolicyEquivalentCheck) String() string {
+	return v.policy
+}
+
+// IAMPolicyEquivalent returns a Check for asserting that the value passed to the CheckValue method
+// is an IAM policy document equivalent to the supplied JSON policy.
+func IAMPolicyEquivalent(policy string) iamPolicyEquivalentCheck {
+	return iamPolicyEquivalentCheck{
+		policy: policy,
+	}
+}
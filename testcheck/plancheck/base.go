@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plancheck contains plan checks for AWS resources for use in
+// terraform-plugin-testing acceptance tests, both in this provider and in
+// modules that use it.
+package plancheck
+
+import (
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func resourceChange(resourceAddress string, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) (*tfjson.ResourceChange, bool) {
+	if req.Plan == nil {
+		resp.Error = fmt.Errorf("plan is nil")
+
+		return nil, false
+	}
+
+	for _, r := range req.Plan.ResourceChanges {
+		if resourceAddress == r.Address {
+			return r, true
+		}
+	}
+
+	resp.Error = fmt.Errorf("%s - Resource not found in plan", resourceAddress)
+
+	return nil, false
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/testcheck/internal/check"
+)
+
+var _ plancheck.PlanCheck = expectMergedTagsCheck{}
+
+type expectMergedTagsCheck struct {
+	resourceAddress string
+	defaultTags     map[string]string
+}
+
+func (e expectMergedTagsCheck) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	resource, ok := resourceChange(e.resourceAddress, req, resp)
+	if !ok {
+		return
+	}
+
+	if unknown, ok := resource.Change.AfterUnknown.(map[string]any); ok && unknown[names.AttrTagsAll] == true {
+		resp.Error = fmt.Errorf("%s - %s is unknown in plan", e.resourceAddress, names.AttrTagsAll)
+
+		return
+	}
+
+	after, ok := resource.Change.After.(map[string]any)
+	if !ok {
+		resp.Error = fmt.Errorf("%s - planned values are not an object: %T", e.resourceAddress, resource.Change.After)
+
+		return
+	}
+
+	if err := check.MergedTags(after, e.defaultTags); err != nil {
+		resp.Error = fmt.Errorf("%s - %w", e.resourceAddress, err)
+
+		return
+	}
+}
+
+// ExpectMergedTags returns a plan check that asserts that the resource's planned tags_all value
+// equals the provider's default_tags merged with the resource's tags.
+func ExpectMergedTags(resourceAddress string, defaultTags map[string]string) plancheck.PlanCheck {
+	return expectMergedTagsCheck{
+		resourceAddress: resourceAddress,
+		defaultTags:     defaultTags,
+	}
+}
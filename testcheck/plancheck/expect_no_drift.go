@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = expectNoDriftCheck{}
+
+type expectNoDriftCheck struct {
+	resourceAddress string
+}
+
+func (e expectNoDriftCheck) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	if req.Plan == nil {
+		resp.Error = fmt.Errorf("plan is nil")
+
+		return
+	}
+
+	var errs []error
+
+	for _, r := range req.Plan.ResourceDrift {
+		if e.resourceAddress != "" && e.resourceAddress != r.Address {
+			continue
+		}
+
+		if r.Change == nil || r.Change.Actions.NoOp() {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s - drift detected after refresh: %s", r.Address, r.Change.Actions))
+	}
+
+	resp.Error = errors.Join(errs...)
+}
+
+// ExpectNoDrift returns a plan check that asserts that refreshing state detected
+// no changes made outside of Terraform to any resource.
+// Use it in a test step's ConfigPlanChecks.PostApplyPostRefresh, or in a RefreshState
+// step's RefreshPlanChecks.PostRefresh.
+func ExpectNoDrift() plancheck.PlanCheck {
+	return expectNoDriftCheck{}
+}
+
+// ExpectNoResourceDrift returns a plan check that asserts that refreshing state detected
+// no changes made outside of Terraform to the given resource.
+func ExpectNoResourceDrift(resourceAddress string) plancheck.PlanCheck {
+	return expectNoDriftCheck{
+		resourceAddress: resourceAddress,
+	}
+}
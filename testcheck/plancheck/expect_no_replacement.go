@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/testcheck/internal/check"
+)
+
+var _ plancheck.PlanCheck = expectNoReplacementCheck{}
+
+type expectNoReplacementCheck struct {
+	resourceAddress string
+	attributePath   tfjsonpath.Path
+}
+
+func (e expectNoReplacementCheck) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	resource, ok := resourceChange(e.resourceAddress, req, resp)
+	if !ok {
+		return
+	}
+
+	if !resource.Change.Actions.Replace() {
+		return
+	}
+
+	want := e.attributePath.String()
+	for _, v := range resource.Change.ReplacePaths {
+		path, ok := v.([]any)
+		if !ok {
+			resp.Error = fmt.Errorf("%s - unexpected replace path type: %T", e.resourceAddress, v)
+
+			return
+		}
+
+		got, err := check.PathString(path)
+		if err != nil {
+			resp.Error = fmt.Errorf("%s - %w", e.resourceAddress, err)
+
+			return
+		}
+
+		if check.PathHasPrefix(got, want) {
+			resp.Error = fmt.Errorf("%s - replacement planned due to change at path: %s", e.resourceAddress, got)
+
+			return
+		}
+	}
+}
+
+// ExpectNoReplacement returns a plan check that asserts that a change to the attribute at the given path,
+// or to any value nested under it, does not cause the resource to be replaced.
+// Replacements caused by other attributes are ignored.
+func ExpectNoReplacement(resourceAddress string, attributePath tfjsonpath.Path) plancheck.PlanCheck {
+	return expectNoReplacementCheck{
+		resourceAddress: resourceAddress,
+		attributePath:   attributePath,
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/testcheck/plancheck"
+)
+
+const testResourceAddress = "aws_example_thing.test"
+
+func runPlanCheck(check plancheck.PlanCheck, plan *tfjson.Plan) error {
+	var resp plancheck.CheckPlanResponse
+	check.CheckPlan(context.Background(), plancheck.CheckPlanRequest{Plan: plan}, &resp)
+	return resp.Error
+}
+
+func TestExpectNoReplacement(t *testing.T) {
+	t.Parallel()
+
+	replace := tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}
+
+	testCases := map[string]struct {
+		change      *tfjson.Change
+		path        tfjsonpath.Path
+		expectError bool
+	}{
+		"update": {
+			change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			path:   tfjsonpath.New("name"),
+		},
+		"replaced by path": {
+			change:      &tfjson.Change{Actions: replace, ReplacePaths: []any{[]any{"name"}}},
+			path:        tfjsonpath.New("name"),
+			expectError: true,
+		},
+		"replaced by nested path": {
+			change:      &tfjson.Change{Actions: replace, ReplacePaths: []any{[]any{"settings", float64(0), "size"}}},
+			path:        tfjsonpath.New("settings"),
+			expectError: true,
+		},
+		"replaced by other path": {
+			change: &tfjson.Change{Actions: replace, ReplacePaths: []any{[]any{"name"}}},
+			path:   tfjsonpath.New("description"),
+		},
+		"replaced by path with common prefix": {
+			change: &tfjson.Change{Actions: replace, ReplacePaths: []any{[]any{"name_prefix"}}},
+			path:   tfjsonpath.New("name"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{{Address: testResourceAddress, Change: testCase.change}},
+			}
+			err := runPlanCheck(tfplancheck.ExpectNoReplacement(testResourceAddress, testCase.path), plan)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestExpectNoDrift(t *testing.T) {
+	t.Parallel()
+
+	plan := &tfjson.Plan{
+		ResourceDrift: []*tfjson.ResourceChange{
+			{Address: "aws_example_thing.other", Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}}},
+			{Address: testResourceAddress, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}}},
+		},
+	}
+
+	if err := runPlanCheck(tfplancheck.ExpectNoResourceDrift(testResourceAddress), plan); err != nil {
+		t.Errorf("ExpectNoResourceDrift: unexpected error: %s", err)
+	}
+
+	if err := runPlanCheck(tfplancheck.ExpectNoDrift(), plan); err == nil {
+		t.Error("ExpectNoDrift: expected error")
+	}
+
+	if err := runPlanCheck(tfplancheck.ExpectNoDrift(), &tfjson.Plan{}); err != nil {
+		t.Errorf("ExpectNoDrift: unexpected error: %s", err)
+	}
+}
+
+func TestExpectMergedTags(t *testing.T) {
+	t.Parallel()
+
+	defaultTags := map[string]string{"env": "test", "owner": "default"}
+
+	testCases := map[string]struct {
+		change      *tfjson.Change
+		expectError bool
+	}{
+		"merged": {
+			change: &tfjson.Change{
+				After: map[string]any{
+					"tags":     map[string]any{"owner": "resource"},
+					"tags_all": map[string]any{"env": "test", "owner": "resource"},
+				},
+			},
+		},
+		"no resource tags": {
+			change: &tfjson.Change{
+				After: map[string]any{
+					"tags":     nil,
+					"tags_all": map[string]any{"env": "test", "owner": "default"},
+				},
+			},
+		},
+		"missing default tag": {
+			change: &tfjson.Change{
+				After: map[string]any{
+					"tags":     map[string]any{"owner": "resource"},
+					"tags_all": map[string]any{"owner": "resource"},
+				},
+			},
+			expectError: true,
+		},
+		"unknown": {
+			change: &tfjson.Change{
+				After:        map[string]any{"tags": map[string]any{}},
+				AfterUnknown: map[string]any{"tags_all": true},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{{Address: testResourceAddress, Change: testCase.change}},
+			}
+			err := runPlanCheck(tfplancheck.ExpectMergedTags(testResourceAddress, defaultTags), plan)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package statecheck contains state checks for AWS resources for use in
+// terraform-plugin-testing acceptance tests, both in this provider and in
+// modules that use it.
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/testcheck/internal/check"
+)
+
+var _ statecheck.StateCheck = expectMergedTagsCheck{}
+
+type expectMergedTagsCheck struct {
+	resourceAddress string
+	defaultTags     map[string]string
+}
+
+func (e expectMergedTagsCheck) CheckState(ctx context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	if req.State == nil || req.State.Values == nil || req.State.Values.RootModule == nil {
+		resp.Error = fmt.Errorf("state does not contain a root module")
+
+		return
+	}
+
+	for _, r := range req.State.Values.RootModule.Resources {
+		if e.resourceAddress != r.Address {
+			continue
+		}
+
+		if err := check.MergedTags(r.AttributeValues, e.defaultTags); err != nil {
+			resp.Error = fmt.Errorf("%s - %w", e.resourceAddress, err)
+		}
+
+		return
+	}
+
+	resp.Error = fmt.Errorf("%s - Resource not found in state", e.resourceAddress)
+}
+
+// ExpectMergedTags returns a state check that asserts that the resource's tags_all value
+// equals the provider's default_tags merged with the resource's tags.
+func ExpectMergedTags(resourceAddress string, defaultTags map[string]string) statecheck.StateCheck {
+	return expectMergedTagsCheck{
+		resourceAddress: resourceAddress,
+		defaultTags:     defaultTags,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/testcheck/statecheck"
+)
+
+func TestExpectMergedTags(t *testing.T) {
+	t.Parallel()
+
+	const resourceAddress = "aws_example_thing.test"
+	defaultTags := map[string]string{"env": "test"}
+
+	testCases := map[string]struct {
+		resources   []*tfjson.StateResource
+		expectError bool
+	}{
+		"merged": {
+			resources: []*tfjson.StateResource{{
+				Address: resourceAddress,
+				AttributeValues: map[string]any{
+					"tags":     map[string]any{"Name": "test"},
+					"tags_all": map[string]any{"Name": "test", "env": "test"},
+				},
+			}},
+		},
+		"not merged": {
+			resources: []*tfjson.StateResource{{
+				Address: resourceAddress,
+				AttributeValues: map[string]any{
+					"tags":     map[string]any{"Name": "test"},
+					"tags_all": map[string]any{"Name": "test"},
+				},
+			}},
+			expectError: true,
+		},
+		"extra tag": {
+			resources: []*tfjson.StateResource{{
+				Address: resourceAddress,
+				AttributeValues: map[string]any{
+					"tags_all": map[string]any{"env": "test", "extra": "value"},
+				},
+			}},
+			expectError: true,
+		},
+		"not found": {
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := statecheck.CheckStateRequest{
+				State: &tfjson.State{
+					Values: &tfjson.StateValues{
+						RootModule: &tfjson.StateModule{Resources: testCase.resources},
+					},
+				},
+			}
+			var resp statecheck.CheckStateResponse
+			tfstatecheck.ExpectMergedTags(resourceAddress, defaultTags).CheckState(context.Background(), req, &resp)
+
+			if got, want := resp.Error != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", resp.Error, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+// crudGenerator generates Plugin Framework CRUD handler bodies from a Plugin SDK resource's handlers.
+// Anything that can't be translated mechanically is replaced by a TODO comment so that the generated code still compiles.
+type crudGenerator struct {
+	Attributes  []attribute
+	HasTimeouts bool
+	ModelName   string
+	Source      *sdkResource
+	TFTypeName  string
+
+	usedImports map[string]string // Import paths of packages referenced by translated code, keyed by package name.
+}
+
+// create returns the body of the Create handler.
+func (g *crudGenerator) create() string {
+	h := g.Source.Create
+	if h == nil || h.Function == nil {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	t := g.translator(h, "data")
+
+	fprintf(&sb, "var data %s\n", g.ModelName)
+	fprintf(&sb, "response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+
+	op := h.operation()
+	if op == nil {
+		fprintf(&sb, "// TODO Migrate %s.\n", h.Name)
+		fprintf(&sb, "data.ID = types.StringValue(\"TODO\")\n\n")
+		fprintf(&sb, "response.Diagnostics.Append(response.State.Set(ctx, &data)...)\n")
+
+		return sb.String()
+	}
+
+	g.emitConn(&sb, h)
+	g.emitInput(&sb, h, op, "data")
+	if h.TagsIn {
+		fprintf(&sb, "\n// Additional fields.\ninput.Tags = getTagsIn(ctx)\n")
+	}
+
+	fprintf(&sb, "\noutput, err := conn.%s(ctx, &input)\n\n", op.Operation)
+	fprintf(&sb, "if err != nil {\n")
+	fprintf(&sb, "response.Diagnostics.AddError(%q, err.Error())\n\n", "creating "+g.humanName())
+	fprintf(&sb, "return\n}\n\n")
+
+	fprintf(&sb, "// Set values for unknowns.\n")
+	fprintf(&sb, "response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n")
+	t.vars[op.Output] = "output"
+	if h.IDExpr == nil {
+		fprintf(&sb, "data.ID = types.StringValue(\"TODO\") // TODO Set the resource's ID.\n")
+	} else if v, err := t.expr(h.IDExpr); err != nil {
+		fprintf(&sb, "data.ID = types.StringValue(\"TODO\") // TODO Set the resource's ID from %s: %s.\n", g.Source.Package.source(h.IDExpr), err)
+	} else {
+		fprintf(&sb, "\n")
+		t.emitStmts(&sb, strconv.Quote("creating "+g.humanName()))
+		fprintf(&sb, "data.ID = types.StringValue(%s)\n", v)
+	}
+
+	g.emitWaiter(&sb, t, h.helper("wait"), "create", "data", true)
+
+	fprintf(&sb, "\nresponse.Diagnostics.Append(response.State.Set(ctx, data)...)\n")
+
+	return sb.String()
+}
+
+// read returns the body of the Read handler.
+func (g *crudGenerator) read() string {
+	h := g.Source.Read
+	if h == nil || h.Function == nil {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	t := g.translator(h, "data")
+
+	fprintf(&sb, "var data %s\n", g.ModelName)
+	fprintf(&sb, "response.Diagnostics.Append(request.State.Get(ctx, &data)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+
+	finder := h.helper("find")
+	args, err := t.args(finder)
+	if finder == nil || finder.Results != 2 || err != nil {
+		if finder != nil {
+			fprintf(&sb, "// TODO Migrate call to %s: %s.\n", finder.Function, errorString(err))
+		} else {
+			fprintf(&sb, "// TODO Migrate %s.\n", h.Name)
+		}
+		fprintf(&sb, "\nresponse.Diagnostics.Append(response.State.Set(ctx, &data)...)\n")
+
+		return sb.String()
+	}
+
+	g.emitConn(&sb, h)
+	t.vars[finder.Output] = "output"
+
+	t.emitStmts(&sb, fmt.Sprintf("fmt.Sprintf(%q, data.ID.ValueString())", "reading "+g.humanName()+" (%s)"))
+	fprintf(&sb, "output, err := %s(%s)\n\n", finder.Function, args)
+	fprintf(&sb, "if tfresource.NotFound(err) {\n")
+	fprintf(&sb, "response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))\n")
+	fprintf(&sb, "response.State.RemoveResource(ctx)\n\n")
+	fprintf(&sb, "return\n}\n\n")
+	fprintf(&sb, "if err != nil {\n")
+	fprintf(&sb, "response.Diagnostics.AddError(fmt.Sprintf(%q, data.ID.ValueString()), err.Error())\n\n", "reading "+g.humanName()+" (%s)")
+	fprintf(&sb, "return\n}\n\n")
+
+	fprintf(&sb, "// Set attributes for import.\n")
+	fprintf(&sb, "response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n")
+
+	if h.TagsOut != nil {
+		if v, err := t.expr(h.TagsOut); err != nil {
+			fprintf(&sb, "\n// TODO setTagsOut(ctx, %s): %s.\n", g.Source.Package.source(h.TagsOut), err)
+		} else {
+			fprintf(&sb, "\n")
+			t.emitStmts(&sb, fmt.Sprintf("fmt.Sprintf(%q, data.ID.ValueString())", "reading "+g.humanName()+" (%s)"))
+			fprintf(&sb, "setTagsOut(ctx, %s)\n", v)
+		}
+	}
+
+	fprintf(&sb, "\nresponse.Diagnostics.Append(response.State.Set(ctx, &data)...)\n")
+
+	return sb.String()
+}
+
+// update returns the body of the Update handler.
+func (g *crudGenerator) update() string {
+	h := g.Source.Update
+	if h == nil || h.Function == nil {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	t := g.translator(h, "new")
+
+	fprintf(&sb, "var old, new %s\n", g.ModelName)
+	fprintf(&sb, "response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n")
+	fprintf(&sb, "response.Diagnostics.Append(request.State.Get(ctx, &old)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+
+	var conditions []string
+	for _, v := range g.Attributes {
+		switch v.Name {
+		case "tags", "tags_all", "timeouts":
+			continue
+		}
+
+		if v.Updatable {
+			conditions = append(conditions, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", v.FieldName))
+		}
+	}
+
+	// Tags are updated by the transparent tagging interceptor.
+	if op := h.operation(); op != nil && len(conditions) > 0 {
+		g.emitConn(&sb, h)
+
+		fprintf(&sb, "if %s {\n", strings.Join(conditions, " ||\n"))
+		g.emitInput(&sb, h, op, "new")
+		fprintf(&sb, "\n_, err := conn.%s(ctx, &input)\n\n", op.Operation)
+		fprintf(&sb, "if err != nil {\n")
+		fprintf(&sb, "response.Diagnostics.AddError(fmt.Sprintf(%q, new.ID.ValueString()), err.Error())\n\n", "updating "+g.humanName()+" (%s)")
+		fprintf(&sb, "return\n}\n")
+		g.emitWaiter(&sb, t, h.helper("wait"), "update", "new", false)
+		fprintf(&sb, "}\n\n")
+	} else if op != nil {
+		fprintf(&sb, "// TODO Migrate %s.\n\n", h.Name)
+	}
+
+	fprintf(&sb, "response.Diagnostics.Append(response.State.Set(ctx, &new)...)\n")
+
+	return sb.String()
+}
+
+// delete returns the body of the Delete handler.
+func (g *crudGenerator) delete() string {
+	h := g.Source.Delete
+	if h == nil || h.Function == nil {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	t := g.translator(h, "data")
+
+	fprintf(&sb, "var data %s\n", g.ModelName)
+	fprintf(&sb, "response.Diagnostics.Append(request.State.Get(ctx, &data)...)\n")
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+
+	op := h.operation()
+	if op == nil {
+		fprintf(&sb, "// TODO Migrate %s.\n", h.Name)
+
+		return sb.String()
+	}
+
+	g.emitConn(&sb, h)
+	g.emitInput(&sb, h, op, "data")
+	fprintf(&sb, "\n_, err := conn.%s(ctx, &input)\n\n", op.Operation)
+
+	if h.NotFound != nil {
+		if v, err := t.expr(h.NotFound); err != nil {
+			fprintf(&sb, "// TODO Ignore not found errors (%s): %s.\n\n", g.Source.Package.source(h.NotFound), err)
+		} else {
+			t.emitStmts(&sb, fmt.Sprintf("fmt.Sprintf(%q, data.ID.ValueString())", "deleting "+g.humanName()+" (%s)"))
+			fprintf(&sb, "if %s {\nreturn\n}\n\n", v)
+		}
+	}
+
+	fprintf(&sb, "if err != nil {\n")
+	fprintf(&sb, "response.Diagnostics.AddError(fmt.Sprintf(%q, data.ID.ValueString()), err.Error())\n\n", "deleting "+g.humanName()+" (%s)")
+	fprintf(&sb, "return\n}\n")
+
+	g.emitWaiter(&sb, t, h.helper("wait"), "delete", "data", false)
+
+	return sb.String()
+}
+
+func (g *crudGenerator) emitConn(sb *strings.Builder, h *sdkHandler) {
+	if h.ClientMethod == "" {
+		fprintf(sb, "// TODO conn := r.Meta().ServiceClient(ctx)\n\n")
+
+		return
+	}
+
+	fprintf(sb, "conn := r.Meta().%s(ctx)\n\n", h.ClientMethod)
+}
+
+// emitInput emits the AWS API operation's input, expanded from the specified model variable.
+func (g *crudGenerator) emitInput(sb *strings.Builder, h *sdkHandler, op *sdkCall, data string) {
+	name, importPath, ok := h.inputPackage()
+	if !ok {
+		fprintf(sb, "var input struct{} // TODO Declare %sInput.\n", op.Operation)
+
+		return
+	}
+
+	g.usedImports[name] = importPath
+
+	fprintf(sb, "var input %s.%sInput\n", name, op.Operation)
+	fprintf(sb, "response.Diagnostics.Append(fwflex.Expand(ctx, %s, &input)...)\n", data)
+	fprintf(sb, "if response.Diagnostics.HasError() {\nreturn\n}\n")
+}
+
+// emitWaiter emits a call to the specified waiter, if any.
+func (g *crudGenerator) emitWaiter(sb *strings.Builder, t *translator, waiter *sdkCall, operation, data string, taint bool) {
+	if waiter == nil {
+		return
+	}
+
+	args, err := t.args(waiter)
+	if err != nil {
+		fprintf(sb, "\n// TODO Migrate call to %s: %s.\n", waiter.Function, err)
+
+		return
+	}
+
+	result := "_, err"
+	if waiter.Results == 1 {
+		result = "err"
+	}
+
+	fprintf(sb, "\n")
+	t.emitStmts(sb, fmt.Sprintf("fmt.Sprintf(%q, %s.ID.ValueString())", "waiting for "+g.humanName()+" (%s) "+operation, data))
+	fprintf(sb, "if %s := %s(%s); err != nil {\n", result, waiter.Function, args)
+	if taint {
+		fprintf(sb, "response.State.SetAttribute(ctx, path.Root(names.AttrID), %s.ID) // Set 'id' so as to taint the resource.\n", data)
+	}
+	fprintf(sb, "response.Diagnostics.AddError(fmt.Sprintf(%q, %s.ID.ValueString()), err.Error())\n\n", "waiting for "+g.humanName()+" (%s) "+operation, data)
+	fprintf(sb, "return\n}\n")
+}
+
+func (g *crudGenerator) humanName() string {
+	if v := g.Source.HumanName; v != "" {
+		return v
+	}
+
+	return g.TFTypeName
+}
+
+func (g *crudGenerator) translator(h *sdkHandler, data string) *translator {
+	return &translator{
+		data:      data,
+		generator: g,
+		handler:   h,
+		vars: map[string]string{
+			h.ConnName: "conn",
+			h.CtxName:  "ctx",
+		},
+	}
+}
+
+// translator translates Plugin SDK handler expressions to the equivalent Plugin Framework code.
+type translator struct {
+	data      string // Name of the model variable.
+	depth     int
+	generator *crudGenerator
+	handler   *sdkHandler
+	stmts     []string          // Statements, each assigning a variable and err, on which translated expressions depend.
+	vars      map[string]string // Translated variable names.
+}
+
+// emitStmts emits any statements on which translated expressions depend.
+func (t *translator) emitStmts(sb *strings.Builder, errorArgs string) {
+	for _, v := range t.stmts {
+		fprintf(sb, "%s\n\n", v)
+		fprintf(sb, "if err != nil {\n")
+		fprintf(sb, "response.Diagnostics.AddError(%s, err.Error())\n\n", errorArgs)
+		fprintf(sb, "return\n}\n\n")
+	}
+
+	t.stmts = nil
+}
+
+// args translates the arguments of the specified call.
+func (t *translator) args(call *sdkCall) (string, error) {
+	if call == nil {
+		return "", nil
+	}
+
+	var args []string
+	for _, v := range call.Call.Args {
+		v, err := t.expr(v)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, v)
+	}
+
+	return strings.Join(args, ", "), nil
+}
+
+func (t *translator) expr(expr ast.Expr) (string, error) {
+	h := t.handler
+
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		return expr.Value, nil
+
+	case *ast.Ident:
+		return t.ident(expr.Name)
+
+	case *ast.ParenExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+
+		return "(" + x + ")", nil
+
+	case *ast.StarExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+
+		return "*" + x, nil
+
+	case *ast.UnaryExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+
+		return expr.Op.String() + x, nil
+
+	case *ast.BinaryExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+		y, err := t.expr(expr.Y)
+		if err != nil {
+			return "", err
+		}
+
+		return x + " " + expr.Op.String() + " " + y, nil
+
+	case *ast.ArrayType:
+		if expr.Len != nil {
+			return "", fmt.Errorf("unsupported array type")
+		}
+		elt, err := t.expr(expr.Elt)
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + elt, nil
+
+	case *ast.IndexExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+		index, err := t.expr(expr.Index)
+		if err != nil {
+			return "", err
+		}
+
+		return x + "[" + index + "]", nil
+
+	case *ast.IndexListExpr:
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+		var indices []string
+		for _, v := range expr.Indices {
+			v, err := t.expr(v)
+			if err != nil {
+				return "", err
+			}
+			indices = append(indices, v)
+		}
+
+		return x + "[" + strings.Join(indices, ", ") + "]", nil
+
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			if _, ok := h.Locals[x.Name]; !ok {
+				if importPath, ok := h.Function.File.Imports[x.Name]; ok {
+					// Plugin SDK-specific packages can't be used.
+					if strings.Contains(importPath, "terraform-plugin-sdk") {
+						return "", fmt.Errorf("unsupported reference to %s.%s", x.Name, expr.Sel.Name)
+					}
+
+					t.generator.usedImports[x.Name] = importPath
+
+					return x.Name + "." + expr.Sel.Name, nil
+				}
+			}
+		}
+
+		x, err := t.expr(expr.X)
+		if err != nil {
+			return "", err
+		}
+
+		return x + "." + expr.Sel.Name, nil
+
+	case *ast.TypeAssertExpr:
+		if isIdent(expr.X, h.MetaName) {
+			return "r.Meta()", nil
+		}
+
+		if call, ok := expr.X.(*ast.CallExpr); ok && t.isDataCall(call, "Get") && len(call.Args) == 1 {
+			return t.get(call.Args[0], expr.Type)
+		}
+
+		return "", fmt.Errorf("unsupported type assertion %s", t.source(expr))
+
+	case *ast.CallExpr:
+		if t.isDataCall(expr, "Id") {
+			return t.data + ".ID.ValueString()", nil
+		}
+
+		if t.isDataCall(expr, "Timeout") && len(expr.Args) == 1 {
+			if v, ok := expr.Args[0].(*ast.SelectorExpr); ok && strings.HasPrefix(v.Sel.Name, "Timeout") {
+				if !t.generator.HasTimeouts {
+					return "", fmt.Errorf("resource has no timeouts")
+				}
+
+				return fmt.Sprintf("r.%sTimeout(ctx, %s.Timeouts)", strings.TrimPrefix(v.Sel.Name, "Timeout"), t.data), nil
+			}
+		}
+
+		if sel, ok := expr.Fun.(*ast.SelectorExpr); ok && isIdent(sel.X, h.DataName) {
+			return "", fmt.Errorf("unsupported call %s", t.source(expr))
+		}
+
+		fun, err := t.expr(expr.Fun)
+		if err != nil {
+			return "", err
+		}
+		var args []string
+		for _, v := range expr.Args {
+			v, err := t.expr(v)
+			if err != nil {
+				return "", err
+			}
+			args = append(args, v)
+		}
+		ellipsis := ""
+		if expr.Ellipsis.IsValid() {
+			ellipsis = "..."
+		}
+
+		return fun + "(" + strings.Join(args, ", ") + ellipsis + ")", nil
+
+	case *ast.CompositeLit:
+		typ := ""
+		if expr.Type != nil {
+			v, err := t.expr(expr.Type)
+			if err != nil {
+				return "", err
+			}
+			typ = v
+		}
+		var elts []string
+		for _, v := range expr.Elts {
+			if kv, ok := v.(*ast.KeyValueExpr); ok {
+				key := t.source(kv.Key)
+				if _, ok := kv.Key.(*ast.Ident); !ok {
+					k, err := t.expr(kv.Key)
+					if err != nil {
+						return "", err
+					}
+					key = k
+				}
+				value, err := t.expr(kv.Value)
+				if err != nil {
+					return "", err
+				}
+				elts = append(elts, key+": "+value)
+
+				continue
+			}
+
+			v, err := t.expr(v)
+			if err != nil {
+				return "", err
+			}
+			elts = append(elts, v)
+		}
+
+		return typ + "{" + strings.Join(elts, ", ") + "}", nil
+	}
+
+	return "", fmt.Errorf("unsupported expression %s", t.source(expr))
+}
+
+// ident translates an identifier.
+func (t *translator) ident(name string) (string, error) {
+	h := t.handler
+
+	if v, ok := t.vars[name]; ok {
+		return v, nil
+	}
+
+	switch name {
+	case "nil", "true", "false", "err", "string", "int", "int32", "int64", "bool", "float64", "any", "error":
+		return name, nil
+	case h.DataName:
+		return "", fmt.Errorf("unsupported reference to %s", name)
+	case h.MetaName:
+		return "r.Meta()", nil
+	}
+
+	if v, ok := h.ErrLocals[name]; ok && t.depth < 8 {
+		t.depth++
+		defer func() { t.depth-- }()
+
+		if v, err := t.expr(v); err == nil {
+			t.stmts = append(t.stmts, fmt.Sprintf("%s, err := %s", name, v))
+			t.vars[name] = name
+
+			return name, nil
+		}
+	}
+
+	if v, ok := h.Locals[name]; ok && t.depth < 8 {
+		t.depth++
+		defer func() { t.depth-- }()
+
+		if v, err := t.expr(v); err == nil {
+			return v, nil
+		}
+	}
+
+	// A local variable named after a string attribute, e.g. functionName := parts[0].
+	for _, v := range t.generator.Attributes {
+		if v.Kind == schema.TypeString && v.Name != "id" && lowerFirst(naming.ToCamelCase(v.Name)) == name {
+			return fmt.Sprintf("%s.%s.ValueString()", t.data, v.FieldName), nil
+		}
+	}
+
+	if _, ok := h.Locals[name]; ok {
+		return "", fmt.Errorf("unsupported reference to local variable %s", name)
+	}
+
+	if t.generator.Source.Package.Identifiers[name] {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("unsupported reference to %s", name)
+}
+
+// get translates d.Get("name").(type).
+func (t *translator) get(key ast.Expr, typ ast.Expr) (string, error) {
+	lit, ok := key.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("unsupported %s.Get key %s", t.handler.DataName, t.source(key))
+	}
+
+	name, _ := strconv.Unquote(lit.Value)
+
+	var field string
+	for _, v := range t.generator.Attributes {
+		if v.Name == name {
+			field = v.FieldName
+			break
+		}
+	}
+
+	if field == "" {
+		return "", fmt.Errorf("unsupported %s.Get key %q", t.handler.DataName, name)
+	}
+
+	v := t.data + "." + field
+
+	switch t.source(typ) {
+	case "string":
+		return v + ".ValueString()", nil
+	case "bool":
+		return v + ".ValueBool()", nil
+	case "float64":
+		return v + ".ValueFloat64()", nil
+	case "int64":
+		return v + ".ValueInt64()", nil
+	case "int", "int32":
+		return t.source(typ) + "(" + v + ".ValueInt64())", nil
+	}
+
+	return "", fmt.Errorf("unsupported %s.Get(%q) type %s", t.handler.DataName, name, t.source(typ))
+}
+
+func (t *translator) isDataCall(call *ast.CallExpr, method string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && isIdent(sel.X, t.handler.DataName) && sel.Sel.Name == method
+}
+
+func (t *translator) source(node ast.Node) string {
+	return t.generator.Source.Package.source(node)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return "unexpected signature"
+	}
+
+	return err.Error()
+}
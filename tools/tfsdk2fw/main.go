@@ -6,13 +6,19 @@ package main
 import (
 	"context"
 	_ "embed"
+	"encoding/csv"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
@@ -60,7 +66,7 @@ func main() {
 	p, err := provider.New(context.Background())
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -83,6 +89,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -98,10 +105,13 @@ type migrator struct {
 	PackageName  string
 	Resource     *schema.Resource
 	Template     string
+	TestTemplate string
 	TFTypeName   string
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, the CRUD handlers and state upgraders are generated from the Plugin SDK resource's source
+// and a test verifying that the schemas are compatible is generated alongside.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -113,29 +123,106 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	templateData, err := m.generateTemplateData(dirname)
 
 	if err != nil {
 		return err
 	}
 
+	return m.write(templateData, outputFilename)
+}
+
+// write generates the Framework schema, and for resources the schema compatibility test, into the specified output file.
+func (m *migrator) write(templateData *templateData, outputFilename string) error {
 	d := m.Generator.NewGoFileDestination(outputFilename)
 
-	if err := d.BufferTemplate("schema", m.Template, templateData); err != nil {
+	if err := d.BufferTemplate("schema", m.Template, templateData, templateFuncMap); err != nil {
+		return err
+	}
+
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_test.go"
+
+	m.infof("generating schema compatibility test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.BufferTemplate("test", m.TestTemplate, templateData); err != nil {
 		return err
 	}
 
 	return d.Write()
 }
 
-func (m *migrator) generateTemplateData() (*templateData, error) {
+func (m *migrator) generateTemplateData(dirname string) (*templateData, error) {
+	var source *sdkResource
+	reservedIdentifiers := make(map[string]bool)
+
+	if !m.IsDataSource {
+		pkg, err := parsePackage(dirname)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k := range pkg.Identifiers {
+			reservedIdentifiers[k] = true
+		}
+
+		if source, err = pkg.sdkResource(m.TFTypeName); err != nil {
+			m.Generator.Warnf("Plugin SDK resource source not found, CRUD handlers must be migrated by hand: %s", err)
+		}
+	}
+
+	templateData := &templateData{
+		EmitResourceImportState:    m.Resource.Importer != nil,
+		EmitResourceUpdateSkeleton: m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		FactoryName:                fmt.Sprintf("new%sResource", m.Name),
+		ModelName:                  lowerFirst(m.Name) + "ResourceModel",
+		Name:                       m.Name,
+		PackageName:                m.PackageName,
+		ResourceTypeName:           lowerFirst(m.Name) + "Resource",
+		TFTypeName:                 m.TFTypeName,
+	}
+
+	reservedIdentifiers[templateData.FactoryName] = true
+	reservedIdentifiers[templateData.ModelName] = true
+	reservedIdentifiers[templateData.ResourceTypeName] = true
+
+	if !m.IsDataSource {
+		// The state type must be calculated before the emitter modifies the resource's schema.
+		stateType := m.Resource.CoreConfigSchema().ImpliedType()
+		if v, ok := m.Resource.SchemaMap()["region"]; ok && isInjectedRegion(v) {
+			attributeTypes := stateType.AttributeTypes()
+			delete(attributeTypes, "region")
+			stateType = cty.Object(attributeTypes)
+		}
+
+		b, err := ctyjson.MarshalType(stateType)
+
+		if err != nil {
+			return nil, fmt.Errorf("encoding Plugin SDK state type: %w", err)
+		}
+
+		templateData.SDKv2StateType = string(b)
+	}
+
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		AttributeNameConstants: attributeNameConstants(),
+		Generator:              m.Generator,
+		IsDataSource:           m.IsDataSource,
+		ReservedIdentifiers:    reservedIdentifiers,
+		SchemaWriter:           &sbSchema,
+		StructWriter:           &sbStruct,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -144,24 +231,23 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
-	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+	sbModels := strings.Builder{}
+	for _, v := range emitter.Models {
+		fprintf(&sbModels, "type %s struct {\n%s}\n\n", v.Name, v.Fields.String())
 	}
 
+	templateData.DefaultCreateTimeout = emitter.DefaultCreateTimeout
+	templateData.DefaultReadTimeout = emitter.DefaultReadTimeout
+	templateData.DefaultUpdateTimeout = emitter.DefaultUpdateTimeout
+	templateData.DefaultDeleteTimeout = emitter.DefaultDeleteTimeout
+	templateData.HasTags = !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap
+	templateData.EmitResourceModifyPlan = templateData.HasTags
+	templateData.HasTimeouts = emitter.HasTimeouts
+	templateData.ImportProviderFrameworkTypes = emitter.ImportProviderFrameworkTypes
+	templateData.Models = sbModels.String()
+	templateData.Schema = sbSchema.String()
+	templateData.Struct = strings.TrimSpace(sbStruct.String())
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -172,7 +258,40 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 			templateData.FrameworkValidatorsPackages = append(templateData.FrameworkValidatorsPackages, v)
 		}
 	}
-	for _, v := range emitter.GoImports {
+
+	goImports := emitter.GoImports
+
+	if source != nil {
+		g := &crudGenerator{
+			Attributes:  emitter.Attributes,
+			HasTimeouts: emitter.HasTimeouts,
+			ModelName:   templateData.ModelName,
+			Source:      source,
+			TFTypeName:  m.TFTypeName,
+			usedImports: make(map[string]string),
+		}
+
+		templateData.Annotations = source.annotations()
+		templateData.CreateBody = strings.TrimSpace(g.create())
+		templateData.ReadBody = strings.TrimSpace(g.read())
+		templateData.UpdateBody = strings.TrimSpace(g.update())
+		templateData.DeleteBody = strings.TrimSpace(g.delete())
+		templateData.CustomizeDiff = source.CustomizeDiff
+		templateData.StateUpgraders = source.stateUpgraders(m.Resource.SchemaVersion)
+
+		if templateData.CustomizeDiff != "" && !m.IsDataSource {
+			templateData.EmitResourceModifyPlan = true
+		}
+
+		for name, path := range g.usedImports {
+			if name == importPackageName(path) {
+				name = ""
+			}
+			goImports = append(goImports, goImport{Alias: name, Path: path})
+		}
+	}
+
+	for _, v := range goImports {
 		if !slices.Contains(templateData.GoImports, v) {
 			templateData.GoImports = append(templateData.GoImports, v)
 		}
@@ -186,10 +305,12 @@ func (m *migrator) infof(format string, a ...interface{}) {
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	AttributeNameConstants        map[string]string // names package constant names, keyed by attribute name.
+	Attributes                    []attribute       // Top-level attributes and blocks.
+	DefaultCreateTimeout          string
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -197,45 +318,67 @@ type emitter struct {
 	HasTimeouts                   bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
-	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	Models                        []*model        // Nested object models.
+	ReservedIdentifiers           map[string]bool // Identifiers that nested object model names must not collide with.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
 
+// attribute describes a top-level attribute or block.
+type attribute struct {
+	FieldName string // Model struct field name.
+	Kind      schema.ValueType
+	Name      string
+	Updatable bool // Whether the attribute can be changed in-place.
+}
+
+// model is a nested object's model struct.
+type model struct {
+	Fields strings.Builder
+	Name   string
+}
+
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
-	if _, ok := resource.Schema["id"]; ok {
+	schemaMap := resource.SchemaMap()
+
+	if _, ok := schemaMap["id"]; ok {
 		e.warnf("Explicit `id` attribute defined")
 	} else {
-		resource.Schema["id"] = &schema.Schema{
+		schemaMap["id"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: e.IsDataSource,
 			Computed: true,
 		}
 	}
 
+	// The Plugin Framework provider injects the same per-resource Region override attribute.
+	if v, ok := schemaMap["region"]; ok && isInjectedRegion(v) {
+		delete(schemaMap, "region")
+	}
+
 	if v := resource.Timeouts; v != nil {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = goDuration(*v)
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = goDuration(*v)
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = goDuration(*v)
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = goDuration(*v)
 		}
 	}
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, schemaMap)
 
 	if err != nil {
 		return err
@@ -293,23 +436,32 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 				fprintf(e.SchemaWriter, `// If the "id" attribute is composed from multiple attributes of the resource, use framework.IDAttributeDeprecatedNoReplacement()`+"\n")
 			}
 		}
-		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.SchemaWriter, "%s:", e.attributeKey(name))
+
+		fieldName := naming.ToCamelCase(name)
+		fprintf(e.StructWriter, "%s ", fieldName)
 
 		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+			e.Attributes = append(e.Attributes, attribute{
+				FieldName: fieldName,
+				Kind:      property.Type,
+				Name:      name,
+				Updatable: name != "id" && !property.ForceNew && (property.Required || property.Optional),
+			})
 		}
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
-		} else {
+			fprintf(e.StructWriter, "types.String")
+		} else if ok, err := e.emitTagsAttribute(path, name, property); err != nil {
+			return err
+		} else if !ok {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -330,7 +482,19 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			emittedFieldName = true
 		}
 
-		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.SchemaWriter, "%s:", e.attributeKey(name))
+
+		fieldName := naming.ToCamelCase(name)
+		fprintf(e.StructWriter, "%s ", fieldName)
+
+		if isTopLevelAttribute {
+			e.Attributes = append(e.Attributes, attribute{
+				FieldName: fieldName,
+				Kind:      property.Type,
+				Name:      name,
+				Updatable: !property.ForceNew && (property.Required || property.Optional),
+			})
+		}
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -338,6 +502,8 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -347,12 +513,54 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 	return nil
 }
 
+// emitTagsAttribute generates the Plugin Framework code for the top-level 'tags' and 'tags_all' attributes
+// and emits the generated code to the emitter's Writer.
+// Returns whether or not the attribute was emitted.
+func (e *emitter) emitTagsAttribute(path []string, name string, property *schema.Schema) (bool, error) {
+	if len(path) > 0 || property.Type != schema.TypeMap {
+		return false, nil
+	}
+
+	if v, ok := property.Elem.(*schema.Schema); !ok || v.Type != schema.TypeString {
+		return false, nil
+	}
+
+	switch name {
+	case "tags":
+		e.HasTopLevelTagsMap = true
+
+		switch {
+		case property.Required:
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeRequired()")
+		case property.Optional:
+			fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+		default:
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+		}
+
+	case "tags_all":
+		e.HasTopLevelTagsAllMap = true
+
+		fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+
+	default:
+		return false, nil
+	}
+
+	e.GoImports = append(e.GoImports, goImport{
+		Alias: "tftags",
+		Path:  "github.com/hashicorp/terraform-provider-aws/internal/tags",
+	})
+	fprintf(e.StructWriter, "tftags.Map")
+
+	return true, nil
+}
+
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
 func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
@@ -364,30 +572,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -399,16 +598,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,10 +618,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -438,10 +627,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -451,67 +636,44 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 		}
 
-		switch v := property.Elem.(type) {
+		switch elem := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
+			fieldType, customType, elementType, err := e.primitiveCollectionTypes(path, v, elem.Type)
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+			if err != nil {
+				return err
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+			fprintf(e.StructWriter, "%s", fieldType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
+			if v == schema.TypeMap {
+				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, elem))
+			}
+
+			model, fieldType, customType, err := e.nestedObjectCollectionTypes(path, v, elem.Schema)
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", model.Name)
+			fprintf(e.StructWriter, "%s", fieldType)
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, elem))
 		}
 
 	default:
@@ -633,10 +795,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			model := e.newModel(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", model.Name)
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model.Name)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(model, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -656,10 +825,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			model := e.newModel(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", model.Name)
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", model.Name)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(model, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -731,127 +907,171 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
-// emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-// Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema.Schema) error {
-	names := make([]string, 0)
-	for name := range schema {
-		names = append(names, name)
+// primitiveCollectionTypes returns the model field type, the schema custom type and the schema element type
+// for a Plugin SDK collection of primitive values.
+func (e *emitter) primitiveCollectionTypes(path []string, collectionType, elementType schema.ValueType) (string, string, string, error) {
+	var elementValueType, elementAttrType string
+
+	switch elementType {
+	case schema.TypeBool:
+		elementValueType, elementAttrType = "types.Bool", "types.BoolType"
+	case schema.TypeFloat:
+		elementValueType, elementAttrType = "types.Float64", "types.Float64Type"
+	case schema.TypeInt:
+		elementValueType, elementAttrType = "types.Int64", "types.Int64Type"
+	case schema.TypeString:
+		elementValueType, elementAttrType = "types.String", "types.StringType"
+	default:
+		return "", "", "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", strings.ToLower(strings.TrimPrefix(collectionType.String(), "Type")), elementType.String()))
 	}
-	slices.Sort(names)
 
-	fprintf(e.SchemaWriter, "types.ObjectType{\n")
+	e.ImportProviderFrameworkTypes = true
 
-	emittedFieldName := false
-	for _, name := range names {
-		property := schema[name]
+	var collection string
 
-		if !emittedFieldName {
-			fprintf(e.SchemaWriter, "AttrTypes: map[string]attr.Type{\n")
-			emittedFieldName = true
-			e.ImportFrameworkAttr = true
-		}
+	switch collectionType {
+	case schema.TypeList:
+		collection = "List"
+	case schema.TypeMap:
+		collection = "Map"
+	case schema.TypeSet:
+		collection = "Set"
+	}
 
-		fprintf(e.SchemaWriter, "%q:", name)
+	if elementType == schema.TypeString {
+		return fmt.Sprintf("fwtypes.%sOfString", collection), fmt.Sprintf("fwtypes.%sOfStringType", collection), elementAttrType, nil
+	}
 
-		err := e.emitComputedOnlyBlockProperty(append(path, name), property)
+	return fmt.Sprintf("fwtypes.%sValueOf[%s]", collection, elementValueType), fmt.Sprintf("fwtypes.New%sTypeOf[%s](ctx)", collection, elementValueType), elementAttrType, nil
+}
 
-		if err != nil {
-			return err
-		}
-	}
-	if emittedFieldName {
-		fprintf(e.SchemaWriter, "},\n")
-	}
+// nestedObjectCollectionTypes returns the nested object's model, the model field type and the schema custom type
+// for a Plugin SDK collection of nested objects that is handled as an attribute.
+func (e *emitter) nestedObjectCollectionTypes(path []string, collectionType schema.ValueType, schemaMap map[string]*schema.Schema) (*model, string, string, error) {
+	model := e.newModel(path)
+	e.ImportProviderFrameworkTypes = true
 
-	fprintf(e.SchemaWriter, "}")
+	err := e.emitModel(model, func() error {
+		return e.emitModelFields(path, schemaMap)
+	})
 
-	return nil
-}
+	if err != nil {
+		return nil, "", "", err
+	}
 
-// emitComputedOnlyBlockProperty generates the Plugin Framework code for a Plugin SDK Computed-only nested block's property
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
-	switch v := property.Type; v {
-	//
-	// Primitive types.
-	//
-	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "types.BoolType,\n")
+	if collectionType == schema.TypeSet {
+		return model, fmt.Sprintf("fwtypes.SetNestedObjectValueOf[%s]", model.Name), fmt.Sprintf("fwtypes.NewSetNestedObjectTypeOf[%s](ctx)", model.Name), nil
+	}
 
-	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "types.Float64Type,\n")
+	return model, fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model.Name), fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", model.Name), nil
+}
 
-	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "types.Int64Type,\n")
+// emitModelFields generates the model struct fields for a nested object that is handled as an attribute
+// and emits the generated code to the emitter's StructWriter.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitModelFields(path []string, schemaMap map[string]*schema.Schema) error {
+	names := make([]string, 0)
+	for name := range schemaMap {
+		names = append(names, name)
+	}
+	slices.Sort(names)
 
-	case schema.TypeString:
-		fprintf(e.SchemaWriter, "types.StringType,\n")
+	for _, name := range names {
+		property := schemaMap[name]
+		path := append(slices.Clone(path), name)
+
+		var fieldType string
+
+		switch v := property.Type; v {
+		case schema.TypeBool:
+			fieldType = "types.Bool"
+		case schema.TypeFloat:
+			fieldType = "types.Float64"
+		case schema.TypeInt:
+			fieldType = "types.Int64"
+		case schema.TypeString:
+			fieldType = "types.String"
+		case schema.TypeList, schema.TypeMap, schema.TypeSet:
+			switch elem := property.Elem.(type) {
+			case *schema.Schema:
+				var err error
+				fieldType, _, _, err = e.primitiveCollectionTypes(path, v, elem.Type)
+
+				if err != nil {
+					return err
+				}
+			case *schema.Resource:
+				if v == schema.TypeMap {
+					return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) map of %T", elem))
+				}
 
-	//
-	// Complex types.
-	//
-	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateType, typeName string
+				var err error
+				_, fieldType, _, err = e.nestedObjectCollectionTypes(path, v, elem.Schema)
 
-		switch v {
-		case schema.TypeList:
-			aggregateType = "types.ListType"
-			typeName = "list"
-		case schema.TypeMap:
-			aggregateType = "types.MapType"
-			typeName = "map"
-		case schema.TypeSet:
-			aggregateType = "types.SetType"
-			typeName = "set"
+				if err != nil {
+					return err
+				}
+			default:
+				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", v.String(), elem))
+			}
+		default:
+			return unsupportedTypeError(path, v.String())
 		}
 
-		switch v := property.Elem.(type) {
-		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
+		fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+	}
 
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
+	return nil
+}
 
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
+// newModel adds a new nested object model for the specified path.
+// The model's name is based on the nested object's name, qualified if necessary to make it unique.
+func (e *emitter) newModel(path []string) *model {
+	name := lowerFirst(naming.ToCamelCase(path[len(path)-1])) + "Model"
 
-			case schema.TypeString:
-				elementType = "types.StringType"
+	for i := len(path) - 2; e.isReservedIdentifier(name); i-- {
+		if i >= 0 {
+			name = lowerFirst(naming.ToCamelCase(strings.Join(path[i:], "_"))) + "Model"
+		} else {
+			name = fmt.Sprintf("%s%d", strings.TrimSuffix(name, "Model"), -i) + "Model"
+		}
+	}
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.String()))
-			}
+	model := &model{Name: name}
+	e.Models = append(e.Models, model)
 
-			fprintf(e.SchemaWriter, "%s{ElemType:%s},\n", aggregateType, elementType)
+	return model
+}
 
-		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s{ElemType:", aggregateType)
+func (e *emitter) isReservedIdentifier(name string) bool {
+	if e.ReservedIdentifiers[name] {
+		return true
+	}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+	return slices.ContainsFunc(e.Models, func(m *model) bool {
+		return m.Name == name
+	})
+}
 
-			fprintf(e.SchemaWriter, "},\n")
+// emitModel calls the specified function with the emitter's StructWriter set to the model's fields.
+func (e *emitter) emitModel(model *model, f func() error) error {
+	structWriter := e.StructWriter
+	e.StructWriter = &model.Fields
+	defer func() {
+		e.StructWriter = structWriter
+	}()
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
-		}
+	return f()
+}
 
-	default:
-		return unsupportedTypeError(path, v.String())
+// attributeKey returns the Go expression for the specified attribute name,
+// preferring a names package constant.
+func (e *emitter) attributeKey(name string) string {
+	if v, ok := e.AttributeNameConstants[name]; ok {
+		return "names." + v
 	}
 
-	return nil
+	return strconv.Quote(name)
 }
 
 // warnf emits a formatted warning message to the UI.
@@ -897,29 +1117,113 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isInjectedRegion returns whether or not the specified property is the per-resource Region override attribute
+// injected by the provider.
+func isInjectedRegion(property *schema.Schema) bool {
+	return property.Type == schema.TypeString && strings.HasPrefix(property.Description, "The AWS Region in which the ")
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// attributeNameConstants returns the names package's attribute name constants, keyed by attribute name.
+// The tool is run from the root of the repository.
+func attributeNameConstants() map[string]string {
+	f, err := os.Open("names/attr_constants.csv")
+
+	if err != nil {
+		return nil
+	}
+
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+
+	if err != nil {
+		return nil
+	}
+
+	constants := make(map[string]string, len(records))
+	for _, record := range records {
+		if len(record) >= 2 {
+			constants[record[0]] = "Attr" + record[1]
+		}
+	}
+
+	return constants
+}
+
+// goDuration returns the Go expression for the specified duration.
+func goDuration(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "0"
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
+// lowerFirst lowercases the leading initialism or letter of a CamelCase name, e.g. "ARNConfig" -> "arnConfig".
+func lowerFirst(s string) string {
+	n := 0
+	for n < len(s) && s[n] >= 'A' && s[n] <= 'Z' {
+		n++
+	}
+
+	switch {
+	case n == len(s):
+		return strings.ToLower(s)
+	case n > 1:
+		n--
+	}
+
+	return strings.ToLower(s[:n]) + s[n:]
+}
+
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	Annotations                   []string
+	CreateBody                    string
+	CustomizeDiff                 string
+	DefaultCreateTimeout          string
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	DeleteBody                    string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	FactoryName                   string // e.g. newInstanceResource
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasTags                       bool
 	HasTimeouts                   bool
-	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ModelName                     string // e.g. instanceResourceModel
+	Models                        string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ReadBody                      string
+	ResourceTypeName              string // e.g. instanceResource
 	Schema                        string
+	SDKv2StateType                string // JSON-encoded
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateBody                    string
+}
+
+var templateFuncMap = template.FuncMap{
+	"lines": func(s string) []string {
+		return strings.Split(s, "\n")
+	},
 }
 
 //go:embed datasource.gtpl
@@ -928,6 +1232,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed resource_test.gtpl
+var resourceTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
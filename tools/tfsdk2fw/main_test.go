@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestMigrateResource generates Framework resources from real Plugin SDK resources,
+// compares the output with the golden files in testdata and checks that the output compiles
+// in the Plugin SDK resource's package.
+//
+// Run with -update to regenerate the golden files after changing the generator.
+func TestMigrateResource(t *testing.T) {
+	if _, err := exec.LookPath("goimports"); err != nil {
+		t.Skip("goimports not found")
+	}
+
+	rootDir, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := provider.New(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		TestName     string
+		ResourceType string
+		PackageName  string
+		Name         string
+	}{
+		{
+			TestName:     "create read delete",
+			ResourceType: "aws_cloudwatch_log_stream",
+			PackageName:  "logs",
+			Name:         "Stream",
+		},
+		{
+			TestName:     "create read update delete with tags",
+			ResourceType: "aws_memorydb_acl",
+			PackageName:  "memorydb",
+			Name:         "ACL",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			resource, ok := p.ResourcesMap[testCase.ResourceType]
+			if !ok {
+				t.Fatalf("resource type %s not found", testCase.ResourceType)
+			}
+
+			m := &migrator{
+				Generator:    common.NewGenerator(),
+				Name:         testCase.Name,
+				PackageName:  testCase.PackageName,
+				Resource:     resource,
+				Template:     resourceImpl,
+				TestTemplate: resourceTestImpl,
+				TFTypeName:   testCase.ResourceType,
+			}
+
+			packageDir := filepath.Join(rootDir, "internal", "service", testCase.PackageName)
+			templateData, err := m.generateTemplateData(packageDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			outputFilename := filepath.Join(t.TempDir(), "tfsdk2fw_gen.go")
+			if err := m.write(templateData, outputFilename); err != nil {
+				t.Fatal(err)
+			}
+
+			// The generated resource and its test are compiled in the Plugin SDK resource's package
+			// without modifying the package's directory.
+			overlay := map[string]map[string]string{"Replace": {}}
+			for _, suffix := range []string{".go", "_test.go"} {
+				filename := testCase.ResourceType + suffix
+				generated := filepath.Join(filepath.Dir(outputFilename), "tfsdk2fw_gen"+suffix)
+
+				testGolden(t, generated, filepath.Join("testdata", filename+".golden"))
+
+				overlay["Replace"][filepath.Join(packageDir, "tfsdk2fw_gen"+suffix)] = generated
+			}
+
+			testCompile(t, rootDir, "./internal/service/"+testCase.PackageName, overlay)
+		})
+	}
+}
+
+// testGolden compares the generated file with the golden file, updating the golden file if -update is set.
+func testGolden(t *testing.T, generated, golden string) {
+	t.Helper()
+
+	got, err := os.ReadFile(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated file differs from %s; run go test -update to update it\n%s", golden, got)
+	}
+}
+
+// testCompile runs go vet on the package, with the specified file overlay.
+func testCompile(t *testing.T, dir, pkg string, overlay any) {
+	t.Helper()
+
+	b, err := json.Marshal(overlay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlayFilename := filepath.Join(t.TempDir(), "overlay.json")
+	if err := os.WriteFile(overlayFilename, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "vet", "-overlay", overlayFilename, pkg)
+	cmd.Dir = dir
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("go vet %s: %s\n%s", pkg, err, output)
+	}
+}
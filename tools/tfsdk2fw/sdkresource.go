@@ -0,0 +1,664 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// goPackage is a parsed Go package.
+type goPackage struct {
+	Files       []*goFile
+	FileSet     *token.FileSet
+	Functions   map[string]*goFunction // Package-level functions, keyed by name.
+	Identifiers map[string]bool        // All package-level identifiers.
+}
+
+type goFile struct {
+	AST     *ast.File
+	Imports map[string]string // Import paths, keyed by package name.
+}
+
+type goFunction struct {
+	Decl *ast.FuncDecl
+	File *goFile
+}
+
+// parsePackage parses the non-test Go source files in the specified directory.
+func parsePackage(dirname string) (*goPackage, error) {
+	filenames, err := filepath.Glob(filepath.Join(dirname, "*.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &goPackage{
+		FileSet:     token.NewFileSet(),
+		Functions:   make(map[string]*goFunction),
+		Identifiers: make(map[string]bool),
+	}
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip any previously generated file.
+		if bytes.Contains(src, []byte("// Code generated by tools/tfsdk2fw/main.go.")) {
+			continue
+		}
+
+		f, err := parser.ParseFile(pkg.FileSet, filename, src, parser.ParseComments)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		file := &goFile{
+			AST:     f,
+			Imports: make(map[string]string),
+		}
+
+		for _, v := range f.Imports {
+			importPath, _ := strconv.Unquote(v.Path.Value)
+			var name string
+
+			if v.Name != nil {
+				name = v.Name.Name
+			} else {
+				name = importPackageName(importPath)
+			}
+
+			if name != "_" && name != "." {
+				file.Imports[name] = importPath
+			}
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					pkg.Functions[decl.Name.Name] = &goFunction{Decl: decl, File: file}
+					pkg.Identifiers[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						pkg.Identifiers[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							pkg.Identifiers[name.Name] = true
+						}
+					}
+				}
+			}
+		}
+
+		pkg.Files = append(pkg.Files, file)
+	}
+
+	return pkg, nil
+}
+
+// sdkResource is the result of static analysis of a Plugin SDK resource's source.
+type sdkResource struct {
+	Annotations    []string // e.g. `@Tags(identifierAttribute="arn")`.
+	Create         *sdkHandler
+	CustomizeDiff  string // Source of the CustomizeDiff function.
+	Delete         *sdkHandler
+	HumanName      string // e.g. "Lambda Provisioned Concurrency Config".
+	Package        *goPackage
+	Read           *sdkHandler
+	StateUpgraders []sdkStateUpgrader
+	Update         *sdkHandler
+}
+
+type sdkStateUpgrader struct {
+	Function string
+	Version  int
+}
+
+// sdkResource finds the Plugin SDK resource for the specified Terraform type name
+// via its `@SDKResource` annotation and analyzes its implementation.
+func (pkg *goPackage) sdkResource(typeName string) (*sdkResource, error) {
+	annotation := fmt.Sprintf("@SDKResource(%q", typeName)
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.AST.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || decl.Doc == nil || !strings.Contains(decl.Doc.Text(), annotation) {
+				continue
+			}
+
+			r := &sdkResource{
+				Package: pkg,
+			}
+
+			for _, line := range strings.Split(decl.Doc.Text(), "\n") {
+				if line := strings.TrimSpace(line); strings.HasPrefix(line, "@") {
+					r.Annotations = append(r.Annotations, line)
+				}
+			}
+
+			if err := r.analyzeFactory(decl); err != nil {
+				return nil, err
+			}
+
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no function annotated with %s)", annotation)
+}
+
+// analyzeFactory analyzes the resource's factory function, which returns a *schema.Resource.
+func (r *sdkResource) analyzeFactory(decl *ast.FuncDecl) error {
+	var resource *ast.CompositeLit
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if v, ok := n.(*ast.CompositeLit); ok && resource == nil {
+			if v, ok := v.Type.(*ast.SelectorExpr); ok && v.Sel.Name == "Resource" {
+				resource = n.(*ast.CompositeLit)
+			}
+		}
+
+		return resource == nil
+	})
+
+	if resource == nil {
+		return fmt.Errorf("%s: no schema.Resource literal", decl.Name.Name)
+	}
+
+	for _, elt := range resource.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "Create", "CreateContext", "CreateWithoutTimeout":
+			r.Create = r.handler(kv.Value)
+		case "Read", "ReadContext", "ReadWithoutTimeout":
+			r.Read = r.handler(kv.Value)
+		case "Update", "UpdateContext", "UpdateWithoutTimeout":
+			r.Update = r.handler(kv.Value)
+		case "Delete", "DeleteContext", "DeleteWithoutTimeout":
+			r.Delete = r.handler(kv.Value)
+		case "CustomizeDiff":
+			r.CustomizeDiff = r.Package.source(kv.Value)
+		case "StateUpgraders":
+			v, ok := kv.Value.(*ast.CompositeLit)
+
+			if !ok {
+				continue
+			}
+
+			for _, elt := range v.Elts {
+				v, ok := elt.(*ast.CompositeLit)
+
+				if !ok {
+					continue
+				}
+
+				var stateUpgrader sdkStateUpgrader
+
+				for _, elt := range v.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+
+					if !ok {
+						continue
+					}
+
+					switch key, value := kv.Key.(*ast.Ident), kv.Value; key.Name {
+					case "Upgrade":
+						if v, ok := value.(*ast.Ident); ok {
+							stateUpgrader.Function = v.Name
+						}
+					case "Version":
+						if v, ok := value.(*ast.BasicLit); ok {
+							stateUpgrader.Version, _ = strconv.Atoi(v.Value)
+						}
+					}
+				}
+
+				r.StateUpgraders = append(r.StateUpgraders, stateUpgrader)
+			}
+		}
+	}
+
+	for _, v := range []*sdkHandler{r.Create, r.Read, r.Update, r.Delete} {
+		if v != nil && r.HumanName == "" {
+			r.HumanName = v.HumanName
+		}
+	}
+
+	if r.HumanName == "" {
+		r.HumanName = annotationName(r.Annotations)
+	}
+
+	return nil
+}
+
+// handler analyzes the CRUD handler function referenced by the specified expression.
+func (r *sdkResource) handler(expr ast.Expr) *sdkHandler {
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return &sdkHandler{Source: r.Package.source(expr)}
+	}
+
+	fn, ok := r.Package.Functions[ident.Name]
+
+	if !ok {
+		return &sdkHandler{Source: ident.Name}
+	}
+
+	return analyzeHandler(fn)
+}
+
+// annotations returns the resource's annotations for the Plugin Framework resource.
+func (r *sdkResource) annotations() []string {
+	var annotations []string
+
+	for _, v := range r.Annotations {
+		if strings.HasPrefix(v, "@SDKResource(") {
+			v = "@FrameworkResource(" + strings.TrimPrefix(v, "@SDKResource(")
+		}
+
+		annotations = append(annotations, v)
+	}
+
+	return annotations
+}
+
+// stateUpgrader is a Plugin Framework state upgrader.
+type stateUpgrader struct {
+	Functions []string // Plugin SDK state upgrade functions, in order.
+	Version   int      // Prior schema version.
+}
+
+// stateUpgraders returns the Plugin Framework state upgraders for the resource.
+// Plugin Framework state upgraders are not chained, so each prior version's upgrader runs all subsequent Plugin SDK state upgrade functions.
+func (r *sdkResource) stateUpgraders(schemaVersion int) []stateUpgrader {
+	upgraders := slices.Clone(r.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b sdkStateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	var stateUpgraders []stateUpgrader
+
+	for i, v := range upgraders {
+		if v.Version >= schemaVersion {
+			continue
+		}
+
+		stateUpgrader := stateUpgrader{
+			Version: v.Version,
+		}
+
+		for _, v := range upgraders[i:] {
+			if v.Version >= schemaVersion {
+				break
+			}
+
+			stateUpgrader.Functions = append(stateUpgrader.Functions, v.Function)
+		}
+
+		stateUpgraders = append(stateUpgraders, stateUpgrader)
+	}
+
+	return stateUpgraders
+}
+
+// sdkHandler is the result of static analysis of a Plugin SDK resource CRUD handler.
+type sdkHandler struct {
+	Calls        []*sdkCall // API and finder/waiter calls in source order.
+	ClientMethod string     // e.g. "LambdaClient".
+	ConnName     string
+	CtxName      string
+	DataName     string
+	ErrLocals    map[string]*ast.CallExpr // Local variables assigned, along with err, from a function call.
+	Function     *goFunction
+	HumanName    string
+	IDExpr       ast.Expr // Argument to d.SetId().
+	Locals       map[string]ast.Expr
+	MetaName     string
+	Name         string
+	NotFound     ast.Expr // Condition under which a not found error is ignored.
+	Source       string   // Set if the handler isn't a package-level function.
+	TagsIn       bool     // Whether getTagsIn() is called.
+	TagsOut      ast.Expr // Argument to setTagsOut().
+}
+
+// sdkCall is a call to an AWS API operation, finder or waiter.
+type sdkCall struct {
+	Call      *ast.CallExpr
+	Function  string // Finder or waiter function name.
+	Operation string // AWS API operation name.
+	Output    string // Name of the variable assigned the call's first result.
+	Results   int    // Number of results assigned, 0 if not assigned.
+}
+
+// analyzeHandler analyzes a Plugin SDK CRUD handler function with signature
+// func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics.
+func analyzeHandler(fn *goFunction) *sdkHandler {
+	h := &sdkHandler{
+		ErrLocals: make(map[string]*ast.CallExpr),
+		Function:  fn,
+		Locals:    make(map[string]ast.Expr),
+		Name:      fn.Decl.Name.Name,
+	}
+
+	var params []string
+	for _, field := range fn.Decl.Type.Params.List {
+		for _, name := range field.Names {
+			params = append(params, name.Name)
+		}
+	}
+
+	if len(params) != 3 || fn.Decl.Body == nil {
+		return h
+	}
+
+	h.CtxName, h.DataName, h.MetaName = params[0], params[1], params[2]
+
+	assigned := make(map[*ast.CallExpr]bool)
+
+	ast.Inspect(fn.Decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Don't analyze the bodies of nested functions, e.g. retry functions.
+			return false
+
+		case *ast.AssignStmt:
+			var lhs []string
+			for _, v := range n.Lhs {
+				if v, ok := v.(*ast.Ident); ok {
+					lhs = append(lhs, v.Name)
+				} else {
+					lhs = append(lhs, "")
+				}
+			}
+
+			if len(n.Rhs) == 1 && len(lhs) > 0 {
+				if v, ok := n.Rhs[0].(*ast.CallExpr); ok {
+					if clientMethod, ok := h.clientMethod(v); ok {
+						h.ClientMethod, h.ConnName = clientMethod, lhs[0]
+						assigned[v] = true
+					} else if call := h.call(v); call != nil {
+						call.Output, call.Results = lhs[0], len(lhs)
+						h.Calls = append(h.Calls, call)
+						assigned[v] = true
+					} else if len(lhs) == 2 && lhs[1] == "err" {
+						if _, ok := h.ErrLocals[lhs[0]]; !ok && lhs[0] != "_" {
+							h.ErrLocals[lhs[0]] = v
+						}
+					}
+				}
+			}
+
+			if len(n.Lhs) == len(n.Rhs) {
+				for i, name := range lhs {
+					if _, ok := h.Locals[name]; !ok && name != "" && name != "_" {
+						h.Locals[name] = n.Rhs[i]
+					}
+				}
+			}
+
+		case *ast.CallExpr:
+			if assigned[n] {
+				break
+			}
+
+			if call := h.call(n); call != nil {
+				h.Calls = append(h.Calls, call)
+				break
+			}
+
+			switch fun := n.Fun.(type) {
+			case *ast.Ident:
+				switch fun.Name {
+				case "getTagsIn":
+					h.TagsIn = true
+				case "setTagsOut":
+					if len(n.Args) == 2 {
+						h.TagsOut = n.Args[1]
+					}
+				}
+
+			case *ast.SelectorExpr:
+				if isIdent(fun.X, h.DataName) && fun.Sel.Name == "SetId" && len(n.Args) == 1 && h.IDExpr == nil {
+					if v, ok := n.Args[0].(*ast.BasicLit); !ok || v.Value != `""` {
+						h.IDExpr = n.Args[0]
+					}
+				}
+
+				if isIdent(fun.X, "sdkdiag") && fun.Sel.Name == "AppendErrorf" && len(n.Args) > 1 && h.HumanName == "" {
+					if v, ok := n.Args[1].(*ast.BasicLit); ok {
+						h.HumanName = humanName(v.Value)
+					}
+				}
+			}
+
+		case *ast.IfStmt:
+			if h.NotFound == nil && isNotFoundCondition(n) {
+				h.NotFound = n.Cond
+			}
+		}
+
+		return true
+	})
+
+	// Treat the conn variable as a parameter.
+	delete(h.Locals, h.ConnName)
+
+	return h
+}
+
+// clientMethod returns the AWS client method name from an expression of the form
+// meta.(*conns.AWSClient).ServiceClient(ctx).
+func (h *sdkHandler) clientMethod(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	if !ok || !strings.HasSuffix(sel.Sel.Name, "Client") {
+		return "", false
+	}
+
+	assert, ok := sel.X.(*ast.TypeAssertExpr)
+
+	if !ok || !isIdent(assert.X, h.MetaName) {
+		return "", false
+	}
+
+	return sel.Sel.Name, true
+}
+
+// call returns the AWS API operation, finder or waiter call, if any.
+func (h *sdkHandler) call(call *ast.CallExpr) *sdkCall {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if strings.HasPrefix(fun.Name, "find") || strings.HasPrefix(fun.Name, "wait") {
+			return &sdkCall{Call: call, Function: fun.Name}
+		}
+
+	case *ast.SelectorExpr:
+		if h.ConnName != "" && isIdent(fun.X, h.ConnName) {
+			return &sdkCall{Call: call, Operation: fun.Sel.Name}
+		}
+	}
+
+	return nil
+}
+
+// operation returns the first AWS API operation call, ignoring tagging operations.
+func (h *sdkHandler) operation() *sdkCall {
+	if h == nil {
+		return nil
+	}
+
+	for _, v := range h.Calls {
+		if v.Operation != "" && !strings.HasPrefix(v.Operation, "Tag") && !strings.HasPrefix(v.Operation, "Untag") {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// helper returns the first finder or waiter call whose name has the specified prefix.
+func (h *sdkHandler) helper(prefix string) *sdkCall {
+	if h == nil {
+		return nil
+	}
+
+	for _, v := range h.Calls {
+		if strings.HasPrefix(v.Function, prefix) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// inputPackage returns the name of the AWS SDK for Go v2 service package imported by the handler's file.
+func (h *sdkHandler) inputPackage() (string, string, bool) {
+	const (
+		prefix = "github.com/aws/aws-sdk-go-v2/service/"
+	)
+
+	for name, importPath := range h.Function.File.Imports {
+		if strings.HasPrefix(importPath, prefix) && !strings.Contains(strings.TrimPrefix(importPath, prefix), "/") {
+			return name, importPath, true
+		}
+	}
+
+	return "", "", false
+}
+
+// isNotFoundCondition returns whether the specified if statement ignores a not found error, e.g.
+//
+//	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+//		return diags
+//	}
+func isNotFoundCondition(n *ast.IfStmt) bool {
+	if n.Init != nil || n.Else != nil || len(n.Body.List) != 1 {
+		return false
+	}
+
+	ret, ok := n.Body.List[0].(*ast.ReturnStmt)
+
+	if !ok || len(ret.Results) != 1 {
+		return false
+	}
+
+	if v, ok := ret.Results[0].(*ast.Ident); !ok || (v.Name != "diags" && v.Name != "nil") {
+		return false
+	}
+
+	// Exclude "err != nil" and "d.IsNewResource()"-type conditions.
+	if v, ok := n.Cond.(*ast.BinaryExpr); ok && (v.Op == token.NEQ || v.Op == token.EQL) {
+		return false
+	}
+
+	usesErr := false
+	ast.Inspect(n.Cond, func(n ast.Node) bool {
+		if isIdent(n, "err") {
+			usesErr = true
+		}
+
+		return !usesErr
+	})
+
+	return usesErr
+}
+
+// source returns the Go source for the specified node.
+func (pkg *goPackage) source(node ast.Node) string {
+	var buf bytes.Buffer
+
+	if err := printer.Fprint(&buf, pkg.FileSet, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// humanName returns the human-friendly resource name from a quoted error message
+// format such as "creating Lambda Provisioned Concurrency Config (%s): %s".
+func humanName(quoted string) string {
+	message, err := strconv.Unquote(quoted)
+
+	if err != nil {
+		return ""
+	}
+
+	_, message, ok := strings.Cut(message, " ")
+
+	if !ok {
+		return ""
+	}
+
+	if i := strings.IndexAny(message, "(:%"); i >= 0 {
+		message = message[:i]
+	}
+
+	return strings.TrimSpace(message)
+}
+
+// annotationName returns the name="..." argument of the resource's `@SDKResource` annotation.
+func annotationName(annotations []string) string {
+	for _, v := range annotations {
+		if _, v, ok := strings.Cut(v, `name="`); ok {
+			if v, _, ok := strings.Cut(v, `"`); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+// importPackageName returns the default package name for the specified import path.
+func importPackageName(importPath string) string {
+	name := path.Base(importPath)
+
+	// Ignore any major version suffix.
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+
+	return strings.ReplaceAll(name, "-", "")
+}
+
+func isIdent(n ast.Node, name string) bool {
+	v, ok := n.(*ast.Ident)
+
+	return ok && v.Name == name
+}